@@ -57,6 +57,18 @@ type APIConfig struct {
 
 	// When using auth_type `kubeConfig`, override the current context.
 	Context string `mapstructure:"context"`
+
+	// Impersonate a user and groups when talking to the K8s API server, e.g. to
+	// watch the cluster with the permissions of a restricted service account.
+	Impersonate ImpersonateConfig `mapstructure:"impersonate"`
+}
+
+// ImpersonateConfig contains the user and groups to impersonate.
+type ImpersonateConfig struct {
+	// User to impersonate. Impersonation is disabled if empty.
+	User string `mapstructure:"user"`
+	// Groups to impersonate in addition to the groups of the user.
+	Groups []string `mapstructure:"groups"`
 }
 
 // Validate validates the K8s API config
@@ -64,6 +76,9 @@ func (c APIConfig) Validate() error {
 	if !authTypes[c.AuthType] {
 		return fmt.Errorf("invalid authType for kubernetes: %v", c.AuthType)
 	}
+	if c.Impersonate.User == "" && len(c.Impersonate.Groups) > 0 {
+		return fmt.Errorf("impersonate: user must be set when groups are impersonated")
+	}
 
 	return nil
 }
@@ -110,6 +125,13 @@ func CreateRestConfig(apiConf APIConfig) (*rest.Config, error) {
 		}
 	}
 
+	if apiConf.Impersonate.User != "" {
+		authConf.Impersonate = rest.ImpersonationConfig{
+			UserName: apiConf.Impersonate.User,
+			Groups:   apiConf.Impersonate.Groups,
+		}
+	}
+
 	authConf.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
 		// Don't use system proxy settings since the API is local to the
 		// cluster
@@ -4,9 +4,15 @@
 package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
@@ -18,6 +24,19 @@ type Config struct {
 	// Collection interval for metrics.
 	CollectionInterval time.Duration `mapstructure:"collection_interval"`
 
+	// Collection intervals for metrics of specific kinds, keyed by lowercase kind, e.g. "node".
+	// Kinds that are not listed use CollectionInterval. Intervals are rounded to a multiple
+	// of CollectionInterval.
+	CollectionIntervals map[string]time.Duration `mapstructure:"collection_intervals"`
+
+	// Label selectors restricting the objects of specific kinds that are watched, keyed by
+	// lowercase kind, e.g. "deployment". Objects of kinds that are not listed are all watched.
+	LabelSelectors map[string]string `mapstructure:"label_selectors"`
+
+	// Kinds to watch, as lowercase kinds, e.g. "pod". Kinds that are not listed are not watched,
+	// and all kinds are watched if empty.
+	CollectedKinds []string `mapstructure:"collected_kinds"`
+
 	// Node condition types to report. See all condition types, see
 	// here: https://kubernetes.io/docs/concepts/architecture/nodes/#condition.
 	NodeConditionTypesToReport []string `mapstructure:"node_conditions_to_report"`
@@ -37,10 +56,75 @@ type Config struct {
 	// metadata collection on changes).
 	MetadataCollectionInterval time.Duration `mapstructure:"metadata_collection_interval"`
 
+	// Maximum duration to wait for the initial sync of the informer caches. The receiver fails
+	// to start, reporting the kinds that are not synced, if the sync doesn't complete within it.
+	// Defaults to 10 minutes.
+	InitialSyncTimeout time.Duration `mapstructure:"initial_sync_timeout"`
+
+	// Window within which the metadata updates of changed entities are batched, e.g. during a
+	// full informer resync. The updates of an entity within the window are coalesced into one.
+	// Metadata updates are sent right away if 0.
+	MetadataDebounceInterval time.Duration `mapstructure:"metadata_debounce_interval"`
+
+	// Label keys to add to the metadata of each entity as "k8s.<kind>.label.<key>".
+	// Each entry is either an exact key or a prefix followed by "*", e.g. "app.kubernetes.io/*".
+	MetadataLabels []string `mapstructure:"metadata_labels"`
+	// Annotation keys to add to the metadata of each entity as "k8s.<kind>.annotation.<key>".
+	// Entries follow the same format as metadata_labels.
+	MetadataAnnotations []string `mapstructure:"metadata_annotations"`
+
+	// Namespace to watch instead of the whole cluster. Cluster-scoped kinds, such as nodes and
+	// persistent volumes, are not collected if set.
+	Namespace string `mapstructure:"namespace"`
+
+	// Namespaces to collect metrics and metadata from. All namespaces are collected if empty.
+	NamespaceInclude []string `mapstructure:"namespace_include"`
+	// Namespaces to not collect metrics and metadata from. Cluster-scoped objects, except for
+	// namespaces, are not affected by namespace_include and namespace_exclude.
+	NamespaceExclude []string `mapstructure:"namespace_exclude"`
+
+	// Kinds of custom resources whose number is reported as k8s.custom_resource.count.
+	CustomResources []CustomResourceConfig `mapstructure:"custom_resources"`
+
+	// Prefix prepended to the name of every emitted metric, e.g. "infra." to emit
+	// k8s.pod.phase as infra.k8s.pod.phase. Metric names are not changed if empty.
+	MetricPrefix string `mapstructure:"metric_prefix"`
+
+	// Name of the cluster, set as the k8s.cluster.name attribute of every emitted resource. The
+	// attribute is not set if empty.
+	ClusterName string `mapstructure:"cluster_name"`
+
+	// Clusters to watch instead of the cluster configured with auth_type and context. Every
+	// cluster is watched with the other settings of the receiver, and its metrics are reported
+	// with its name as the k8s.cluster.name resource attribute.
+	Clusters []ClusterConfig `mapstructure:"clusters"`
+
 	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
+// ClusterConfig is the connection to one of the clusters watched by the receiver.
+type ClusterConfig struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// Name of the cluster, reported as the k8s.cluster.name resource attribute.
+	Name string `mapstructure:"name"`
+}
+
+// CustomResourceConfig identifies a kind of custom resources.
+type CustomResourceConfig struct {
+	// API group of the custom resources. Empty for the core group.
+	Group string `mapstructure:"group"`
+	// API version of the custom resources.
+	Version string `mapstructure:"version"`
+	// Kind of the custom resources.
+	Kind string `mapstructure:"kind"`
+}
+
+func (c CustomResourceConfig) groupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: c.Group, Version: c.Version, Kind: c.Kind}
+}
+
 func (cfg *Config) Validate() error {
 	switch cfg.Distribution {
 	case distributionOpenShift:
@@ -48,5 +132,139 @@ func (cfg *Config) Validate() error {
 	default:
 		return fmt.Errorf("\"%s\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", cfg.Distribution)
 	}
+	for kind, interval := range cfg.CollectionIntervals {
+		if !cfg.isSupportedKind(kind) {
+			return fmt.Errorf("collection_intervals: %q is not a supported kind", kind)
+		}
+		if interval < cfg.CollectionInterval {
+			return fmt.Errorf("collection_intervals: interval for %q must not be shorter than collection_interval", kind)
+		}
+	}
+	for kind, selector := range cfg.LabelSelectors {
+		if !cfg.isSupportedKind(kind) {
+			return fmt.Errorf("label_selectors: %q is not a supported kind", kind)
+		}
+		if _, err := labels.Parse(selector); err != nil {
+			return fmt.Errorf("label_selectors: invalid selector for %q: %w", kind, err)
+		}
+	}
+	if cfg.InitialSyncTimeout < 0 {
+		return errors.New("initial_sync_timeout must not be negative")
+	}
+	if cfg.MetadataDebounceInterval < 0 {
+		return errors.New("metadata_debounce_interval must not be negative")
+	}
+	for _, kind := range cfg.CollectedKinds {
+		if !cfg.isSupportedKind(kind) {
+			return fmt.Errorf("collected_kinds: %q is not a supported kind", kind)
+		}
+	}
+	if cfg.Namespace != "" && (len(cfg.NamespaceInclude) > 0 || len(cfg.NamespaceExclude) > 0) {
+		return errors.New("namespace can't be combined with namespace_include or namespace_exclude")
+	}
+	if err := metadata.ValidateKeyPatterns(cfg.MetadataLabels); err != nil {
+		return fmt.Errorf("invalid metadata_labels: %w", err)
+	}
+	if err := metadata.ValidateKeyPatterns(cfg.MetadataAnnotations); err != nil {
+		return fmt.Errorf("invalid metadata_annotations: %w", err)
+	}
+	if cfg.ClusterName != "" && len(cfg.Clusters) > 0 {
+		return errors.New("cluster_name can't be combined with clusters, set the name of each cluster instead")
+	}
+	clusterNames := make(map[string]struct{}, len(cfg.Clusters))
+	for _, c := range cfg.Clusters {
+		if c.Name == "" {
+			return errors.New("clusters: name must be set")
+		}
+		if _, ok := clusterNames[c.Name]; ok {
+			return fmt.Errorf("clusters: %q is configured more than once", c.Name)
+		}
+		clusterNames[c.Name] = struct{}{}
+		if err := c.APIConfig.Validate(); err != nil {
+			return fmt.Errorf("clusters: invalid config for %q: %w", c.Name, err)
+		}
+	}
+	seen := make(map[schema.GroupVersionKind]struct{}, len(cfg.CustomResources))
+	for _, cr := range cfg.CustomResources {
+		if cr.Version == "" || cr.Kind == "" {
+			return errors.New("custom_resources: version and kind must be set")
+		}
+		if _, ok := seen[cr.groupVersionKind()]; ok {
+			return fmt.Errorf("custom_resources: %q is configured more than once", cr.groupVersionKind().String())
+		}
+		seen[cr.groupVersionKind()] = struct{}{}
+	}
 	return nil
 }
+
+// supportedConfigKinds are the kinds supported as collection_intervals and label_selectors keys,
+// and in collected_kinds, besides the kinds of custom resources.
+var supportedConfigKinds = map[string]struct{}{
+	"pod":                     {},
+	"node":                    {},
+	"namespace":               {},
+	"replicationcontroller":   {},
+	"resourcequota":           {},
+	"service":                 {},
+	"persistentvolume":        {},
+	"persistentvolumeclaim":   {},
+	"limitrange":              {},
+	"deployment":              {},
+	"replicaset":              {},
+	"daemonset":               {},
+	"statefulset":             {},
+	"job":                     {},
+	"cronjob":                 {},
+	"horizontalpodautoscaler": {},
+	"endpointslice":           {},
+	"poddisruptionbudget":     {},
+	"ingress":                 {},
+	"clusterresourcequota":    {},
+	"endpoints":               {},
+	"secret":                  {},
+	"serviceaccount":          {},
+	"priorityclass":           {},
+	"storageclass":            {},
+	"lease":                   {},
+	"verticalpodautoscaler":   {},
+	"deploymentconfig":        {},
+}
+
+// isSupportedKind returns whether the given lowercase kind is one of supportedConfigKinds or the
+// kind of one of the configured custom resources.
+func (cfg *Config) isSupportedKind(kind string) bool {
+	if _, ok := supportedConfigKinds[kind]; ok {
+		return true
+	}
+	for _, cr := range cfg.CustomResources {
+		if strings.ToLower(cr.Kind) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// collectsKind returns whether the given kind is watched according to CollectedKinds.
+func (cfg *Config) collectsKind(kind string) bool {
+	if len(cfg.CollectedKinds) == 0 {
+		return true
+	}
+	for _, k := range cfg.CollectedKinds {
+		if k == strings.ToLower(kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectionsPerKind converts CollectionIntervals to every how many collections each kind is recorded.
+func (cfg *Config) collectionsPerKind() map[string]int {
+	if len(cfg.CollectionIntervals) == 0 || cfg.CollectionInterval <= 0 {
+		return nil
+	}
+	out := make(map[string]int, len(cfg.CollectionIntervals))
+	for kind, interval := range cfg.CollectionIntervals {
+		out[kind] = int(math.Round(float64(interval) / float64(cfg.CollectionInterval)))
+	}
+	return out
+}
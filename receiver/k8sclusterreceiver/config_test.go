@@ -37,14 +37,27 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				Distribution:               distributionKubernetes,
 				CollectionInterval:         30 * time.Second,
+				CollectionIntervals:        map[string]time.Duration{"node": 60 * time.Second},
 				NodeConditionTypesToReport: []string{"Ready", "MemoryPressure"},
 				AllocatableTypesToReport:   []string{"cpu", "memory"},
 				MetadataExporters:          []string{"nop"},
 				APIConfig: k8sconfig.APIConfig{
 					AuthType: k8sconfig.AuthTypeServiceAccount,
+					Impersonate: k8sconfig.ImpersonateConfig{
+						User:   "system:serviceaccount:monitoring:otel-reader",
+						Groups: []string{"system:serviceaccounts"},
+					},
 				},
 				MetadataCollectionInterval: 30 * time.Minute,
-				MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
+				InitialSyncTimeout:         20 * time.Minute,
+				MetadataDebounceInterval:   time.Second,
+				MetadataLabels:             []string{"app", "app.kubernetes.io/*"},
+				MetadataAnnotations:        []string{"team"},
+				NamespaceExclude:           []string{"kube-system"},
+				CustomResources: []CustomResourceConfig{
+					{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
@@ -57,9 +70,21 @@ func TestLoadConfig(t *testing.T) {
 					AuthType: k8sconfig.AuthTypeServiceAccount,
 				},
 				MetadataCollectionInterval: 5 * time.Minute,
+				InitialSyncTimeout:         10 * time.Minute,
 				MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "clusters"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Clusters = []ClusterConfig{
+					{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "prod-eu"}, Name: "prod-eu"},
+					{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "prod-us"}, Name: "prod-us"},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -87,6 +112,19 @@ func TestInvalidConfig(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "invalid authType for kubernetes: ", err.Error())
 
+	// Impersonated groups without a user
+	cfg = &Config{
+		APIConfig: k8sconfig.APIConfig{
+			AuthType:    k8sconfig.AuthTypeNone,
+			Impersonate: k8sconfig.ImpersonateConfig{Groups: []string{"system:serviceaccounts"}},
+		},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "impersonate: user must be set when groups are impersonated", err.Error())
+
 	// Wrong distro
 	cfg = &Config{
 		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
@@ -96,4 +134,161 @@ func TestInvalidConfig(t *testing.T) {
 	err = component.ValidateConfig(cfg)
 	assert.Error(t, err)
 	assert.Equal(t, "\"wrong\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", err.Error())
+
+	// Wildcard not at the end of a label key
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		MetadataLabels:     []string{"app.*/name"},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "invalid metadata_labels: key pattern \"app.*/name\" must only contain \"*\" as the last character", err.Error())
+
+	// Unknown kind in collection intervals
+	cfg = &Config{
+		APIConfig:           k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:        distributionKubernetes,
+		CollectionInterval:  30 * time.Second,
+		CollectionIntervals: map[string]time.Duration{"nodes": time.Minute},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "collection_intervals: \"nodes\" is not a supported kind", err.Error())
+
+	// Collection interval shorter than the global one
+	cfg.CollectionIntervals = map[string]time.Duration{"node": 10 * time.Second}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "collection_intervals: interval for \"node\" must not be shorter than collection_interval", err.Error())
+
+	// Unknown kind in label selectors
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		LabelSelectors:     map[string]string{"pods": "app=web"},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "label_selectors: \"pods\" is not a supported kind", err.Error())
+
+	// Invalid label selector
+	cfg.LabelSelectors = map[string]string{"pod": "app in (web"}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "label_selectors: invalid selector for \"pod\"")
+
+	// Single namespace combined with a namespace filter
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		Namespace:          "team-a",
+		NamespaceExclude:   []string{"kube-system"},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "namespace can't be combined with namespace_include or namespace_exclude", err.Error())
+
+	// Unsupported collected kind
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		CollectedKinds:     []string{"pod", "Node"},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "collected_kinds: \"Node\" is not a supported kind", err.Error())
+
+	// Opt-in kinds and the kinds of custom resources can be collected
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		CollectedKinds:     []string{"pod", "lease", "endpoints", "certificate"},
+		CustomResources:    []CustomResourceConfig{{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}},
+	}
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	// Negative initial sync timeout
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		InitialSyncTimeout: -time.Minute,
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "initial_sync_timeout must not be negative", err.Error())
+
+	// Negative metadata debounce interval
+	cfg = &Config{
+		APIConfig:                k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:             distributionKubernetes,
+		CollectionInterval:       30 * time.Second,
+		MetadataDebounceInterval: -time.Second,
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "metadata_debounce_interval must not be negative", err.Error())
+
+	// Custom resource without kind
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		CustomResources:    []CustomResourceConfig{{Group: "cert-manager.io", Version: "v1"}},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "custom_resources: version and kind must be set", err.Error())
+
+	// Duplicate custom resource
+	cr := CustomResourceConfig{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+	cfg.CustomResources = []CustomResourceConfig{cr, cr}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "custom_resources: \"cert-manager.io/v1, Kind=Certificate\" is configured more than once", err.Error())
+
+	// Cluster without name
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		Clusters:           []ClusterConfig{{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig}}},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "clusters: name must be set", err.Error())
+
+	// Cluster name combined with clusters
+	cfg.ClusterName = "prod"
+	cfg.Clusters = []ClusterConfig{{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig}, Name: "prod"}}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "cluster_name can't be combined with clusters, set the name of each cluster instead", err.Error())
+	cfg.ClusterName = ""
+
+	// Duplicate cluster
+	c := ClusterConfig{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "prod"}, Name: "prod"}
+	cfg.Clusters = []ClusterConfig{c, c}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "clusters: \"prod\" is configured more than once", err.Error())
+}
+
+func TestCollectionsPerKind(t *testing.T) {
+	cfg := &Config{
+		CollectionInterval: 10 * time.Second,
+		CollectionIntervals: map[string]time.Duration{
+			"node":      60 * time.Second,
+			"namespace": 25 * time.Second,
+			"pod":       10 * time.Second,
+		},
+	}
+	assert.Equal(t, map[string]int{"node": 6, "namespace": 3, "pod": 1}, cfg.collectionsPerKind())
+	assert.Nil(t, (&Config{CollectionInterval: 10 * time.Second}).collectionsPerKind())
 }
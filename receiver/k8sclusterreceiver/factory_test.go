@@ -40,6 +40,7 @@ func TestFactory(t *testing.T) {
 			AuthType: k8sconfig.AuthTypeServiceAccount,
 		},
 		MetadataCollectionInterval: 5 * time.Minute,
+		InitialSyncTimeout:         10 * time.Minute,
 		MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
 	}, rCfg)
 
@@ -74,14 +75,14 @@ func TestFactoryDistributions(t *testing.T) {
 	r := newTestReceiver(t, rCfg)
 	err := r.Start(context.Background(), componenttest.NewNopHost())
 	require.NoError(t, err)
-	require.Nil(t, r.resourceWatcher.osQuotaClient)
+	require.Nil(t, r.clusters[0].resourceWatcher.osQuotaClient)
 
 	// openshift
 	rCfg.Distribution = "openshift"
 	r = newTestReceiver(t, rCfg)
 	err = r.Start(context.Background(), componenttest.NewNopHost())
 	require.NoError(t, err)
-	require.NotNil(t, r.resourceWatcher.osQuotaClient)
+	require.NotNil(t, r.clusters[0].resourceWatcher.osQuotaClient)
 }
 
 func newTestReceiver(t *testing.T, cfg *Config) *kubernetesReceiver {
@@ -90,10 +91,10 @@ func newTestReceiver(t *testing.T, cfg *Config) *kubernetesReceiver {
 	require.NotNil(t, r)
 	rcvr, ok := r.(*kubernetesReceiver)
 	require.True(t, ok)
-	rcvr.resourceWatcher.makeClient = func(_ k8sconfig.APIConfig) (kubernetes.Interface, error) {
+	rcvr.clusters[0].resourceWatcher.makeClient = func(_ k8sconfig.APIConfig) (kubernetes.Interface, error) {
 		return fake.NewSimpleClientset(), nil
 	}
-	rcvr.resourceWatcher.makeOpenShiftQuotaClient = func(_ k8sconfig.APIConfig) (quotaclientset.Interface, error) {
+	rcvr.clusters[0].resourceWatcher.makeOpenShiftQuotaClient = func(_ k8sconfig.APIConfig) (quotaclientset.Interface, error) {
 		return fakeQuota.NewSimpleClientset(), nil
 	}
 	return rcvr
@@ -135,7 +136,7 @@ func TestNewSharedReceiver(t *testing.T) {
 
 	// Verify that the log consumer is correct set.
 	kr = lr.(*sharedcomponent.SharedComponent).Unwrap().(*kubernetesReceiver)
-	assert.Equal(t, lc, kr.resourceWatcher.entityLogConsumer)
+	assert.Equal(t, lc, kr.clusters[0].resourceWatcher.entityLogConsumer)
 
 	// Make sure only one receiver is created both for metrics and logs.
 	assert.Equal(t, mr, lr)
@@ -7,15 +7,23 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/demonset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deploymentconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/ingress"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/namespace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pod"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicaset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/service"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/statefulset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/vpa"
 )
 
 // transformObject transforms the k8s object by removing the data that is not utilized by the receiver.
@@ -38,6 +46,20 @@ func transformObject(object any) (any, error) {
 		return statefulset.Transform(o), nil
 	case *corev1.Service:
 		return service.Transform(o), nil
+	case *corev1.Secret:
+		return namespace.TransformSecret(o), nil
+	case *corev1.ServiceAccount:
+		return namespace.TransformServiceAccount(o), nil
+	case *networkingv1.Ingress:
+		return ingress.Transform(o), nil
+	case *unstructured.Unstructured:
+		switch o.GroupVersionKind() {
+		case gvk.VerticalPodAutoscaler:
+			return vpa.Transform(o), nil
+		case gvk.DeploymentConfig:
+			return deploymentconfig.Transform(o), nil
+		}
+		return customresource.Transform(o), nil
 	}
 	return object, nil
 }
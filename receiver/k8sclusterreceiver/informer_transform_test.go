@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
 )
@@ -36,7 +38,6 @@ func TestTransformObject(t *testing.T) {
 					testutils.NewPodSpecWithContainer("container-name"),
 					testutils.NewPodStatusWithContainer("container-name", "container-id"),
 				)
-				pod.Spec.Containers[0].Image = ""
 				pod.Status.ContainerStatuses[0].State = corev1.ContainerState{}
 				return pod
 			}(),
@@ -100,7 +101,8 @@ func TestTransformObject(t *testing.T) {
 					Selector: map[string]string{
 						"app": "my-app",
 					},
-					Type: corev1.ServiceTypeClusterIP,
+					Type:            corev1.ServiceTypeClusterIP,
+					SessionAffinity: corev1.ServiceAffinityNone,
 				},
 			},
 			want: &corev1.Service{
@@ -108,10 +110,21 @@ func TestTransformObject(t *testing.T) {
 					Selector: map[string]string{
 						"app": "my-app",
 					},
+					Type: corev1.ServiceTypeClusterIP,
 				},
 			},
 			same: false,
 		},
+		{
+			name:   "ingress",
+			object: testutils.NewIngress("1"),
+			want: func() *networkingv1.Ingress {
+				ingress := testutils.NewIngress("1")
+				ingress.Spec.TLS[0].SecretName = ""
+				return ingress
+			}(),
+			same: false,
+		},
 		{
 			// This is a case where we don't transform the object.
 			name:   "hpa",
@@ -119,6 +132,34 @@ func TestTransformObject(t *testing.T) {
 			want:   testutils.NewHPA("1"),
 			same:   true,
 		},
+		{
+			name:   "vpa",
+			object: testutils.NewVPA("1"),
+			want: func() *unstructured.Unstructured {
+				vpa := testutils.NewVPA("1")
+				vpa.SetLabels(nil)
+				unstructured.RemoveNestedField(vpa.Object, "spec", "updatePolicy")
+				unstructured.RemoveNestedField(vpa.Object, "spec", "targetRef", "apiVersion")
+				recommendations, _, _ := unstructured.NestedSlice(vpa.Object, "status", "recommendation", "containerRecommendations")
+				delete(recommendations[0].(map[string]any), "lowerBound")
+				delete(recommendations[0].(map[string]any), "upperBound")
+				_ = unstructured.SetNestedSlice(vpa.Object, recommendations, "status", "recommendation", "containerRecommendations")
+				return vpa
+			}(),
+			same: false,
+		},
+		{
+			name:   "deploymentconfig",
+			object: testutils.NewDeploymentConfig("1"),
+			want: func() *unstructured.Unstructured {
+				dc := testutils.NewDeploymentConfig("1")
+				dc.SetLabels(nil)
+				unstructured.RemoveNestedField(dc.Object, "spec", "selector")
+				unstructured.RemoveNestedField(dc.Object, "status", "replicas")
+				return dc
+			}(),
+			same: false,
+		},
 		{
 			name:   "invalid_type",
 			object: intPtr,
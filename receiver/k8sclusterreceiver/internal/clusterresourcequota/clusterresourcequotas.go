@@ -5,13 +5,24 @@ package clusterresourcequota // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"strings"
+	"time"
 
 	quotav1 "github.com/openshift/api/quota/v1"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/resourcequota"
+)
+
+const (
+	// Keys for cluster resource quota metadata.
+	clusterQuotaCreationTime = "clusterquota.creation_timestamp"
+	clusterQuotaScope        = "openshift.clusterquota.scope"
 )
 
 func RecordMetrics(mb *metadata.MetricsBuilder, crq *quotav1.ClusterResourceQuota, ts pcommon.Timestamp) {
@@ -43,6 +54,27 @@ func RecordMetrics(mb *metadata.MetricsBuilder, crq *quotav1.ClusterResourceQuot
 	mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// GetMetadata returns the metadata of the cluster resource quota, including its labels and scopes.
+func GetMetadata(crq *quotav1.ClusterResourceQuota) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	meta := maps.MergeStringMaps(map[string]string{}, crq.Labels)
+
+	meta[constants.K8sKeyClusterResourceQuotaName] = crq.Name
+	meta[clusterQuotaCreationTime] = crq.GetCreationTimestamp().Format(time.RFC3339)
+	if scope := resourcequota.GetScope(&crq.Spec.Quota); scope != "" {
+		meta[clusterQuotaScope] = scope
+	}
+
+	crqID := experimentalmetricmetadata.ResourceID(crq.UID)
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		crqID: {
+			EntityType:    "openshift.clusterquota",
+			ResourceIDKey: constants.K8sKeyClusterResourceQuotaUID,
+			ResourceID:    crqID,
+			Metadata:      meta,
+		},
+	}
+}
+
 func extractValue(k v1.ResourceName, v resource.Quantity) int64 {
 	val := v.Value()
 	if strings.HasSuffix(string(k), ".cpu") {
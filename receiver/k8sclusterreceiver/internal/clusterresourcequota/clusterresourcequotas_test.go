@@ -8,10 +8,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
@@ -37,3 +40,21 @@ func TestClusterRequestQuotaMetrics(t *testing.T) {
 	),
 	)
 }
+
+func TestClusterRequestQuotaMetadata(t *testing.T) {
+	crq := testutils.NewClusterResourceQuota("1")
+	crq.Spec.Quota.Scopes = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeNotBestEffort}
+
+	actual := GetMetadata(crq)
+	require.Len(t, actual, 1)
+	assert.Equal(t, &metadata.KubernetesMetadata{
+		EntityType:    "openshift.clusterquota",
+		ResourceIDKey: "openshift.clusterquota.uid",
+		ResourceID:    "test-clusterquota-1-uid",
+		Metadata: map[string]string{
+			"openshift.clusterquota.name":     "test-clusterquota-1",
+			"openshift.clusterquota.scope":    "NotBestEffort",
+			"clusterquota.creation_timestamp": "0001-01-01T00:00:00Z",
+		},
+	}, actual[experimentalmetricmetadata.ResourceID("test-clusterquota-1-uid")])
+}
@@ -4,108 +4,291 @@
 package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
 
 import (
+	"runtime"
+	"strings"
+	"sync"
 	"time"
 
-	quotav1 "github.com/openshift/api/quota/v1"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
-	appsv1 "k8s.io/api/apps/v1"
-	autoscalingv2 "k8s.io/api/autoscaling/v2"
-	batchv1 "k8s.io/api/batch/v1"
+	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/clusterresourcequota"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/cronjob"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/demonset"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/hpa"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/namespace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pod"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicaset"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicationcontroller"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/resourcequota"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/statefulset"
 )
 
 // TODO: Consider moving some of these constants to
 // https://go.opentelemetry.io/collector/blob/main/model/semconv/opentelemetry.go.
 
+// recordFunc records the metrics of a single Kubernetes object with the given MetricsBuilder.
+// Metrics that cannot be recorded with the MetricsBuilder are appended to customRMs.
+type recordFunc func(mb *metadata.MetricsBuilder, customRMs pmetric.ResourceMetricsSlice)
+
+// KindRecordFunc records the metrics of obj, an object of the kind it was registered for, with
+// the given MetricsBuilder. Metrics that cannot be recorded with the MetricsBuilder are appended
+// to customRMs. It may be called concurrently for different objects.
+type KindRecordFunc func(mb *metadata.MetricsBuilder, customRMs pmetric.ResourceMetricsSlice, obj any, ts pcommon.Timestamp)
+
+// KindMetadataFunc returns the metadata of obj, an object of the kind it was registered for, by
+// resource ID. The metadata store is used to look up the objects that obj refers to.
+type KindMetadataFunc func(obj any, ms *metadata.Store, logger *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata
+
+// InformerFactories holds the factories a KindInformerFunc creates the informer of its kind with.
+type InformerFactories struct {
+	// Kubernetes creates the informers of the kinds served by the Kubernetes API.
+	Kubernetes informers.SharedInformerFactory
+	// Dynamic creates the informers of the kinds served by CRDs or API extensions. It is nil
+	// if the receiver doesn't watch any such kind.
+	Dynamic dynamicinformer.DynamicSharedInformerFactory
+	// Resource is the resource of the kind as discovered on the API server. It is only set
+	// for the informers created with Dynamic.
+	Resource schema.GroupVersionResource
+}
+
+// KindInformerFunc returns the informer watching the objects of the kind it was registered for.
+type KindInformerFunc func(f InformerFactories) cache.SharedIndexInformer
+
+// Kind holds the functions the receiver handles the objects of a registered kind with. Functions
+// that are not set are skipped: a kind without Record isn't recorded by CollectMetricData and a
+// kind without Metadata doesn't emit metadata.
+type Kind struct {
+	Informer KindInformerFunc
+	Metadata KindMetadataFunc
+	Record   KindRecordFunc
+}
+
+type registeredKind struct {
+	kind schema.GroupVersionKind
+	Kind
+}
+
 // DataCollector emits metrics with CollectMetricData based on the Kubernetes API objects in the metadata store.
 type DataCollector struct {
 	settings                 receiver.CreateSettings
 	metadataStore            *metadata.Store
 	nodeConditionsToReport   []string
 	allocatableTypesToReport []string
-	metricsBuilder           *metadata.MetricsBuilder
+	// metricsBuilders holds one MetricsBuilder per collection worker.
+	metricsBuilders []*metadata.MetricsBuilder
+	// collectionsPerKind holds, by lowercase kind, every how many collections objects of that
+	// kind are recorded. Kinds that are not listed are recorded on every collection.
+	collectionsPerKind map[string]int
+	collections        int
+	// kinds holds the kinds recorded by CollectMetricData, in registration order.
+	kinds []registeredKind
+	// customResources holds the kinds of custom resources that are counted by CollectMetricData.
+	customResources []schema.GroupVersionKind
+	// clusterName is set as the k8s.cluster.name attribute of every emitted resource, unless empty
+	// or the attribute is disabled.
+	clusterName string
+	// nodePodCountEnabled is whether the pods of every node are counted, which requires
+	// cross-referencing the pods and nodes in the metadata store.
+	nodePodCountEnabled bool
+	// requestNodeFractionEnabled is whether the requests of every container are compared to the
+	// allocatable resources of its node, which requires joining the pods and nodes in the metadata store.
+	requestNodeFractionEnabled bool
+	// nodeAllocatable holds the allocatable resources of the nodes by name during a collection
+	// in which the requests of containers are compared to them.
+	nodeAllocatable map[string]corev1.ResourceList
 }
 
 // NewDataCollector returns a DataCollector.
 func NewDataCollector(set receiver.CreateSettings, ms *metadata.Store,
-	metricsBuilderConfig metadata.MetricsBuilderConfig, nodeConditionsToReport, allocatableTypesToReport []string) *DataCollector {
-	return &DataCollector{
+	metricsBuilderConfig metadata.MetricsBuilderConfig, nodeConditionsToReport, allocatableTypesToReport []string,
+	collectionsPerKind map[string]int) *DataCollector {
+	metricsBuilders := make([]*metadata.MetricsBuilder, runtime.GOMAXPROCS(0))
+	for i := range metricsBuilders {
+		metricsBuilders[i] = metadata.NewMetricsBuilder(metricsBuilderConfig, set)
+	}
+	dc := &DataCollector{
 		settings:                 set,
 		metadataStore:            ms,
 		nodeConditionsToReport:   nodeConditionsToReport,
 		allocatableTypesToReport: allocatableTypesToReport,
-		metricsBuilder:           metadata.NewMetricsBuilder(metricsBuilderConfig, set),
+		metricsBuilders:          metricsBuilders,
+		collectionsPerKind:       collectionsPerKind,
+		nodePodCountEnabled:      metricsBuilderConfig.Metrics.K8sNodePodCount.Enabled,
+		requestNodeFractionEnabled: metricsBuilderConfig.Metrics.K8sContainerCPURequestNodeFraction.Enabled ||
+			metricsBuilderConfig.Metrics.K8sContainerMemoryRequestNodeFraction.Enabled,
+	}
+	dc.registerBuiltinKinds()
+	return dc
+}
+
+// RegisterKind adds a kind to be handled with the functions of k. Its Record function is called
+// by CollectMetricData for all objects of the kind in the metadata store. Kinds are recorded in
+// registration order, after the built-in kinds. Registering a kind again replaces its functions.
+// It must not be called concurrently with CollectMetricData.
+func (dc *DataCollector) RegisterKind(kind schema.GroupVersionKind, k Kind) {
+	for i := range dc.kinds {
+		if dc.kinds[i].kind == kind {
+			dc.kinds[i].Kind = k
+			return
+		}
 	}
+	dc.kinds = append(dc.kinds, registeredKind{kind: kind, Kind: k})
 }
 
+// Kind returns the functions kind was registered with and whether it was registered.
+func (dc *DataCollector) Kind(kind schema.GroupVersionKind) (Kind, bool) {
+	for _, k := range dc.kinds {
+		if k.kind == kind {
+			return k.Kind, true
+		}
+	}
+	return Kind{}, false
+}
+
+// RegisterCustomResource adds a kind of custom resources whose number is recorded by CollectMetricData.
+// Custom resources are only counted if the kind is set up in the metadata store. It must not be called
+// concurrently with CollectMetricData.
+func (dc *DataCollector) RegisterCustomResource(kind schema.GroupVersionKind) {
+	dc.customResources = append(dc.customResources, kind)
+}
+
+// SetClusterName sets the name of the cluster reported as the k8s.cluster.name attribute of every
+// emitted resource. The attribute is not set if name is empty or the attribute is disabled. It must
+// not be called concurrently with CollectMetricData.
+func (dc *DataCollector) SetClusterName(name string) {
+	dc.clusterName = name
+}
+
+// CollectMetricData records metrics for all objects in the metadata store. The objects are split
+// into contiguous chunks that are recorded concurrently, one chunk per MetricsBuilder, and the
+// results are merged in chunk order so the output is the same as recording them serially.
 func (dc *DataCollector) CollectMetricData(currentTime time.Time) pmetric.Metrics {
 	ts := pcommon.NewTimestampFromTime(currentTime)
-	customRMs := pmetric.NewResourceMetricsSlice()
-
-	dc.metadataStore.ForEach(gvk.Pod, func(o any) {
-		pod.RecordMetrics(dc.settings.Logger, dc.metricsBuilder, o.(*corev1.Pod), ts)
-	})
-	dc.metadataStore.ForEach(gvk.Node, func(o any) {
-		crm := node.CustomMetrics(dc.settings, dc.metricsBuilder.NewResourceBuilder(), o.(*corev1.Node),
-			dc.nodeConditionsToReport, dc.allocatableTypesToReport, ts)
-		if crm.ScopeMetrics().Len() > 0 {
-			crm.MoveTo(customRMs.AppendEmpty())
+	// The nodes are looked up before recording the pods concurrently, which only read them.
+	dc.nodeAllocatable = nil
+	if dc.requestNodeFractionEnabled && dc.isDue(gvk.Pod) {
+		dc.nodeAllocatable = map[string]corev1.ResourceList{}
+		dc.metadataStore.ForEach(gvk.Node, func(o any) {
+			n := o.(*corev1.Node)
+			dc.nodeAllocatable[n.Name] = n.Status.Allocatable
+		})
+	}
+	var records []recordFunc
+	for _, k := range dc.kinds {
+		if k.Record == nil {
+			continue
 		}
-		node.RecordMetrics(dc.metricsBuilder, o.(*corev1.Node), ts)
-	})
-	dc.metadataStore.ForEach(gvk.Namespace, func(o any) {
-		namespace.RecordMetrics(dc.metricsBuilder, o.(*corev1.Namespace), ts)
-	})
-	dc.metadataStore.ForEach(gvk.ReplicationController, func(o any) {
-		replicationcontroller.RecordMetrics(dc.metricsBuilder, o.(*corev1.ReplicationController), ts)
-	})
-	dc.metadataStore.ForEach(gvk.ResourceQuota, func(o any) {
-		resourcequota.RecordMetrics(dc.metricsBuilder, o.(*corev1.ResourceQuota), ts)
-	})
-	dc.metadataStore.ForEach(gvk.Deployment, func(o any) {
-		deployment.RecordMetrics(dc.metricsBuilder, o.(*appsv1.Deployment), ts)
-	})
-	dc.metadataStore.ForEach(gvk.ReplicaSet, func(o any) {
-		replicaset.RecordMetrics(dc.metricsBuilder, o.(*appsv1.ReplicaSet), ts)
-	})
-	dc.metadataStore.ForEach(gvk.DaemonSet, func(o any) {
-		demonset.RecordMetrics(dc.metricsBuilder, o.(*appsv1.DaemonSet), ts)
-	})
-	dc.metadataStore.ForEach(gvk.StatefulSet, func(o any) {
-		statefulset.RecordMetrics(dc.metricsBuilder, o.(*appsv1.StatefulSet), ts)
-	})
-	dc.metadataStore.ForEach(gvk.Job, func(o any) {
-		jobs.RecordMetrics(dc.metricsBuilder, o.(*batchv1.Job), ts)
-	})
-	dc.metadataStore.ForEach(gvk.CronJob, func(o any) {
-		cronjob.RecordMetrics(dc.metricsBuilder, o.(*batchv1.CronJob), ts)
-	})
-	dc.metadataStore.ForEach(gvk.HorizontalPodAutoscaler, func(o any) {
-		hpa.RecordMetrics(dc.metricsBuilder, o.(*autoscalingv2.HorizontalPodAutoscaler), ts)
+		record := k.Record
+		dc.forEach(k.kind, func(o any) {
+			records = append(records, func(mb *metadata.MetricsBuilder, customRMs pmetric.ResourceMetricsSlice) {
+				record(mb, customRMs, o, ts)
+			})
+		})
+	}
+
+	workers := len(dc.metricsBuilders)
+	if workers > len(records) {
+		workers = len(records)
+	}
+	customRMs := make([]pmetric.ResourceMetricsSlice, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		customRMs[w] = pmetric.NewResourceMetricsSlice()
+		chunk := records[w*len(records)/workers : (w+1)*len(records)/workers]
+		wg.Add(1)
+		go func(mb *metadata.MetricsBuilder, customRMs pmetric.ResourceMetricsSlice) {
+			defer wg.Done()
+			for _, record := range chunk {
+				record(mb, customRMs)
+			}
+		}(dc.metricsBuilders[w], customRMs[w])
+	}
+	wg.Wait()
+
+	var pods []*corev1.Pod
+	if dc.isDue(gvk.Pod) || dc.nodePodCountEnabled {
+		dc.metadataStore.ForEach(gvk.Pod, func(o any) {
+			pods = append(pods, o.(*corev1.Pod))
+		})
+	}
+	if dc.isDue(gvk.Pod) {
+		pod.RecordClusterMetrics(dc.metricsBuilders[0], pods, ts)
+	}
+	if dc.nodePodCountEnabled && dc.isDue(gvk.Node) {
+		var nodes []*corev1.Node
+		dc.metadataStore.ForEach(gvk.Node, func(o any) {
+			nodes = append(nodes, o.(*corev1.Node))
+		})
+		node.RecordPodCounts(dc.metricsBuilders[0], nodes, pods, ts)
+	}
+	var secrets []*corev1.Secret
+	dc.forEach(gvk.Secret, func(o any) {
+		secrets = append(secrets, o.(*corev1.Secret))
 	})
-	dc.metadataStore.ForEach(gvk.ClusterResourceQuota, func(o any) {
-		clusterresourcequota.RecordMetrics(dc.metricsBuilder, o.(*quotav1.ClusterResourceQuota), ts)
+	var serviceAccounts []*corev1.ServiceAccount
+	dc.forEach(gvk.ServiceAccount, func(o any) {
+		serviceAccounts = append(serviceAccounts, o.(*corev1.ServiceAccount))
 	})
+	if len(secrets) > 0 || len(serviceAccounts) > 0 {
+		namespace.RecordObjectCounts(dc.metricsBuilders[0], secrets, serviceAccounts, ts)
+	}
+	for _, kind := range dc.customResources {
+		if !dc.isDue(kind) || dc.metadataStore.Get(kind) == nil {
+			continue
+		}
+		var count int
+		dc.metadataStore.ForEach(kind, func(any) {
+			count++
+		})
+		customresource.RecordCount(dc.metricsBuilders[0], kind, count, ts)
+	}
 
-	m := dc.metricsBuilder.Emit()
-	customRMs.MoveAndAppendTo(m.ResourceMetrics())
+	// The destination is sized upfront so merging the results doesn't grow it repeatedly.
+	rmss := make([]pmetric.ResourceMetricsSlice, 0, len(dc.metricsBuilders)+workers)
+	for _, mb := range dc.metricsBuilders {
+		rmss = append(rmss, mb.Emit().ResourceMetrics())
+	}
+	rmss = append(rmss, customRMs...)
+	var total int
+	for _, rms := range rmss {
+		total += rms.Len()
+	}
+	m := pmetric.NewMetrics()
+	m.ResourceMetrics().EnsureCapacity(total)
+	for _, rms := range rmss {
+		rms.MoveAndAppendTo(m.ResourceMetrics())
+	}
+	if dc.clusterName != "" {
+		// The attribute is set through a ResourceBuilder, so that it is not set if it's disabled.
+		rb := dc.metricsBuilders[0].NewResourceBuilder()
+		rb.SetK8sClusterName(dc.clusterName)
+		clusterAttrs := rb.Emit().Attributes()
+		for i := 0; i < m.ResourceMetrics().Len(); i++ {
+			attrs := m.ResourceMetrics().At(i).Resource().Attributes()
+			clusterAttrs.Range(func(k string, v pcommon.Value) bool {
+				v.CopyTo(attrs.PutEmpty(k))
+				return true
+			})
+		}
+	}
+	dc.collections++
 	return m
 }
+
+// forEach calls f for all objects of the given kind if the kind is due in the current collection.
+func (dc *DataCollector) forEach(kind schema.GroupVersionKind, f func(o any)) {
+	if dc.isDue(kind) {
+		dc.metadataStore.ForEach(kind, f)
+	}
+}
+
+// isDue returns whether objects of the given kind are recorded in the current collection.
+func (dc *DataCollector) isDue(kind schema.GroupVersionKind) bool {
+	n, ok := dc.collectionsPerKind[strings.ToLower(kind.Kind)]
+	return !ok || n <= 1 || dc.collections%n == 0
+}
@@ -4,11 +4,23 @@
 package collection
 
 import (
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
@@ -60,6 +72,34 @@ func TestCollectMetricData(t *testing.T) {
 	})
 	expectedRMs++
 
+	ms.Setup(gvk.Service, &testutils.MockStore{
+		Cache: map[string]any{
+			"service1-uid": testutils.NewService("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.PersistentVolume, &testutils.MockStore{
+		Cache: map[string]any{
+			"persistentvolume1-uid": testutils.NewPersistentVolume("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.PersistentVolumeClaim, &testutils.MockStore{
+		Cache: map[string]any{
+			"persistentvolumeclaim1-uid": testutils.NewPersistentVolumeClaim("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.LimitRange, &testutils.MockStore{
+		Cache: map[string]any{
+			"limitrange1-uid": testutils.NewLimitRange("1"),
+		},
+	})
+	expectedRMs++
+
 	ms.Setup(gvk.Deployment, &testutils.MockStore{
 		Cache: map[string]any{
 			"deployment1-uid": testutils.NewDeployment("1"),
@@ -102,6 +142,13 @@ func TestCollectMetricData(t *testing.T) {
 	})
 	expectedRMs++
 
+	ms.Setup(gvk.CronJobBeta, &testutils.MockStore{
+		Cache: map[string]any{
+			"cronjob2-uid": testutils.NewCronJobBeta("2"),
+		},
+	})
+	expectedRMs++
+
 	ms.Setup(gvk.HorizontalPodAutoscaler, &testutils.MockStore{
 		Cache: map[string]any{
 			"horizontalpodautoscaler1-uid": testutils.NewHPA("1"),
@@ -109,14 +156,566 @@ func TestCollectMetricData(t *testing.T) {
 	})
 	expectedRMs++
 
-	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil)
-	m1 := dc.CollectMetricData(time.Now())
+	ms.Setup(gvk.HorizontalPodAutoscalerBeta, &testutils.MockStore{
+		Cache: map[string]any{
+			"horizontalpodautoscaler2-uid": testutils.NewHPABeta("2"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.EndpointSlice, &testutils.MockStore{
+		Cache: map[string]any{
+			"endpointslice1-uid": testutils.NewEndpointSlice("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.Endpoints, &testutils.MockStore{
+		Cache: map[string]any{
+			"endpoints1-uid": testutils.NewEndpoints("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.PodDisruptionBudget, &testutils.MockStore{
+		Cache: map[string]any{
+			"pdb1-uid": testutils.NewPodDisruptionBudget("1"),
+		},
+	})
+	expectedRMs++
+
+	ms.Setup(gvk.Ingress, &testutils.MockStore{
+		Cache: map[string]any{
+			"ingress1-uid": testutils.NewIngress("1"),
+		},
+	})
+	expectedRMs++
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPersistentvolumeCapacity.Enabled = true
+	mbc.Metrics.K8sPersistentvolumePhase.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeclaimPhase.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeclaimRequestedStorage.Enabled = true
+	mbc.Metrics.K8sEndpointsliceAddressCount.Enabled = true
+	mbc.Metrics.K8sEndpointsliceReadyCount.Enabled = true
+	mbc.Metrics.K8sEndpointsAddressCount.Enabled = true
+	mbc.Metrics.K8sEndpointsNotReadyAddressCount.Enabled = true
+	mbc.Metrics.K8sLimitrangeDefaultCPURequest.Enabled = true
+	mbc.Metrics.K8sLimitrangeDefaultMemoryRequest.Enabled = true
+	mbc.Metrics.K8sLimitrangeMax.Enabled = true
+	mbc.Metrics.K8sLimitrangeMin.Enabled = true
+	mbc.Metrics.K8sPdbCurrentHealthy.Enabled = true
+	mbc.Metrics.K8sPdbDesiredHealthy.Enabled = true
+	mbc.Metrics.K8sPdbExpectedPods.Enabled = true
+	mbc.Metrics.K8sPdbDisruptionsAllowed.Enabled = true
+	mbc.Metrics.K8sIngressRuleCount.Enabled = true
+	mbc.Metrics.K8sIngressTLSCount.Enabled = true
+	mbc.Metrics.K8sServicePortCount.Enabled = true
+	mbc.Metrics.K8sServiceType.Enabled = true
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, mbc, []string{"Ready"}, nil, nil)
+	collectionTime := time.Now()
+	m1 := dc.CollectMetricData(collectionTime)
 
 	// Verify number of resource metrics only, content is tested in other tests.
 	assert.Equal(t, expectedRMs, m1.ResourceMetrics().Len())
 
+	// All data points of a collection share the collection time.
+	assertDataPointTimestamps(t, m1, pcommon.NewTimestampFromTime(collectionTime))
+
+	// The legacy "k8s"/"container" type resource attribute is not emitted.
+	for i := 0; i < m1.ResourceMetrics().Len(); i++ {
+		_, ok := m1.ResourceMetrics().At(i).Resource().Attributes().Get("type")
+		assert.False(t, ok)
+	}
+
 	m2 := dc.CollectMetricData(time.Now())
 
 	// Second scrape should be the same as the first one except for the timestamp.
 	assert.NoError(t, pmetrictest.CompareMetrics(m1, m2, pmetrictest.IgnoreTimestamp(), pmetrictest.IgnoreResourceMetricsOrder()))
 }
+
+func assertDataPointTimestamps(t *testing.T, m pmetric.Metrics, want pcommon.Timestamp) {
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		sms := m.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				var dps pmetric.NumberDataPointSlice
+				//exhaustive:ignore
+				switch ms.At(k).Type() {
+				case pmetric.MetricTypeGauge:
+					dps = ms.At(k).Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dps = ms.At(k).Sum().DataPoints()
+				default:
+					require.Fail(t, "unexpected metric type", ms.At(k).Name())
+				}
+				for l := 0; l < dps.Len(); l++ {
+					assert.Equal(t, want, dps.At(l).Timestamp(), ms.At(k).Name())
+				}
+			}
+		}
+	}
+}
+
+func TestCollectMetricDataDisabledContainerMetrics(t *testing.T) {
+	ms := metadata.NewStore()
+	ms.Setup(gvk.Pod, &testutils.MockStore{
+		Cache: map[string]any{
+			"pod1-uid": testutils.NewPodWithContainer(
+				"1",
+				testutils.NewPodSpecWithContainer("container-name"),
+				testutils.NewPodStatusWithContainer("container-name", "container-id"),
+			),
+		},
+	})
+
+	// Metrics are enabled and disabled individually through the metrics config, e.g. to drop
+	// all k8s.container.* metrics while keeping the pod metrics.
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerCPURequest.Enabled = false
+	mbc.Metrics.K8sContainerCPULimit.Enabled = false
+	mbc.Metrics.K8sContainerMemoryRequest.Enabled = false
+	mbc.Metrics.K8sContainerMemoryLimit.Enabled = false
+	mbc.Metrics.K8sContainerStorageRequest.Enabled = false
+	mbc.Metrics.K8sContainerStorageLimit.Enabled = false
+	mbc.Metrics.K8sContainerEphemeralstorageRequest.Enabled = false
+	mbc.Metrics.K8sContainerEphemeralstorageLimit.Enabled = false
+	mbc.Metrics.K8sContainerRestarts.Enabled = false
+	mbc.Metrics.K8sContainerReady.Enabled = false
+
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, mbc, nil, nil, nil)
+	m := dc.CollectMetricData(time.Now())
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	_, ok := m.ResourceMetrics().At(0).Resource().Attributes().Get("k8s.container.name")
+	assert.False(t, ok)
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	assert.Equal(t, "k8s.pod.phase", metrics.At(0).Name())
+}
+
+func TestCollectMetricDataEmptyStore(t *testing.T) {
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), metadata.NewStore(), metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil, nil)
+	m := dc.CollectMetricData(time.Now())
+	assert.Equal(t, 0, m.ResourceMetrics().Len())
+	assert.Equal(t, 0, m.DataPointCount())
+}
+
+func TestCollectMetricDataRegisteredKind(t *testing.T) {
+	widget := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	ms := metadata.NewStore()
+	ms.Setup(widget, &testutils.MockStore{
+		Cache: map[string]any{
+			"widget1-uid": "widget-1",
+			"widget2-uid": "widget-2",
+		},
+	})
+
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), nil, nil, nil)
+	dc.RegisterKind(widget, Kind{
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			mb.RecordK8sDeploymentDesiredDataPoint(ts, 1)
+			rb := mb.NewResourceBuilder()
+			rb.SetK8sDeploymentName(o.(string))
+			mb.EmitForResource(metadata.WithResource(rb.Emit()))
+		},
+	})
+	_, ok := dc.Kind(widget)
+	assert.True(t, ok)
+	m := dc.CollectMetricData(time.Now())
+
+	var names []string
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		name, ok := m.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.deployment.name")
+		assert.True(t, ok)
+		names = append(names, name.Str())
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{"widget-1", "widget-2"}, names)
+}
+
+func TestCollectMetricDataCustomResources(t *testing.T) {
+	certificate := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+	issuer := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Issuer"}
+	ms := metadata.NewStore()
+	ms.Setup(certificate, &testutils.MockStore{
+		Cache: map[string]any{
+			"cert1-uid": &unstructured.Unstructured{},
+			"cert2-uid": &unstructured.Unstructured{},
+		},
+	})
+
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), nil, nil, nil)
+	dc.RegisterCustomResource(certificate)
+	// Not set up in the metadata store, e.g. not served by the cluster.
+	dc.RegisterCustomResource(issuer)
+	m := dc.CollectMetricData(time.Now())
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	testutils.AssertMetricInt(t, metrics.At(0), "k8s.custom_resource.count", pmetric.MetricTypeGauge, 2)
+	kind, ok := metrics.At(0).Gauge().DataPoints().At(0).Attributes().Get("kind")
+	require.True(t, ok)
+	assert.Equal(t, "Certificate", kind.Str())
+}
+
+func TestCollectMetricDataVerticalPodAutoscalers(t *testing.T) {
+	ms := metadata.NewStore()
+	ms.Setup(gvk.VerticalPodAutoscaler, &testutils.MockStore{
+		Cache: map[string]any{
+			"test-vpa-1-uid": testutils.NewVPA("1"),
+		},
+	})
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sVpaTargetMemory.Enabled = true
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, mbc, nil, nil, nil)
+	m := dc.CollectMetricData(time.Now())
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	name, ok := rm.Resource().Attributes().Get("k8s.vpa.name")
+	require.True(t, ok)
+	assert.Equal(t, "test-vpa-1", name.Str())
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	testutils.AssertMetricInt(t, metrics.At(0), "k8s.vpa.target_memory", pmetric.MetricTypeGauge, 256*1024*1024)
+}
+
+func TestCollectMetricDataObjectCounts(t *testing.T) {
+	ms := metadata.NewStore()
+	ms.SetNamespaceFilter(metadata.NewNamespaceFilter(nil, []string{"kube-system"}))
+	ms.Setup(gvk.Secret, &testutils.MockStore{
+		Cache: map[string]any{
+			"secret1-uid": &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}, Type: corev1.SecretTypeOpaque},
+			"secret2-uid": &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system"}, Type: corev1.SecretTypeOpaque},
+		},
+	})
+	ms.Setup(gvk.ServiceAccount, &testutils.MockStore{
+		Cache: map[string]any{
+			"sa1-uid": &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}},
+		},
+	})
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sNamespaceSecretCount.Enabled = true
+	mbc.Metrics.K8sNamespaceServiceaccountCount.Enabled = true
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, mbc, nil, nil, nil)
+	m := dc.CollectMetricData(time.Now())
+
+	// Secrets in excluded namespaces are not counted.
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	ns, ok := rm.Resource().Attributes().Get("k8s.namespace.name")
+	require.True(t, ok)
+	assert.Equal(t, "default", ns.Str())
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	metrics.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, metrics.At(0), "k8s.namespace.secret.count", pmetric.MetricTypeGauge, 1)
+	testutils.AssertMetricInt(t, metrics.At(1), "k8s.namespace.serviceaccount.count", pmetric.MetricTypeGauge, 1)
+}
+
+func TestCollectMetricDataClusterName(t *testing.T) {
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), newPodsStore(2), metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil, nil)
+	m := dc.CollectMetricData(time.Now())
+	require.Greater(t, m.ResourceMetrics().Len(), 0)
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		_, ok := m.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.cluster.name")
+		assert.False(t, ok)
+	}
+
+	dc.SetClusterName("prod")
+	m = dc.CollectMetricData(time.Now())
+	require.Greater(t, m.ResourceMetrics().Len(), 0)
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		name, ok := m.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.cluster.name")
+		require.True(t, ok)
+		assert.Equal(t, "prod", name.Str())
+	}
+
+	// The attribute can be disabled like the other resource attributes.
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.ResourceAttributes.K8sClusterName.Enabled = false
+	dc = NewDataCollector(receivertest.NewNopCreateSettings(), newPodsStore(2), mbc, []string{"Ready"}, nil, nil)
+	dc.SetClusterName("prod")
+	m = dc.CollectMetricData(time.Now())
+	require.Greater(t, m.ResourceMetrics().Len(), 0)
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		_, ok := m.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.cluster.name")
+		assert.False(t, ok)
+	}
+}
+
+func TestCollectMetricDataNodePodCount(t *testing.T) {
+	pods := map[string]any{}
+	for i := 0; i < 3; i++ {
+		id := strconv.Itoa(i)
+		p := testutils.NewPodWithContainer(
+			id,
+			testutils.NewPodSpecWithContainer("container-name"),
+			testutils.NewPodStatusWithContainer("container-name", "container-id"),
+		)
+		p.Spec.NodeName = "test-node-1"
+		pods["pod"+id+"-uid"] = p
+	}
+	ms := metadata.NewStore()
+	ms.Setup(gvk.Pod, &testutils.MockStore{Cache: pods})
+	ms.Setup(gvk.Node, &testutils.MockStore{
+		Cache: map[string]any{
+			"node1-uid": testutils.NewNode("1"),
+			"node2-uid": testutils.NewNode("2"),
+		},
+	})
+
+	podCounts := func(m pmetric.Metrics) map[string]int64 {
+		counts := map[string]int64{}
+		for i := 0; i < m.ResourceMetrics().Len(); i++ {
+			rm := m.ResourceMetrics().At(i)
+			for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+				ms := rm.ScopeMetrics().At(j).Metrics()
+				for k := 0; k < ms.Len(); k++ {
+					if ms.At(k).Name() != "k8s.node.pod_count" {
+						continue
+					}
+					name, ok := rm.Resource().Attributes().Get("k8s.node.name")
+					require.True(t, ok)
+					counts[name.Str()] = ms.At(k).Gauge().DataPoints().At(0).IntValue()
+				}
+			}
+		}
+		return counts
+	}
+
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), nil, nil, nil)
+	assert.Empty(t, podCounts(dc.CollectMetricData(time.Now())))
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sNodePodCount.Enabled = true
+	dc = NewDataCollector(receivertest.NewNopCreateSettings(), ms, mbc, nil, nil, nil)
+	// Nodes without pods report zero.
+	assert.Equal(t, map[string]int64{"test-node-1": 3, "test-node-2": 0}, podCounts(dc.CollectMetricData(time.Now())))
+}
+
+func TestCollectMetricDataContainerRequestNodeFraction(t *testing.T) {
+	scheduled := testutils.NewPodWithContainer(
+		"1",
+		testutils.NewPodSpecWithContainer("container-name"),
+		testutils.NewPodStatusWithContainer("container-name", "container-id"),
+	)
+	scheduled.Spec.NodeName = "test-node-1"
+	scheduled.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewMilliQuantity(41, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(114, resource.DecimalSI),
+	}
+	// Pods scheduled to a node that's not in the cache are skipped.
+	unknownNode := testutils.NewPodWithContainer(
+		"2",
+		testutils.NewPodSpecWithContainer("container-name"),
+		testutils.NewPodStatusWithContainer("container-name", "container-id"),
+	)
+	unknownNode.Spec.NodeName = "test-node-unknown"
+	ms := metadata.NewStore()
+	ms.Setup(gvk.Pod, &testutils.MockStore{Cache: map[string]any{
+		"pod1-uid": scheduled,
+		"pod2-uid": unknownNode,
+	}})
+	ms.Setup(gvk.Node, &testutils.MockStore{Cache: map[string]any{"node1-uid": testutils.NewNode("1")}})
+
+	fractions := func(m pmetric.Metrics) map[string]float64 {
+		values := map[string]float64{}
+		for i := 0; i < m.ResourceMetrics().Len(); i++ {
+			rm := m.ResourceMetrics().At(i)
+			for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+				ms := rm.ScopeMetrics().At(j).Metrics()
+				for k := 0; k < ms.Len(); k++ {
+					if !strings.HasSuffix(ms.At(k).Name(), "_request_node_fraction") {
+						continue
+					}
+					name, ok := rm.Resource().Attributes().Get("k8s.pod.name")
+					require.True(t, ok)
+					values[name.Str()+"/"+ms.At(k).Name()] = ms.At(k).Gauge().DataPoints().At(0).DoubleValue()
+				}
+			}
+		}
+		return values
+	}
+
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), nil, nil, nil)
+	assert.Empty(t, fractions(dc.CollectMetricData(time.Now())))
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerCPURequestNodeFraction.Enabled = true
+	mbc.Metrics.K8sContainerMemoryRequestNodeFraction.Enabled = true
+	dc = NewDataCollector(receivertest.NewNopCreateSettings(), ms, mbc, nil, nil, nil)
+	m := dc.CollectMetricData(time.Now())
+	got := fractions(m)
+	// test-node-1 has 123m allocatable CPU and 456 bytes allocatable memory.
+	require.Len(t, got, 2)
+	assert.InDelta(t, 41.0/123.0, got["test-pod-1/k8s.container.cpu_request_node_fraction"], 1e-9)
+	assert.InDelta(t, 0.25, got["test-pod-1/k8s.container.memory_request_node_fraction"], 1e-9)
+
+	// The fractions are recorded with the other metrics of the container, in a single resource.
+	var containerResources int
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		attrs := m.ResourceMetrics().At(i).Resource().Attributes()
+		podName, _ := attrs.Get("k8s.pod.name")
+		if _, ok := attrs.Get("k8s.container.name"); ok && podName.Str() == "test-pod-1" {
+			containerResources++
+		}
+	}
+	assert.Equal(t, 1, containerResources)
+}
+
+func newPodsStore(n int) *metadata.Store {
+	cache := make(map[string]any, n)
+	for i := 0; i < n; i++ {
+		id := strconv.Itoa(i)
+		cache["pod"+id+"-uid"] = testutils.NewPodWithContainer(
+			id,
+			testutils.NewPodSpecWithContainer("container-name"),
+			testutils.NewPodStatusWithContainer("container-name", "container-id"),
+		)
+	}
+	ms := metadata.NewStore()
+	ms.Setup(gvk.Pod, &testutils.MockStore{Cache: cache})
+	ms.Setup(gvk.Node, &testutils.MockStore{
+		Cache: map[string]any{
+			"node1-uid": testutils.NewNode("1"),
+		},
+	})
+	return ms
+}
+
+func TestCollectMetricDataParallelMatchesSerial(t *testing.T) {
+	ms := newPodsStore(100)
+
+	serial := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil, nil)
+	serial.metricsBuilders = serial.metricsBuilders[:1]
+	parallel := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil, nil)
+	parallel.metricsBuilders = append(parallel.metricsBuilders,
+		metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
+		metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()))
+
+	expected := serial.CollectMetricData(time.Now())
+	actual := parallel.CollectMetricData(time.Now())
+
+	// 1 for each pod and its container, 1 for the node.
+	assert.Equal(t, 201, expected.ResourceMetrics().Len())
+	assert.NoError(t, pmetrictest.CompareMetrics(expected, actual,
+		pmetrictest.IgnoreTimestamp(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreResourceMetricsOrder()))
+}
+
+func TestCollectMetricDataReusedBuildersDropRemovedFields(t *testing.T) {
+	job := testutils.NewJob("1")
+	ms := metadata.NewStore()
+	ms.Setup(gvk.Job, &testutils.MockStore{
+		Cache: map[string]any{
+			"job1-uid": job,
+		},
+	})
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), nil, nil, nil)
+
+	metricNames := func(m pmetric.Metrics) []string {
+		var names []string
+		for i := 0; i < m.ResourceMetrics().Len(); i++ {
+			sms := m.ResourceMetrics().At(i).ScopeMetrics()
+			for j := 0; j < sms.Len(); j++ {
+				for k := 0; k < sms.At(j).Metrics().Len(); k++ {
+					names = append(names, sms.At(j).Metrics().At(k).Name())
+				}
+			}
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	assert.Contains(t, metricNames(dc.CollectMetricData(time.Now())), "k8s.job.max_parallel_pods")
+
+	// The MetricsBuilders are reused across collections, metrics of removed fields must not be kept.
+	job.Spec.Parallelism = nil
+	assert.NotContains(t, metricNames(dc.CollectMetricData(time.Now())), "k8s.job.max_parallel_pods")
+}
+
+func BenchmarkCollectMetricData(b *testing.B) {
+	ms := newPodsStore(5000)
+	for _, tt := range []struct {
+		name    string
+		workers int
+	}{
+		{name: "serial", workers: 1},
+		{name: "parallel", workers: runtime.GOMAXPROCS(0)},
+	} {
+		b.Run(tt.name, func(b *testing.B) {
+			dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil, nil)
+			dc.metricsBuilders = dc.metricsBuilders[:tt.workers]
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				dc.CollectMetricData(time.Now())
+			}
+		})
+	}
+}
+
+func BenchmarkCollectMetricDataMultipleKinds(b *testing.B) {
+	ms := metadata.NewStore()
+	caches := map[schema.GroupVersionKind]map[string]any{}
+	add := func(kind schema.GroupVersionKind, n int, newObj func(id string) any) {
+		cache := make(map[string]any, n)
+		for i := 0; i < n; i++ {
+			id := strconv.Itoa(i)
+			cache[kind.Kind+id+"-uid"] = newObj(id)
+		}
+		caches[kind] = cache
+	}
+	add(gvk.Pod, 2000, func(id string) any {
+		return testutils.NewPodWithContainer(
+			id,
+			testutils.NewPodSpecWithContainer("container-name"),
+			testutils.NewPodStatusWithContainer("container-name", "container-id"),
+		)
+	})
+	add(gvk.Node, 50, func(id string) any { return testutils.NewNode(id) })
+	add(gvk.Namespace, 50, func(id string) any { return testutils.NewNamespace(id) })
+	add(gvk.Deployment, 500, func(id string) any { return testutils.NewDeployment(id) })
+	add(gvk.ReplicaSet, 500, func(id string) any { return testutils.NewReplicaSet(id) })
+	add(gvk.DaemonSet, 20, func(id string) any { return testutils.NewDaemonset(id) })
+	add(gvk.ResourceQuota, 50, func(id string) any { return testutils.NewResourceQuota(id) })
+	for kind, cache := range caches {
+		ms.Setup(kind, &testutils.MockStore{Cache: cache})
+	}
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil, nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dc.CollectMetricData(time.Now())
+	}
+}
+
+func TestCollectMetricDataPerKindIntervals(t *testing.T) {
+	ms := metadata.NewStore()
+	ms.Setup(gvk.Node, &testutils.MockStore{
+		Cache: map[string]any{
+			"node1-uid": testutils.NewNode("1"),
+		},
+	})
+	ms.Setup(gvk.Namespace, &testutils.MockStore{
+		Cache: map[string]any{
+			"namespace1-uid": testutils.NewNamespace("1"),
+		},
+	})
+
+	dc := NewDataCollector(receivertest.NewNopCreateSettings(), ms, metadata.DefaultMetricsBuilderConfig(), []string{"Ready"}, nil,
+		map[string]int{"node": 2})
+
+	// Namespaces are recorded on every collection, nodes on every second one.
+	for _, wantRMs := range []int{2, 1, 2, 1} {
+		assert.Equal(t, wantRMs, dc.CollectMetricData(time.Now()).ResourceMetrics().Len())
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collection // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/cronjob"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/demonset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/endpoints"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/endpointslice"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/hpa"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/ingress"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/limitrange"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/namespace"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pdb"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolume"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolumeclaim"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pod"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/priorityclass"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicaset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicationcontroller"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/resourcequota"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/service"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/statefulset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/storageclass"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/vpa"
+)
+
+// registerBuiltinKinds registers the kinds supported by the receiver. The informers of the kinds
+// served by the Kubernetes API are created with the Kubernetes factory, the ones of kinds served by
+// a CRD or an API extension with the dynamic factory.
+func (dc *DataCollector) registerBuiltinKinds() {
+	dc.RegisterKind(gvk.Pod, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().Pods().Informer()
+		},
+		Metadata: func(o any, ms *metadata.Store, logger *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return pod.GetMetadata(o.(*corev1.Pod), ms, logger)
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			p := o.(*corev1.Pod)
+			pod.RecordMetrics(dc.settings.Logger, mb, p, dc.nodeAllocatable[p.Spec.NodeName], ts)
+		},
+	})
+	dc.RegisterKind(gvk.Node, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().Nodes().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return node.GetMetadata(o.(*corev1.Node))
+		},
+		Record: func(mb *metadata.MetricsBuilder, customRMs pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			crm := node.CustomMetrics(dc.settings, mb.NewResourceBuilder(), o.(*corev1.Node),
+				dc.nodeConditionsToReport, dc.allocatableTypesToReport, ts)
+			if crm.ScopeMetrics().Len() > 0 {
+				crm.MoveTo(customRMs.AppendEmpty())
+			}
+			node.RecordMetrics(mb, o.(*corev1.Node), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Lease, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Coordination().V1().Leases().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			node.RecordLeaseMetrics(mb, o.(*coordinationv1.Lease), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Namespace, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().Namespaces().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return namespace.GetMetadata(o.(*corev1.Namespace))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			namespace.RecordMetrics(mb, o.(*corev1.Namespace), ts)
+		},
+	})
+	dc.RegisterKind(gvk.ReplicationController, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().ReplicationControllers().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return replicationcontroller.GetMetadata(o.(*corev1.ReplicationController))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			replicationcontroller.RecordMetrics(mb, o.(*corev1.ReplicationController), ts)
+		},
+	})
+	dc.RegisterKind(gvk.ResourceQuota, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().ResourceQuotas().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return resourcequota.GetMetadata(o.(*corev1.ResourceQuota))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			resourcequota.RecordMetrics(mb, o.(*corev1.ResourceQuota), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Service, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().Services().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return service.GetMetadata(o.(*corev1.Service))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			service.RecordMetrics(mb, o.(*corev1.Service), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Secret, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().Secrets().Informer()
+		},
+	})
+	dc.RegisterKind(gvk.ServiceAccount, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().ServiceAccounts().Informer()
+		},
+	})
+	dc.RegisterKind(gvk.PersistentVolume, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().PersistentVolumes().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			persistentvolume.RecordMetrics(mb, o.(*corev1.PersistentVolume), ts)
+		},
+	})
+	dc.RegisterKind(gvk.PersistentVolumeClaim, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().PersistentVolumeClaims().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			persistentvolumeclaim.RecordMetrics(mb, o.(*corev1.PersistentVolumeClaim), ts)
+		},
+	})
+	dc.RegisterKind(gvk.LimitRange, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().LimitRanges().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			limitrange.RecordMetrics(mb, o.(*corev1.LimitRange), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Deployment, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Apps().V1().Deployments().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return deployment.GetMetadata(o.(*appsv1.Deployment))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			deployment.RecordMetrics(mb, o.(*appsv1.Deployment), ts)
+		},
+	})
+	dc.RegisterKind(gvk.ReplicaSet, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Apps().V1().ReplicaSets().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return replicaset.GetMetadata(o.(*appsv1.ReplicaSet))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			replicaset.RecordMetrics(mb, o.(*appsv1.ReplicaSet), ts)
+		},
+	})
+	dc.RegisterKind(gvk.DaemonSet, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Apps().V1().DaemonSets().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return demonset.GetMetadata(o.(*appsv1.DaemonSet))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			demonset.RecordMetrics(mb, o.(*appsv1.DaemonSet), ts)
+		},
+	})
+	dc.RegisterKind(gvk.StatefulSet, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Apps().V1().StatefulSets().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return statefulset.GetMetadata(o.(*appsv1.StatefulSet))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			statefulset.RecordMetrics(mb, o.(*appsv1.StatefulSet), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Job, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Batch().V1().Jobs().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return jobs.GetMetadata(o.(*batchv1.Job))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			jobs.RecordMetrics(mb, o.(*batchv1.Job), ts)
+		},
+	})
+	dc.RegisterKind(gvk.CronJob, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Batch().V1().CronJobs().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return cronjob.GetMetadata(o.(*batchv1.CronJob))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			cronjob.RecordMetrics(mb, o.(*batchv1.CronJob), ts)
+		},
+	})
+	dc.RegisterKind(gvk.CronJobBeta, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Batch().V1beta1().CronJobs().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return cronjob.GetMetadataBeta(o.(*batchv1beta1.CronJob))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			cronjob.RecordMetricsBeta(mb, o.(*batchv1beta1.CronJob), ts)
+		},
+	})
+	dc.RegisterKind(gvk.HorizontalPodAutoscaler, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Autoscaling().V2().HorizontalPodAutoscalers().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return hpa.GetMetadata(o.(*autoscalingv2.HorizontalPodAutoscaler))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			hpa.RecordMetrics(mb, o.(*autoscalingv2.HorizontalPodAutoscaler), ts)
+		},
+	})
+	dc.RegisterKind(gvk.HorizontalPodAutoscalerBeta, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Autoscaling().V2beta2().HorizontalPodAutoscalers().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return hpa.GetMetadataBeta(o.(*autoscalingv2beta2.HorizontalPodAutoscaler))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			hpa.RecordMetricsBeta(mb, o.(*autoscalingv2beta2.HorizontalPodAutoscaler), ts)
+		},
+	})
+	dc.RegisterKind(gvk.EndpointSlice, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Discovery().V1().EndpointSlices().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			endpointslice.RecordMetrics(mb, o.(*discoveryv1.EndpointSlice), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Endpoints, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Core().V1().Endpoints().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			endpoints.RecordMetrics(mb, o.(*corev1.Endpoints), ts)
+		},
+	})
+	dc.RegisterKind(gvk.PodDisruptionBudget, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Policy().V1().PodDisruptionBudgets().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			pdb.RecordMetrics(mb, o.(*policyv1.PodDisruptionBudget), ts)
+		},
+	})
+	dc.RegisterKind(gvk.PriorityClass, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Scheduling().V1().PriorityClasses().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			priorityclass.RecordMetrics(mb, o.(*schedulingv1.PriorityClass), ts)
+		},
+	})
+	dc.RegisterKind(gvk.StorageClass, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Storage().V1().StorageClasses().Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			storageclass.RecordMetrics(mb, o.(*storagev1.StorageClass), ts)
+		},
+	})
+	dc.RegisterKind(gvk.Ingress, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Kubernetes.Networking().V1().Ingresses().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return ingress.GetMetadata(o.(*networkingv1.Ingress))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			ingress.RecordMetrics(mb, o.(*networkingv1.Ingress), ts)
+		},
+	})
+	dc.RegisterKind(gvk.VerticalPodAutoscaler, Kind{
+		Informer: func(f InformerFactories) cache.SharedIndexInformer {
+			return f.Dynamic.ForResource(f.Resource).Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			vpa.RecordMetrics(mb, o.(*unstructured.Unstructured), ts)
+		},
+	})
+}
@@ -24,9 +24,11 @@ const (
 	K8sKindCronJob               = "CronJob"
 	K8sKindDaemonSet             = "DaemonSet"
 	K8sKindDeployment            = "Deployment"
+	K8sKindIngress               = "Ingress"
 	K8sKindJob                   = "Job"
 	K8sKindReplicationController = "ReplicationController"
 	K8sKindReplicaSet            = "ReplicaSet"
+	K8sKindService               = "Service"
 	K8sStatefulSet               = "StatefulSet"
 )
 
@@ -20,16 +20,70 @@ const (
 	// Keys for container metadata.
 	containerKeyStatus       = "container.status"
 	containerKeyStatusReason = "container.status.reason"
+	containerKeyImageID      = "container.image.id"
 
 	// Values for container metadata
 	containerStatusRunning    = "running"
 	containerStatusWaiting    = "waiting"
 	containerStatusTerminated = "terminated"
+
+	// Values for the k8s.container.type resource attribute.
+	containerTypeApp  = "app"
+	containerTypeInit = "init"
 )
 
 // RecordSpecMetrics metricizes values from the container spec.
-// This includes values like resource requests and limits.
-func RecordSpecMetrics(logger *zap.Logger, mb *imetadata.MetricsBuilder, c corev1.Container, pod *corev1.Pod, ts pcommon.Timestamp) {
+// This includes values like resource requests and limits, and the requests as a fraction of the
+// allocatable resources of the node of the pod, if known.
+func RecordSpecMetrics(logger *zap.Logger, mb *imetadata.MetricsBuilder, c corev1.Container, pod *corev1.Pod,
+	nodeAllocatable corev1.ResourceList, ts pcommon.Timestamp) {
+	recordResourceMetrics(logger, mb, c, ts)
+	recordRequestNodeFractions(mb, c, nodeAllocatable, ts)
+	var containerID string
+	var imageStr string
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == c.Name {
+			if cs.State.Waiting != nil {
+				mb.RecordK8sContainerWaitingDataPoint(ts, 1, cs.State.Waiting.Reason)
+			}
+			// Containers that haven't been created yet, e.g. while their image is pulled, only
+			// report why they are waiting.
+			if cs.ContainerID == "" {
+				break
+			}
+			containerID = cs.ContainerID
+			imageStr = cs.Image
+			mb.RecordK8sContainerRestartsDataPoint(ts, int64(cs.RestartCount))
+			mb.RecordK8sContainerReadyDataPoint(ts, boolToInt64(cs.Ready))
+			if cs.Started != nil {
+				mb.RecordK8sContainerStartedDataPoint(ts, boolToInt64(*cs.Started))
+			}
+			if cs.LastTerminationState.Terminated != nil {
+				mb.RecordK8sContainerLastTerminationReasonDataPoint(ts, int64(terminationReasonToInt(cs.LastTerminationState.Terminated.Reason)))
+				mb.RecordK8sContainerLastExitCodeDataPoint(ts, int64(cs.LastTerminationState.Terminated.ExitCode))
+			}
+			break
+		}
+	}
+	emitForContainer(logger, mb, c, pod, containerID, imageStr, containerTypeApp)
+}
+
+// RecordInitContainerSpecMetrics metricizes resource requests and limits from the init container spec.
+func RecordInitContainerSpecMetrics(logger *zap.Logger, mb *imetadata.MetricsBuilder, c corev1.Container, pod *corev1.Pod, ts pcommon.Timestamp) {
+	recordResourceMetrics(logger, mb, c, ts)
+	var containerID string
+	var imageStr string
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.Name == c.Name {
+			containerID = cs.ContainerID
+			imageStr = cs.Image
+			break
+		}
+	}
+	emitForContainer(logger, mb, c, pod, containerID, imageStr, containerTypeInit)
+}
+
+func recordResourceMetrics(logger *zap.Logger, mb *imetadata.MetricsBuilder, c corev1.Container, ts pcommon.Timestamp) {
 	for k, r := range c.Resources.Requests {
 		//exhaustive:ignore
 		switch k {
@@ -60,25 +114,39 @@ func RecordSpecMetrics(logger *zap.Logger, mb *imetadata.MetricsBuilder, c corev
 			logger.Debug("unsupported request type", zap.Any("type", k))
 		}
 	}
-	var containerID string
-	var imageStr string
-	for _, cs := range pod.Status.ContainerStatuses {
-		if cs.Name == c.Name {
-			containerID = cs.ContainerID
-			imageStr = cs.Image
-			mb.RecordK8sContainerRestartsDataPoint(ts, int64(cs.RestartCount))
-			mb.RecordK8sContainerReadyDataPoint(ts, boolToInt64(cs.Ready))
-			break
-		}
+	if ratio, ok := limitRatio(c, corev1.ResourceCPU); ok {
+		mb.RecordK8sContainerCPULimitRatioDataPoint(ts, ratio)
+	}
+	if ratio, ok := limitRatio(c, corev1.ResourceMemory); ok {
+		mb.RecordK8sContainerMemoryLimitRatioDataPoint(ts, ratio)
+	}
+}
+
+// limitRatio returns the ratio of the limit to the request of the given resource of the container.
+// It returns false if the limit or the request is not set, or the request is zero.
+func limitRatio(c corev1.Container, name corev1.ResourceName) (float64, bool) {
+	limit, ok := c.Resources.Limits[name]
+	if !ok {
+		return 0, false
+	}
+	request, ok := c.Resources.Requests[name]
+	if !ok || request.IsZero() {
+		return 0, false
 	}
+	return limit.AsApproximateFloat64() / request.AsApproximateFloat64(), true
+}
 
+func emitForContainer(logger *zap.Logger, mb *imetadata.MetricsBuilder, c corev1.Container, pod *corev1.Pod, containerID, imageStr, containerType string) {
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sPodUID(string(pod.UID))
 	rb.SetK8sPodName(pod.Name)
 	rb.SetK8sNodeName(pod.Spec.NodeName)
 	rb.SetK8sNamespaceName(pod.Namespace)
-	rb.SetContainerID(utils.StripContainerID(containerID))
+	if containerID != "" {
+		rb.SetContainerID(utils.StripContainerID(containerID))
+	}
 	rb.SetK8sContainerName(c.Name)
+	rb.SetK8sContainerType(containerType)
 	image, err := docker.ParseImageName(imageStr)
 	if err != nil {
 		docker.LogParseError(err, imageStr, logger)
@@ -89,9 +157,25 @@ func RecordSpecMetrics(logger *zap.Logger, mb *imetadata.MetricsBuilder, c corev
 	mb.EmitForResource(imetadata.WithResource(rb.Emit()))
 }
 
-func GetMetadata(cs corev1.ContainerStatus) *metadata.KubernetesMetadata {
+// GetMetadata returns the metadata of the container with the given status. The image name and tag
+// are taken from the container spec, and the resolved image ID from the status.
+func GetMetadata(c corev1.Container, cs corev1.ContainerStatus, logger *zap.Logger) *metadata.KubernetesMetadata {
 	mdata := map[string]string{}
 
+	if c.Image != "" {
+		image, err := docker.ParseImageName(c.Image)
+		if err != nil {
+			docker.LogParseError(err, c.Image, logger)
+		} else {
+			mdata[conventions.AttributeContainerImageName] = image.Repository
+			mdata[conventions.AttributeContainerImageTag] = image.Tag
+		}
+	}
+	// The image ID is only populated once the image has been pulled.
+	if cs.ImageID != "" {
+		mdata[containerKeyImageID] = cs.ImageID
+	}
+
 	if cs.State.Running != nil {
 		mdata[containerKeyStatus] = containerStatusRunning
 	}
@@ -114,6 +198,23 @@ func GetMetadata(cs corev1.ContainerStatus) *metadata.KubernetesMetadata {
 	}
 }
 
+func terminationReasonToInt(reason string) int32 {
+	switch reason {
+	case "OOMKilled":
+		return 1
+	case "Error":
+		return 2
+	case "Completed":
+		return 3
+	case "ContainerCannotRun":
+		return 4
+	case "DeadlineExceeded":
+		return 5
+	default:
+		return 6
+	}
+}
+
 func boolToInt64(b bool) int64 {
 	if b {
 		return 1
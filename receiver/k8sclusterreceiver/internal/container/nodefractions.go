@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package container // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/container"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	imetadata "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// recordRequestNodeFractions records the CPU and memory requests of the container as a fraction
+// of the given allocatable resources of its node. Requests that aren't set, or resources the node
+// doesn't report as allocatable, are skipped.
+func recordRequestNodeFractions(mb *imetadata.MetricsBuilder, c corev1.Container, nodeAllocatable corev1.ResourceList, ts pcommon.Timestamp) {
+	if fraction, ok := requestFraction(c, nodeAllocatable, corev1.ResourceCPU); ok {
+		mb.RecordK8sContainerCPURequestNodeFractionDataPoint(ts, fraction)
+	}
+	if fraction, ok := requestFraction(c, nodeAllocatable, corev1.ResourceMemory); ok {
+		mb.RecordK8sContainerMemoryRequestNodeFractionDataPoint(ts, fraction)
+	}
+}
+
+// requestFraction returns the ratio of the request of the given resource of the container to the
+// allocatable amount of it. It returns false if the request is not set or nothing is allocatable.
+func requestFraction(c corev1.Container, allocatable corev1.ResourceList, name corev1.ResourceName) (float64, bool) {
+	request, ok := c.Resources.Requests[name]
+	if !ok {
+		return 0, false
+	}
+	available, ok := allocatable[name]
+	if !ok || available.IsZero() {
+		return 0, false
+	}
+	return request.AsApproximateFloat64() / available.AsApproximateFloat64(), true
+}
@@ -4,8 +4,12 @@
 package cronjob // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/cronjob"
 
 import (
+	"strconv"
+
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
@@ -16,10 +20,12 @@ const (
 	// Keys for cronjob metadata.
 	cronJobKeySchedule          = "schedule"
 	cronJobKeyConcurrencyPolicy = "concurrency_policy"
+	cronJobKeySuspend           = "suspend"
 )
 
 func RecordMetrics(mb *metadata.MetricsBuilder, cj *batchv1.CronJob, ts pcommon.Timestamp) {
 	mb.RecordK8sCronjobActiveJobsDataPoint(ts, int64(len(cj.Status.Active)))
+	recordSuspendedAndLastScheduleAge(mb, cj.Spec.Suspend, cj.Status.LastScheduleTime, ts)
 
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sNamespaceName(cj.Namespace)
@@ -28,9 +34,42 @@ func RecordMetrics(mb *metadata.MetricsBuilder, cj *batchv1.CronJob, ts pcommon.
 	mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+func recordSuspendedAndLastScheduleAge(mb *metadata.MetricsBuilder, suspend *bool, lastScheduleTime *metav1.Time, ts pcommon.Timestamp) {
+	var suspended int64
+	if suspend != nil && *suspend {
+		suspended = 1
+	}
+	mb.RecordK8sCronjobSuspendedDataPoint(ts, suspended)
+	// Cronjobs that have never been scheduled don't have a last schedule time.
+	if lastScheduleTime != nil {
+		mb.RecordK8sCronjobLastScheduleAgeDataPoint(ts, int64(ts.AsTime().Sub(lastScheduleTime.Time).Seconds()))
+	}
+}
+
 func GetMetadata(cj *batchv1.CronJob) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
 	rm := metadata.GetGenericMetadata(&cj.ObjectMeta, constants.K8sKindCronJob)
 	rm.Metadata[cronJobKeySchedule] = cj.Spec.Schedule
 	rm.Metadata[cronJobKeyConcurrencyPolicy] = string(cj.Spec.ConcurrencyPolicy)
+	rm.Metadata[cronJobKeySuspend] = strconv.FormatBool(cj.Spec.Suspend != nil && *cj.Spec.Suspend)
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(cj.UID): rm}
+}
+
+// RecordMetricsBeta records the same metrics as RecordMetrics for clusters that only serve batch/v1beta1.
+func RecordMetricsBeta(mb *metadata.MetricsBuilder, cj *batchv1beta1.CronJob, ts pcommon.Timestamp) {
+	mb.RecordK8sCronjobActiveJobsDataPoint(ts, int64(len(cj.Status.Active)))
+	recordSuspendedAndLastScheduleAge(mb, cj.Spec.Suspend, cj.Status.LastScheduleTime, ts)
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(cj.Namespace)
+	rb.SetK8sCronjobUID(string(cj.UID))
+	rb.SetK8sCronjobName(cj.Name)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func GetMetadataBeta(cj *batchv1beta1.CronJob) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	rm := metadata.GetGenericMetadata(&cj.ObjectMeta, constants.K8sKindCronJob)
+	rm.Metadata[cronJobKeySchedule] = cj.Spec.Schedule
+	rm.Metadata[cronJobKeyConcurrencyPolicy] = string(cj.Spec.ConcurrencyPolicy)
+	rm.Metadata[cronJobKeySuspend] = strconv.FormatBool(cj.Spec.Suspend != nil && *cj.Spec.Suspend)
 	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(cj.UID): rm}
 }
@@ -8,9 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -38,6 +41,74 @@ func TestCronJobMetrics(t *testing.T) {
 	)
 }
 
+func TestCronJobBetaMetrics(t *testing.T) {
+	cj := testutils.NewCronJobBeta("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetricsBeta(mb, cj, ts)
+	m := mb.Emit()
+
+	// The beta path must produce exactly the same metrics as the v1 one.
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+	),
+	)
+}
+
+func TestCronJobSuspendedAndLastScheduleAgeMetrics(t *testing.T) {
+	now := time.Now()
+	suspend := true
+	lastSchedule := metav1.NewTime(now.Add(-2 * time.Hour))
+
+	cj := testutils.NewCronJob("1")
+	cj.Spec.Suspend = &suspend
+	cj.Status.LastScheduleTime = &lastSchedule
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sCronjobSuspended.Enabled = true
+	mbc.Metrics.K8sCronjobLastScheduleAge.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, cj, pcommon.NewTimestampFromTime(now))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(0), "k8s.cronjob.active_jobs", pmetric.MetricTypeGauge, 2)
+	testutils.AssertMetricInt(t, ms.At(1), "k8s.cronjob.last_schedule_age", pmetric.MetricTypeGauge, 7200)
+	testutils.AssertMetricInt(t, ms.At(2), "k8s.cronjob.suspended", pmetric.MetricTypeGauge, 1)
+}
+
+func TestCronJobNeverScheduled(t *testing.T) {
+	cj := testutils.NewCronJob("1")
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sCronjobSuspended.Enabled = true
+	mbc.Metrics.K8sCronjobLastScheduleAge.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, cj, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(0), "k8s.cronjob.active_jobs", pmetric.MetricTypeGauge, 2)
+	testutils.AssertMetricInt(t, ms.At(1), "k8s.cronjob.suspended", pmetric.MetricTypeGauge, 0)
+}
+
 func TestCronJobMetadata(t *testing.T) {
 	cj := testutils.NewCronJob("1")
 
@@ -57,6 +128,7 @@ func TestCronJobMetadata(t *testing.T) {
 				"foo1":                       "",
 				"schedule":                   "schedule",
 				"concurrency_policy":         "concurrency_policy",
+				"suspend":                    "false",
 				"k8s.workload.kind":          "CronJob",
 				"k8s.workload.name":          "test-cronjob-1",
 			},
@@ -64,3 +136,21 @@ func TestCronJobMetadata(t *testing.T) {
 		*actualMetadata["test-cronjob-1-uid"],
 	)
 }
+
+func TestCronJobBetaMetadata(t *testing.T) {
+	cj := testutils.NewCronJobBeta("1")
+
+	require.Equal(t, GetMetadata(testutils.NewCronJob("1")), GetMetadataBeta(cj))
+}
+
+func TestCronJobSuspendMetadata(t *testing.T) {
+	cj := testutils.NewCronJob("1")
+	suspend := true
+	cj.Spec.Suspend = &suspend
+
+	assert.Equal(t, "true", GetMetadata(cj)["test-cronjob-1-uid"].Metadata["suspend"])
+
+	cjBeta := testutils.NewCronJobBeta("1")
+	cjBeta.Spec.Suspend = &suspend
+	assert.Equal(t, "true", GetMetadataBeta(cjBeta)["test-cronjob-1-uid"].Metadata["suspend"])
+}
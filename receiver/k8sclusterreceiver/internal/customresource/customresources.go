@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// Transform transforms the custom resource to remove the fields that we don't use to reduce RAM utilization.
+// Only the number of custom resources is recorded, so only their identity is kept.
+func Transform(cr *unstructured.Unstructured) *unstructured.Unstructured {
+	newCR := &unstructured.Unstructured{}
+	newCR.SetAPIVersion(cr.GetAPIVersion())
+	newCR.SetKind(cr.GetKind())
+	newCR.SetNamespace(cr.GetNamespace())
+	newCR.SetName(cr.GetName())
+	newCR.SetUID(cr.GetUID())
+	return newCR
+}
+
+// RecordCount records the number of custom resources of the given kind.
+func RecordCount(mb *metadata.MetricsBuilder, kind schema.GroupVersionKind, count int, ts pcommon.Timestamp) {
+	mb.RecordK8sCustomResourceCountDataPoint(ts, int64(count), kind.Group, kind.Version, kind.Kind)
+	mb.EmitForResource()
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestRecordCount(t *testing.T) {
+	kind := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordCount(mb, kind, 3, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t, 0, rm.Resource().Attributes().Len())
+	require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+	metric := rm.ScopeMetrics().At(0).Metrics().At(0)
+	testutils.AssertMetricInt(t, metric, "k8s.custom_resource.count", pmetric.MetricTypeGauge, 3)
+	assert.Equal(t, map[string]any{
+		"group":   "cert-manager.io",
+		"version": "v1",
+		"kind":    "Certificate",
+	}, metric.Gauge().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestTransform(t *testing.T) {
+	cr := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]any{
+			"name":      "my-cert",
+			"namespace": "default",
+			"uid":       "my-cert-uid",
+			"labels":    map[string]any{"app": "my-app"},
+		},
+		"spec": map[string]any{
+			"secretName": "my-cert-tls",
+		},
+	}}
+	want := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]any{
+			"name":      "my-cert",
+			"namespace": "default",
+			"uid":       "my-cert-uid",
+		},
+	}}
+	assert.Equal(t, want, Transform(cr))
+}
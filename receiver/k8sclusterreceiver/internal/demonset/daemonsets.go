@@ -10,20 +10,27 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
 // Transform transforms the pod to remove the fields that we don't use to reduce RAM utilization.
 // IMPORTANT: Make sure to update this function before using new daemonset fields.
 func Transform(ds *appsv1.DaemonSet) *appsv1.DaemonSet {
-	return &appsv1.DaemonSet{
+	newDS := &appsv1.DaemonSet{
 		ObjectMeta: metadata.TransformObjectMeta(ds.ObjectMeta),
 		Status: appsv1.DaemonSetStatus{
 			CurrentNumberScheduled: ds.Status.CurrentNumberScheduled,
 			DesiredNumberScheduled: ds.Status.DesiredNumberScheduled,
 			NumberMisscheduled:     ds.Status.NumberMisscheduled,
 			NumberReady:            ds.Status.NumberReady,
+			NumberAvailable:        ds.Status.NumberAvailable,
+			NumberUnavailable:      ds.Status.NumberUnavailable,
+			ObservedGeneration:     ds.Status.ObservedGeneration,
+			UpdatedNumberScheduled: ds.Status.UpdatedNumberScheduled,
 		},
 	}
+	newDS.Generation = ds.Generation
+	return newDS
 }
 
 func RecordMetrics(mb *metadata.MetricsBuilder, ds *appsv1.DaemonSet, ts pcommon.Timestamp) {
@@ -31,6 +38,17 @@ func RecordMetrics(mb *metadata.MetricsBuilder, ds *appsv1.DaemonSet, ts pcommon
 	mb.RecordK8sDaemonsetDesiredScheduledNodesDataPoint(ts, int64(ds.Status.DesiredNumberScheduled))
 	mb.RecordK8sDaemonsetMisscheduledNodesDataPoint(ts, int64(ds.Status.NumberMisscheduled))
 	mb.RecordK8sDaemonsetReadyNodesDataPoint(ts, int64(ds.Status.NumberReady))
+	mb.RecordK8sDaemonsetUnavailableNodesDataPoint(ts, int64(ds.Status.NumberUnavailable))
+	// More nodes than desired can be available while the daemon set is removed from nodes.
+	unavailable := int64(ds.Status.DesiredNumberScheduled) - int64(ds.Status.NumberAvailable)
+	if unavailable < 0 {
+		unavailable = 0
+	}
+	mb.RecordK8sDaemonsetReplicasUnavailableDataPoint(ts, unavailable)
+	mb.RecordK8sDaemonsetGenerationSkewDataPoint(ts, utils.GenerationSkew(ds.Generation, ds.Status.ObservedGeneration))
+	if ds.Status.DesiredNumberScheduled > 0 {
+		mb.RecordK8sDaemonsetUpdatedRatioDataPoint(ts, float64(ds.Status.UpdatedNumberScheduled)/float64(ds.Status.DesiredNumberScheduled))
+	}
 
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sNamespaceName(ds.Namespace)
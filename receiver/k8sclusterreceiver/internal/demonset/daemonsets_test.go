@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -42,11 +43,138 @@ func TestDaemonsetMetrics(t *testing.T) {
 	)
 }
 
+func TestDaemonsetUnavailableNodesMetric(t *testing.T) {
+	ds := testutils.NewDaemonset("1")
+	ds.Status.NumberUnavailable = 2
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sDaemonsetUnavailableNodes.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ds, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	require.Equal(t, 5, m.MetricCount())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(4), "k8s.daemonset.unavailable_nodes", pmetric.MetricTypeGauge, 2)
+}
+
+func TestDaemonsetReplicasUnavailableMetric(t *testing.T) {
+	tests := []struct {
+		name      string
+		desired   int32
+		available int32
+		want      int64
+	}{
+		{
+			name:      "unavailable",
+			desired:   5,
+			available: 3,
+			want:      2,
+		},
+		{
+			name:      "scale_down",
+			desired:   2,
+			available: 3,
+			want:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := testutils.NewDaemonset("1")
+			ds.Status.DesiredNumberScheduled = tt.desired
+			ds.Status.NumberAvailable = tt.available
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sDaemonsetReplicasUnavailable.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, ds, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			require.Equal(t, 5, m.MetricCount())
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			ms.Sort(func(a, b pmetric.Metric) bool {
+				return a.Name() < b.Name()
+			})
+			testutils.AssertMetricInt(t, ms.At(4), "k8s.daemonset.replicas_unavailable", pmetric.MetricTypeGauge, tt.want)
+		})
+	}
+}
+
+func TestDaemonsetGenerationSkewMetric(t *testing.T) {
+	ds := testutils.NewDaemonset("1")
+	ds.Generation = 3
+	ds.Status.ObservedGeneration = 2
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sDaemonsetGenerationSkew.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ds, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	require.Equal(t, 5, m.MetricCount())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(2), "k8s.daemonset.generation_skew", pmetric.MetricTypeGauge, 1)
+}
+
+func TestDaemonsetUpdatedRatioMetric(t *testing.T) {
+	ds := testutils.NewDaemonset("1")
+	ds.Status.DesiredNumberScheduled = 5
+	ds.Status.UpdatedNumberScheduled = 3
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sDaemonsetUpdatedRatio.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ds, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	require.Equal(t, 5, m.MetricCount())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	assert.Equal(t, "k8s.daemonset.updated_ratio", ms.At(4).Name())
+	assert.InDelta(t, 0.6, ms.At(4).Gauge().DataPoints().At(0).DoubleValue(), 1e-9)
+}
+
+func TestDaemonsetUpdatedRatioMetricNoDesiredNodes(t *testing.T) {
+	ds := testutils.NewDaemonset("1")
+	ds.Status.DesiredNumberScheduled = 0
+	ds.Status.UpdatedNumberScheduled = 0
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sDaemonsetUpdatedRatio.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ds, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	require.Equal(t, 4, m.MetricCount())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		assert.NotEqual(t, "k8s.daemonset.updated_ratio", ms.At(i).Name())
+	}
+}
+
 func TestTransform(t *testing.T) {
 	originalDS := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "my-daemonset",
-			Namespace: "default",
+			Name:       "my-daemonset",
+			Namespace:  "default",
+			Generation: 2,
 			Labels: map[string]string{
 				"app": "my-app",
 			},
@@ -84,8 +212,12 @@ func TestTransform(t *testing.T) {
 		Status: appsv1.DaemonSetStatus{
 			CurrentNumberScheduled: 3,
 			NumberReady:            3,
+			NumberAvailable:        2,
 			DesiredNumberScheduled: 3,
 			NumberMisscheduled:     0,
+			NumberUnavailable:      1,
+			ObservedGeneration:     1,
+			UpdatedNumberScheduled: 2,
 			Conditions: []appsv1.DaemonSetCondition{
 				{
 					Type:   "Available",
@@ -96,8 +228,9 @@ func TestTransform(t *testing.T) {
 	}
 	wantDS := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "my-daemonset",
-			Namespace: "default",
+			Name:       "my-daemonset",
+			Namespace:  "default",
+			Generation: 2,
 			Labels: map[string]string{
 				"app": "my-app",
 			},
@@ -105,8 +238,12 @@ func TestTransform(t *testing.T) {
 		Status: appsv1.DaemonSetStatus{
 			CurrentNumberScheduled: 3,
 			NumberReady:            3,
+			NumberAvailable:        2,
 			DesiredNumberScheduled: 3,
 			NumberMisscheduled:     0,
+			NumberUnavailable:      1,
+			ObservedGeneration:     1,
+			UpdatedNumberScheduled: 2,
 		},
 	}
 	assert.Equal(t, wantDS, Transform(originalDS))
@@ -12,25 +12,52 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	imetadata "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
 // Transform transforms the pod to remove the fields that we don't use to reduce RAM utilization.
 // IMPORTANT: Make sure to update this function before using new deployment fields.
 func Transform(deployment *appsv1.Deployment) *appsv1.Deployment {
-	return &appsv1.Deployment{
+	newDeployment := &appsv1.Deployment{
 		ObjectMeta: metadata.TransformObjectMeta(deployment.ObjectMeta),
 		Spec: appsv1.DeploymentSpec{
 			Replicas: deployment.Spec.Replicas,
+			Paused:   deployment.Spec.Paused,
 		},
 		Status: appsv1.DeploymentStatus{
-			AvailableReplicas: deployment.Status.AvailableReplicas,
+			AvailableReplicas:  deployment.Status.AvailableReplicas,
+			CollisionCount:     deployment.Status.CollisionCount,
+			ObservedGeneration: deployment.Status.ObservedGeneration,
 		},
 	}
+	newDeployment.Generation = deployment.Generation
+	for _, c := range deployment.Status.Conditions {
+		newDeployment.Status.Conditions = append(newDeployment.Status.Conditions, appsv1.DeploymentCondition{
+			Type:   c.Type,
+			Status: c.Status,
+		})
+	}
+	return newDeployment
 }
 
 func RecordMetrics(mb *imetadata.MetricsBuilder, dep *appsv1.Deployment, ts pcommon.Timestamp) {
-	mb.RecordK8sDeploymentDesiredDataPoint(ts, int64(*dep.Spec.Replicas))
+	desired := desiredReplicas(dep)
+	mb.RecordK8sDeploymentDesiredDataPoint(ts, desired)
 	mb.RecordK8sDeploymentAvailableDataPoint(ts, int64(dep.Status.AvailableReplicas))
+	// More pods than desired can be available while the deployment scales down.
+	unavailable := desired - int64(dep.Status.AvailableReplicas)
+	if unavailable < 0 {
+		unavailable = 0
+	}
+	mb.RecordK8sDeploymentReplicasUnavailableDataPoint(ts, unavailable)
+	mb.RecordK8sDeploymentPausedDataPoint(ts, boolToInt64(dep.Spec.Paused))
+	for _, c := range dep.Status.Conditions {
+		mb.RecordK8sDeploymentConditionDataPoint(ts, utils.ConditionStatusValue(c.Status), string(c.Type))
+	}
+	if dep.Status.CollisionCount != nil {
+		mb.RecordK8sDeploymentCollisionCountDataPoint(ts, int64(*dep.Status.CollisionCount))
+	}
+	mb.RecordK8sDeploymentGenerationSkewDataPoint(ts, utils.GenerationSkew(dep.Generation, dep.Status.ObservedGeneration))
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sDeploymentName(dep.Name)
 	rb.SetK8sDeploymentUID(string(dep.UID))
@@ -38,6 +65,22 @@ func RecordMetrics(mb *imetadata.MetricsBuilder, dep *appsv1.Deployment, ts pcom
 	mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// desiredReplicas returns the desired number of pods of the deployment. Kubernetes defaults
+// it to 1 if it isn't set.
+func desiredReplicas(dep *appsv1.Deployment) int64 {
+	if dep.Spec.Replicas == nil {
+		return 1
+	}
+	return int64(*dep.Spec.Replicas)
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func GetMetadata(dep *appsv1.Deployment) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
 	rm := metadata.GetGenericMetadata(&dep.ObjectMeta, constants.K8sKindDeployment)
 	rm.Metadata[conventions.AttributeK8SDeploymentName] = dep.Name
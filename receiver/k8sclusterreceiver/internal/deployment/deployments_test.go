@@ -53,6 +53,207 @@ func TestDeploymentMetrics(t *testing.T) {
 	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.deployment.desired", pmetric.MetricTypeGauge, int64(10))
 }
 
+func TestDeploymentPausedMetric(t *testing.T) {
+	dep := testutils.NewDeployment("1")
+	dep.Spec.Paused = true
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sDeploymentPaused.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, dep, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.deployment.uid":  "test-deployment-1-uid",
+			"k8s.deployment.name": "test-deployment-1",
+			"k8s.namespace.name":  "test-namespace",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 3, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(2), "k8s.deployment.paused", pmetric.MetricTypeGauge, int64(1))
+}
+
+func TestDeploymentConditionMetrics(t *testing.T) {
+	dep := testutils.NewDeployment("1")
+	dep.Status.Conditions = []appsv1.DeploymentCondition{
+		{Type: appsv1.DeploymentAvailable, Status: v1.ConditionTrue},
+		{Type: appsv1.DeploymentProgressing, Status: v1.ConditionFalse},
+		{Type: appsv1.DeploymentReplicaFailure, Status: v1.ConditionUnknown},
+	}
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sDeploymentCondition.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, dep, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	sms := m.ResourceMetrics().At(0).ScopeMetrics().At(0)
+	var condition pmetric.Metric
+	for i := 0; i < sms.Metrics().Len(); i++ {
+		if sms.Metrics().At(i).Name() == "k8s.deployment.condition" {
+			condition = sms.Metrics().At(i)
+		}
+	}
+	require.Equal(t, pmetric.MetricTypeGauge, condition.Type())
+	values := map[string]int64{}
+	dps := condition.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		attr, ok := dps.At(i).Attributes().Get("condition")
+		require.True(t, ok)
+		values[attr.Str()] = dps.At(i).IntValue()
+	}
+	assert.Equal(t, map[string]int64{
+		"Available":      1,
+		"Progressing":    0,
+		"ReplicaFailure": -1,
+	}, values)
+}
+
+func TestDeploymentCollisionCountMetric(t *testing.T) {
+	for _, collisionCount := range []*int32{nil, func() *int32 { i := int32(3); return &i }()} {
+		dep := testutils.NewDeployment("1")
+		dep.Status.CollisionCount = collisionCount
+
+		mbc := metadata.DefaultMetricsBuilderConfig()
+		mbc.Metrics.K8sDeploymentCollisionCount.Enabled = true
+		mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+		RecordMetrics(mb, dep, pcommon.Timestamp(time.Now().UnixNano()))
+		m := mb.Emit()
+
+		require.Equal(t, 1, m.ResourceMetrics().Len())
+		sms := m.ResourceMetrics().At(0).ScopeMetrics().At(0)
+		var found bool
+		for i := 0; i < sms.Metrics().Len(); i++ {
+			if sms.Metrics().At(i).Name() == "k8s.deployment.collision_count" {
+				found = true
+				testutils.AssertMetricInt(t, sms.Metrics().At(i), "k8s.deployment.collision_count", pmetric.MetricTypeGauge, *collisionCount)
+			}
+		}
+		assert.Equal(t, collisionCount != nil, found)
+	}
+}
+
+func TestDeploymentReplicasUnavailableMetric(t *testing.T) {
+	tests := []struct {
+		name      string
+		replicas  *int32
+		available int32
+		want      int64
+	}{
+		{
+			name:      "unavailable",
+			replicas:  func() *int32 { i := int32(5); return &i }(),
+			available: 3,
+			want:      2,
+		},
+		{
+			name:      "scale_down",
+			replicas:  func() *int32 { i := int32(2); return &i }(),
+			available: 4,
+			want:      0,
+		},
+		{
+			name:      "default_replicas",
+			available: 0,
+			want:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dep := testutils.NewDeployment("1")
+			dep.Spec.Replicas = tt.replicas
+			dep.Status.AvailableReplicas = tt.available
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sDeploymentReplicasUnavailable.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, dep, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			sms := m.ResourceMetrics().At(0).ScopeMetrics().At(0)
+			var found bool
+			for i := 0; i < sms.Metrics().Len(); i++ {
+				if sms.Metrics().At(i).Name() == "k8s.deployment.replicas_unavailable" {
+					found = true
+					testutils.AssertMetricInt(t, sms.Metrics().At(i), "k8s.deployment.replicas_unavailable", pmetric.MetricTypeGauge, tt.want)
+				}
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestDeploymentGenerationSkewMetric(t *testing.T) {
+	tests := []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		want               int64
+	}{
+		{
+			name:               "observed",
+			generation:         2,
+			observedGeneration: 2,
+			want:               0,
+		},
+		{
+			name:               "not observed",
+			generation:         3,
+			observedGeneration: 2,
+			want:               1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dep := testutils.NewDeployment("1")
+			dep.Generation = tt.generation
+			dep.Status.ObservedGeneration = tt.observedGeneration
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sDeploymentGenerationSkew.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, dep, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() == "k8s.deployment.generation_skew" {
+					found = true
+					testutils.AssertMetricInt(t, ms.At(i), "k8s.deployment.generation_skew", pmetric.MetricTypeGauge, tt.want)
+				}
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestDeploymentWithoutConditions(t *testing.T) {
+	dep := testutils.NewDeployment("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sDeploymentCondition.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, dep, ts)
+	m := mb.Emit()
+
+	// Only the desired and available metrics are emitted.
+	require.Equal(t, 2, m.MetricCount())
+}
+
 func TestGoldenFile(t *testing.T) {
 	dep := testutils.NewDeployment("1")
 	ts := pcommon.Timestamp(time.Now().UnixNano())
@@ -76,15 +277,17 @@ func TestGoldenFile(t *testing.T) {
 func TestTransform(t *testing.T) {
 	origDeployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "my-deployment",
-			UID:       "my-deployment-uid",
-			Namespace: "default",
+			Name:       "my-deployment",
+			UID:        "my-deployment-uid",
+			Namespace:  "default",
+			Generation: 2,
 			Labels: map[string]string{
 				"app": "my-app",
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: func() *int32 { replicas := int32(3); return &replicas }(),
+			Paused:   true,
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{
 					"app": "my-app",
@@ -115,9 +318,10 @@ func TestTransform(t *testing.T) {
 			},
 		},
 		Status: appsv1.DeploymentStatus{
-			Replicas:          3,
-			ReadyReplicas:     3,
-			AvailableReplicas: 3,
+			Replicas:           3,
+			ReadyReplicas:      3,
+			AvailableReplicas:  3,
+			ObservedGeneration: 1,
 			Conditions: []appsv1.DeploymentCondition{
 				{
 					Type:   appsv1.DeploymentAvailable,
@@ -128,18 +332,27 @@ func TestTransform(t *testing.T) {
 	}
 	wantDeployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "my-deployment",
-			UID:       "my-deployment-uid",
-			Namespace: "default",
+			Name:       "my-deployment",
+			UID:        "my-deployment-uid",
+			Namespace:  "default",
+			Generation: 2,
 			Labels: map[string]string{
 				"app": "my-app",
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: func() *int32 { replicas := int32(3); return &replicas }(),
+			Paused:   true,
 		},
 		Status: appsv1.DeploymentStatus{
-			AvailableReplicas: 3,
+			AvailableReplicas:  3,
+			ObservedGeneration: 1,
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:   appsv1.DeploymentAvailable,
+					Status: v1.ConditionTrue,
+				},
+			},
 		},
 	}
 	assert.Equal(t, wantDeployment, Transform(origDeployment))
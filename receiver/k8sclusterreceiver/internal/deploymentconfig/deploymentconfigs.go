@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentconfig // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deploymentconfig"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// statusFields are the replica counts read from the status of the deployment config.
+var statusFields = []string{"availableReplicas", "updatedReplicas", "readyReplicas"}
+
+// Transform transforms the deployment config to remove the fields that we don't use to reduce RAM utilization.
+// IMPORTANT: Make sure to update this function before using new deployment config fields.
+func Transform(dc *unstructured.Unstructured) *unstructured.Unstructured {
+	newDC := &unstructured.Unstructured{}
+	newDC.SetAPIVersion(dc.GetAPIVersion())
+	newDC.SetKind(dc.GetKind())
+	newDC.SetNamespace(dc.GetNamespace())
+	newDC.SetName(dc.GetName())
+	newDC.SetUID(dc.GetUID())
+	if v, ok, _ := unstructured.NestedInt64(dc.Object, "spec", "replicas"); ok {
+		_ = unstructured.SetNestedField(newDC.Object, v, "spec", "replicas")
+	}
+	for _, field := range statusFields {
+		if v, ok, _ := unstructured.NestedInt64(dc.Object, "status", field); ok {
+			_ = unstructured.SetNestedField(newDC.Object, v, "status", field)
+		}
+	}
+	return newDC
+}
+
+// RecordMetrics records the replica counts of the deployment config. Status counts that are
+// omitted by the API server are zero.
+func RecordMetrics(mb *metadata.MetricsBuilder, dc *unstructured.Unstructured, ts pcommon.Timestamp) {
+	desired, _, _ := unstructured.NestedInt64(dc.Object, "spec", "replicas")
+	available, _, _ := unstructured.NestedInt64(dc.Object, "status", "availableReplicas")
+	updated, _, _ := unstructured.NestedInt64(dc.Object, "status", "updatedReplicas")
+	ready, _, _ := unstructured.NestedInt64(dc.Object, "status", "readyReplicas")
+	mb.RecordOpenshiftDeploymentconfigDesiredDataPoint(ts, desired)
+	mb.RecordOpenshiftDeploymentconfigAvailableDataPoint(ts, available)
+	mb.RecordOpenshiftDeploymentconfigUpdatedDataPoint(ts, updated)
+	mb.RecordOpenshiftDeploymentconfigReadyDataPoint(ts, ready)
+	rb := mb.NewResourceBuilder()
+	rb.SetOpenshiftDeploymentconfigUID(string(dc.GetUID()))
+	rb.SetOpenshiftDeploymentconfigName(dc.GetName())
+	rb.SetK8sNamespaceName(dc.GetNamespace())
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func newMetricsBuilder() *metadata.MetricsBuilder {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.OpenshiftDeploymentconfigDesired.Enabled = true
+	mbc.Metrics.OpenshiftDeploymentconfigAvailable.Enabled = true
+	mbc.Metrics.OpenshiftDeploymentconfigUpdated.Enabled = true
+	mbc.Metrics.OpenshiftDeploymentconfigReady.Enabled = true
+	return metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+}
+
+func TestDeploymentConfigMetrics(t *testing.T) {
+	dc := testutils.NewDeploymentConfig("1")
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, dc, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"openshift.deploymentconfig.uid":  "test-deploymentconfig-1-uid",
+			"openshift.deploymentconfig.name": "test-deploymentconfig-1",
+			"k8s.namespace.name":              "test-namespace",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(0), "openshift.deploymentconfig.available", pmetric.MetricTypeGauge, int64(2))
+	testutils.AssertMetricInt(t, ms.At(1), "openshift.deploymentconfig.desired", pmetric.MetricTypeGauge, int64(3))
+	testutils.AssertMetricInt(t, ms.At(2), "openshift.deploymentconfig.ready", pmetric.MetricTypeGauge, int64(2))
+	testutils.AssertMetricInt(t, ms.At(3), "openshift.deploymentconfig.updated", pmetric.MetricTypeGauge, int64(3))
+}
+
+func TestDeploymentConfigWithoutStatus(t *testing.T) {
+	dc := testutils.NewDeploymentConfig("1")
+	unstructured.RemoveNestedField(dc.Object, "status")
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, dc, pcommon.Timestamp(time.Now().UnixNano()))
+	ms := mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	require.Equal(t, 4, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(0), "openshift.deploymentconfig.available", pmetric.MetricTypeGauge, int64(0))
+	testutils.AssertMetricInt(t, ms.At(1), "openshift.deploymentconfig.desired", pmetric.MetricTypeGauge, int64(3))
+}
+
+func TestTransform(t *testing.T) {
+	want := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps.openshift.io/v1",
+		"kind":       "DeploymentConfig",
+		"metadata": map[string]any{
+			"name":      "test-deploymentconfig-1",
+			"namespace": "test-namespace",
+			"uid":       "test-deploymentconfig-1-uid",
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+		},
+		"status": map[string]any{
+			"availableReplicas": int64(2),
+			"updatedReplicas":   int64(3),
+			"readyReplicas":     int64(2),
+		},
+	}}
+	assert.Equal(t, want, Transform(testutils.NewDeploymentConfig("1")))
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoints // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/endpoints"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// RecordMetrics records the addresses of the endpoints summed across all subsets.
+// Endpoints without any subset are recorded as well, so services without backends are visible.
+func RecordMetrics(mb *metadata.MetricsBuilder, ep *corev1.Endpoints, ts pcommon.Timestamp) {
+	var addresses, notReady int64
+	for _, subset := range ep.Subsets {
+		addresses += int64(len(subset.Addresses))
+		notReady += int64(len(subset.NotReadyAddresses))
+	}
+	mb.RecordK8sEndpointsAddressCountDataPoint(ts, addresses)
+	mb.RecordK8sEndpointsNotReadyAddressCountDataPoint(ts, notReady)
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(ep.Namespace)
+	rb.SetK8sEndpointsName(ep.Name)
+	rb.SetK8sEndpointsUID(string(ep.UID))
+	// Endpoints are named after the service they belong to.
+	rb.SetK8sServiceName(ep.Name)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
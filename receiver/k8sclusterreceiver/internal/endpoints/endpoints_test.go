@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoints
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestEndpointsMetrics(t *testing.T) {
+	ep := testutils.NewEndpoints("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ep, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.endpoints.uid":  "test-service-1-uid",
+			"k8s.endpoints.name": "test-service-1",
+			"k8s.namespace.name": "test-namespace",
+			"k8s.service.name":   "test-service-1",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.endpoints.address.count", pmetric.MetricTypeGauge, int64(3))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.endpoints.not_ready_address.count", pmetric.MetricTypeGauge, int64(1))
+}
+
+func TestEmptyEndpointsMetrics(t *testing.T) {
+	ep := testutils.NewEndpoints("1")
+	ep.Subsets = nil
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ep, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	sms := m.ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.endpoints.address.count", pmetric.MetricTypeGauge, int64(0))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.endpoints.not_ready_address.count", pmetric.MetricTypeGauge, int64(0))
+}
+
+func metricsBuilderConfig() metadata.MetricsBuilderConfig {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sEndpointsAddressCount.Enabled = true
+	mbc.Metrics.K8sEndpointsNotReadyAddressCount.Enabled = true
+	mbc.ResourceAttributes.K8sServiceName.Enabled = true
+	return mbc
+}
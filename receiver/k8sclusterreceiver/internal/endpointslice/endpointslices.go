@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpointslice // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/endpointslice"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	discoveryv1 "k8s.io/api/discovery/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, es *discoveryv1.EndpointSlice, ts pcommon.Timestamp) {
+	if len(es.Endpoints) == 0 {
+		return
+	}
+
+	var addresses, ready int64
+	for _, ep := range es.Endpoints {
+		addresses += int64(len(ep.Addresses))
+		if ep.Conditions.Ready != nil && *ep.Conditions.Ready {
+			ready++
+		}
+	}
+	mb.RecordK8sEndpointsliceAddressCountDataPoint(ts, addresses)
+	mb.RecordK8sEndpointsliceReadyCountDataPoint(ts, ready)
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(es.Namespace)
+	rb.SetK8sEndpointsliceName(es.Name)
+	rb.SetK8sEndpointsliceUID(string(es.UID))
+	rb.SetK8sEndpointsliceAddressType(string(es.AddressType))
+	if serviceName, ok := es.Labels[discoveryv1.LabelServiceName]; ok {
+		rb.SetK8sServiceName(serviceName)
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpointslice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestEndpointSliceMetrics(t *testing.T) {
+	es := testutils.NewEndpointSlice("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, es, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.endpointslice.uid":          "test-endpointslice-1-uid",
+			"k8s.endpointslice.name":         "test-endpointslice-1",
+			"k8s.endpointslice.address_type": "IPv4",
+			"k8s.namespace.name":             "test-namespace",
+			"k8s.service.name":               "test-service-1",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.endpointslice.address.count", pmetric.MetricTypeGauge, int64(3))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.endpointslice.ready.count", pmetric.MetricTypeGauge, int64(1))
+}
+
+func TestEmptyEndpointSliceMetrics(t *testing.T) {
+	es := testutils.NewEndpointSlice("1")
+	es.Endpoints = nil
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, es, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 0, m.ResourceMetrics().Len())
+}
+
+func metricsBuilderConfig() metadata.MetricsBuilderConfig {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sEndpointsliceAddressCount.Enabled = true
+	mbc.Metrics.K8sEndpointsliceReadyCount.Enabled = true
+	mbc.ResourceAttributes.K8sServiceName.Enabled = true
+	return mbc
+}
@@ -7,18 +7,34 @@ import "k8s.io/apimachinery/pkg/runtime/schema"
 
 // Kubernetes group version kinds
 var (
-	Pod                     = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
-	Node                    = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Node"}
-	Namespace               = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Namespace"}
-	ReplicationController   = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ReplicationController"}
-	ResourceQuota           = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ResourceQuota"}
-	Service                 = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}
-	DaemonSet               = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}
-	Deployment              = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
-	ReplicaSet              = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}
-	StatefulSet             = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
-	Job                     = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
-	CronJob                 = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}
-	HorizontalPodAutoscaler = schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"}
-	ClusterResourceQuota    = schema.GroupVersionKind{Group: "quota", Version: "v1", Kind: "ClusterResourceQuota"}
+	Pod                         = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	Node                        = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Node"}
+	Namespace                   = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Namespace"}
+	ReplicationController       = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ReplicationController"}
+	ResourceQuota               = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ResourceQuota"}
+	Service                     = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Service"}
+	PersistentVolume            = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "PersistentVolume"}
+	PersistentVolumeClaim       = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "PersistentVolumeClaim"}
+	Endpoints                   = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Endpoints"}
+	LimitRange                  = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "LimitRange"}
+	Secret                      = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Secret"}
+	ServiceAccount              = schema.GroupVersionKind{Group: "", Version: "v1", Kind: "ServiceAccount"}
+	DaemonSet                   = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}
+	Deployment                  = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	ReplicaSet                  = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}
+	StatefulSet                 = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
+	Job                         = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	CronJob                     = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}
+	CronJobBeta                 = schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
+	EndpointSlice               = schema.GroupVersionKind{Group: "discovery.k8s.io", Version: "v1", Kind: "EndpointSlice"}
+	HorizontalPodAutoscaler     = schema.GroupVersionKind{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"}
+	HorizontalPodAutoscalerBeta = schema.GroupVersionKind{Group: "autoscaling", Version: "v2beta2", Kind: "HorizontalPodAutoscaler"}
+	PodDisruptionBudget         = schema.GroupVersionKind{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"}
+	Ingress                     = schema.GroupVersionKind{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"}
+	StorageClass                = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"}
+	PriorityClass               = schema.GroupVersionKind{Group: "scheduling.k8s.io", Version: "v1", Kind: "PriorityClass"}
+	Lease                       = schema.GroupVersionKind{Group: "coordination.k8s.io", Version: "v1", Kind: "Lease"}
+	ClusterResourceQuota        = schema.GroupVersionKind{Group: "quota.openshift.io", Version: "v1", Kind: "ClusterResourceQuota"}
+	VerticalPodAutoscaler       = schema.GroupVersionKind{Group: "autoscaling.k8s.io", Version: "v1", Kind: "VerticalPodAutoscaler"}
+	DeploymentConfig            = schema.GroupVersionKind{Group: "apps.openshift.io", Version: "v1", Kind: "DeploymentConfig"}
 )
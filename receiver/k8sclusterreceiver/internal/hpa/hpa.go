@@ -6,9 +6,11 @@ package hpa // import "github.com/open-telemetry/opentelemetry-collector-contrib
 import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
 func RecordMetrics(mb *metadata.MetricsBuilder, hpa *autoscalingv2.HorizontalPodAutoscaler, ts pcommon.Timestamp) {
@@ -16,6 +18,10 @@ func RecordMetrics(mb *metadata.MetricsBuilder, hpa *autoscalingv2.HorizontalPod
 	mb.RecordK8sHpaMinReplicasDataPoint(ts, int64(*hpa.Spec.MinReplicas))
 	mb.RecordK8sHpaCurrentReplicasDataPoint(ts, int64(hpa.Status.CurrentReplicas))
 	mb.RecordK8sHpaDesiredReplicasDataPoint(ts, int64(hpa.Status.DesiredReplicas))
+	recordMetricValues(mb, hpa.Spec.Metrics, hpa.Status.CurrentMetrics, ts)
+	for _, c := range hpa.Status.Conditions {
+		mb.RecordK8sHpaConditionDataPoint(ts, utils.ConditionStatusValue(c.Status), string(c.Type))
+	}
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sHpaUID(string(hpa.UID))
 	rb.SetK8sHpaName(hpa.Name)
@@ -28,3 +34,27 @@ func GetMetadata(hpa *autoscalingv2.HorizontalPodAutoscaler) map[experimentalmet
 		experimentalmetricmetadata.ResourceID(hpa.UID): metadata.GetGenericMetadata(&hpa.ObjectMeta, "HPA"),
 	}
 }
+
+// RecordMetricsBeta records the same metrics as RecordMetrics for clusters that only serve autoscaling/v2beta2.
+func RecordMetricsBeta(mb *metadata.MetricsBuilder, hpa *autoscalingv2beta2.HorizontalPodAutoscaler, ts pcommon.Timestamp) {
+	mb.RecordK8sHpaMaxReplicasDataPoint(ts, int64(hpa.Spec.MaxReplicas))
+	mb.RecordK8sHpaMinReplicasDataPoint(ts, int64(*hpa.Spec.MinReplicas))
+	mb.RecordK8sHpaCurrentReplicasDataPoint(ts, int64(hpa.Status.CurrentReplicas))
+	mb.RecordK8sHpaDesiredReplicasDataPoint(ts, int64(hpa.Status.DesiredReplicas))
+	recordMetricValues(mb, metricSpecsFromBeta(hpa.Spec.Metrics), metricStatusesFromBeta(hpa.Status.CurrentMetrics), ts)
+	// Conditions may not be populated by older autoscaling/v2beta2 controllers.
+	for _, c := range hpa.Status.Conditions {
+		mb.RecordK8sHpaConditionDataPoint(ts, utils.ConditionStatusValue(c.Status), string(c.Type))
+	}
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sHpaUID(string(hpa.UID))
+	rb.SetK8sHpaName(hpa.Name)
+	rb.SetK8sNamespaceName(hpa.Namespace)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func GetMetadataBeta(hpa *autoscalingv2beta2.HorizontalPodAutoscaler) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		experimentalmetricmetadata.ResourceID(hpa.UID): metadata.GetGenericMetadata(&hpa.ObjectMeta, "HPA"),
+	}
+}
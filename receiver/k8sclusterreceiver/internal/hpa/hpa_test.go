@@ -12,6 +12,11 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
@@ -46,3 +51,390 @@ func TestHPAMetrics(t *testing.T) {
 	testutils.AssertMetricInt(t, sms.Metrics().At(2), "k8s.hpa.max_replicas", pmetric.MetricTypeGauge, 10)
 	testutils.AssertMetricInt(t, sms.Metrics().At(3), "k8s.hpa.min_replicas", pmetric.MetricTypeGauge, 2)
 }
+
+func TestHPABetaMetrics(t *testing.T) {
+	hpa := testutils.NewHPABeta("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetricsBeta(mb, hpa, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.hpa.uid":        "test-hpa-1-uid",
+			"k8s.hpa.name":       "test-hpa-1",
+			"k8s.namespace.name": "test-namespace",
+		},
+		rm.Resource().Attributes().AsRaw())
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 4, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.hpa.current_replicas", pmetric.MetricTypeGauge, 5)
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.hpa.desired_replicas", pmetric.MetricTypeGauge, 7)
+	testutils.AssertMetricInt(t, sms.Metrics().At(2), "k8s.hpa.max_replicas", pmetric.MetricTypeGauge, 10)
+	testutils.AssertMetricInt(t, sms.Metrics().At(3), "k8s.hpa.min_replicas", pmetric.MetricTypeGauge, 2)
+}
+
+func TestHPAMetricValues(t *testing.T) {
+	utilization := int32(80)
+	currentUtilization := int32(65)
+	hpa := testutils.NewHPA("1")
+	hpa.Spec.Metrics = []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &utilization},
+			},
+		},
+		{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "queue_length"},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: resource.NewQuantity(100, resource.DecimalSI)},
+			},
+		},
+		{
+			// No current value yet, skipped.
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: resource.NewQuantity(10, resource.DecimalSI)},
+			},
+		},
+	}
+	hpa.Status.CurrentMetrics = []autoscalingv2.MetricStatus{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricStatus{
+				Name:    corev1.ResourceCPU,
+				Current: autoscalingv2.MetricValueStatus{AverageUtilization: &currentUtilization},
+			},
+		},
+		{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricStatus{
+				Metric:  autoscalingv2.MetricIdentifier{Name: "queue_length"},
+				Current: autoscalingv2.MetricValueStatus{Value: resource.NewQuantity(42, resource.DecimalSI)},
+			},
+		},
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHpaCurrentMetricValue.Enabled = true
+	mbc.Metrics.K8sHpaTargetMetricValue.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, hpa, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	values := map[string]float64{}
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Type() != pmetric.MetricTypeGauge || ms.At(i).Gauge().DataPoints().At(0).ValueType() != pmetric.NumberDataPointValueTypeDouble {
+			continue
+		}
+		dps := ms.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			name, _ := dps.At(j).Attributes().Get("hpa.metric.name")
+			typ, _ := dps.At(j).Attributes().Get("hpa.metric.type")
+			values[ms.At(i).Name()+" "+typ.Str()+" "+name.Str()] = dps.At(j).DoubleValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"k8s.hpa.current_metric_value Resource cpu":          65,
+		"k8s.hpa.target_metric_value Resource cpu":           80,
+		"k8s.hpa.current_metric_value External queue_length": 42,
+		"k8s.hpa.target_metric_value External queue_length":  100,
+	}, values)
+}
+
+func TestHPAMetricValuesBySelectorAndObject(t *testing.T) {
+	quantity := func(v int64) *resource.Quantity { return resource.NewQuantity(v, resource.DecimalSI) }
+	podsMetric := func(selector string, target int64) autoscalingv2.MetricSpec {
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "requests", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"path": selector}}},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: quantity(target)},
+			},
+		}
+	}
+	podsStatus := func(selector string, current int64) autoscalingv2.MetricStatus {
+		return autoscalingv2.MetricStatus{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricStatus{
+				Metric:  autoscalingv2.MetricIdentifier{Name: "requests", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"path": selector}}},
+				Current: autoscalingv2.MetricValueStatus{AverageValue: quantity(current)},
+			},
+		}
+	}
+	objectMetric := func(object string, target int64) autoscalingv2.MetricSpec {
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ObjectMetricSourceType,
+			Object: &autoscalingv2.ObjectMetricSource{
+				DescribedObject: autoscalingv2.CrossVersionObjectReference{Kind: "Ingress", Name: object},
+				Metric:          autoscalingv2.MetricIdentifier{Name: "hits"},
+				Target:          autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: quantity(target)},
+			},
+		}
+	}
+	objectStatus := func(object string, current int64) autoscalingv2.MetricStatus {
+		return autoscalingv2.MetricStatus{
+			Type: autoscalingv2.ObjectMetricSourceType,
+			Object: &autoscalingv2.ObjectMetricStatus{
+				DescribedObject: autoscalingv2.CrossVersionObjectReference{Kind: "Ingress", Name: object},
+				Metric:          autoscalingv2.MetricIdentifier{Name: "hits"},
+				Current:         autoscalingv2.MetricValueStatus{Value: quantity(current)},
+			},
+		}
+	}
+	hpa := testutils.NewHPA("1")
+	hpa.Spec.Metrics = []autoscalingv2.MetricSpec{
+		podsMetric("a", 15), podsMetric("b", 25), objectMetric("a", 35), objectMetric("b", 45),
+	}
+	// The current values are matched with their specs by selector and described object,
+	// regardless of their order.
+	hpa.Status.CurrentMetrics = []autoscalingv2.MetricStatus{
+		objectStatus("b", 40), objectStatus("a", 30), podsStatus("b", 20), podsStatus("a", 10),
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHpaCurrentMetricValue.Enabled = true
+	mbc.Metrics.K8sHpaTargetMetricValue.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, hpa, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	values := map[string][]float64{}
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "k8s.hpa.current_metric_value" && ms.At(i).Name() != "k8s.hpa.target_metric_value" {
+			continue
+		}
+		dps := ms.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			values[ms.At(i).Name()] = append(values[ms.At(i).Name()], dps.At(j).DoubleValue())
+		}
+	}
+	assert.Equal(t, map[string][]float64{
+		"k8s.hpa.current_metric_value": {10, 20, 30, 40},
+		"k8s.hpa.target_metric_value":  {15, 25, 35, 45},
+	}, values)
+}
+
+func TestHPABetaMetricValues(t *testing.T) {
+	utilization := int32(80)
+	currentUtilization := int32(65)
+	hpa := testutils.NewHPABeta("1")
+	hpa.Spec.Metrics = []autoscalingv2beta2.MetricSpec{
+		{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricSource{
+				Name:   corev1.ResourceCPU,
+				Target: autoscalingv2beta2.MetricTarget{Type: autoscalingv2beta2.UtilizationMetricType, AverageUtilization: &utilization},
+			},
+		},
+	}
+	hpa.Status.CurrentMetrics = []autoscalingv2beta2.MetricStatus{
+		{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricStatus{
+				Name:    corev1.ResourceCPU,
+				Current: autoscalingv2beta2.MetricValueStatus{AverageUtilization: &currentUtilization},
+			},
+		},
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHpaCurrentMetricValue.Enabled = true
+	mbc.Metrics.K8sHpaTargetMetricValue.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetricsBeta(mb, hpa, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	values := map[string]float64{}
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "k8s.hpa.current_metric_value" && ms.At(i).Name() != "k8s.hpa.target_metric_value" {
+			continue
+		}
+		dp := ms.At(i).Gauge().DataPoints().At(0)
+		name, _ := dp.Attributes().Get("hpa.metric.name")
+		typ, _ := dp.Attributes().Get("hpa.metric.type")
+		values[ms.At(i).Name()+" "+typ.Str()+" "+name.Str()] = dp.DoubleValue()
+	}
+	assert.Equal(t, map[string]float64{
+		"k8s.hpa.current_metric_value Resource cpu": 65,
+		"k8s.hpa.target_metric_value Resource cpu":  80,
+	}, values)
+}
+
+func TestHPAExternalMetricValues(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "orders"}}
+	hpa := testutils.NewHPA("1")
+	hpa.Spec.Metrics = []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "queue_length", Selector: selector},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: resource.NewQuantity(30, resource.DecimalSI)},
+			},
+		},
+		{
+			// No current value yet, only the target is recorded.
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "lag"},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: resource.NewQuantity(1000, resource.DecimalSI)},
+			},
+		},
+	}
+	hpa.Status.CurrentMetrics = []autoscalingv2.MetricStatus{
+		{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricStatus{
+				Metric:  autoscalingv2.MetricIdentifier{Name: "queue_length", Selector: selector},
+				Current: autoscalingv2.MetricValueStatus{AverageValue: resource.NewQuantity(12, resource.DecimalSI)},
+			},
+		},
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHpaExternalCurrentValue.Enabled = true
+	mbc.Metrics.K8sHpaExternalTargetValue.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, hpa, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	values := map[string]float64{}
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "k8s.hpa.external_current_value" && ms.At(i).Name() != "k8s.hpa.external_target_value" {
+			continue
+		}
+		dps := ms.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			name, _ := dps.At(j).Attributes().Get("hpa.metric.name")
+			sel, _ := dps.At(j).Attributes().Get("hpa.metric.selector")
+			values[ms.At(i).Name()+" "+name.Str()+" "+sel.Str()] = dps.At(j).DoubleValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"k8s.hpa.external_current_value queue_length queue=orders": 12,
+		"k8s.hpa.external_target_value queue_length queue=orders":  30,
+		"k8s.hpa.external_target_value lag ":                       1000,
+	}, values)
+}
+
+func TestHPABetaExternalMetricValues(t *testing.T) {
+	hpa := testutils.NewHPABeta("1")
+	hpa.Spec.Metrics = []autoscalingv2beta2.MetricSpec{
+		{
+			Type: autoscalingv2beta2.ExternalMetricSourceType,
+			External: &autoscalingv2beta2.ExternalMetricSource{
+				Metric: autoscalingv2beta2.MetricIdentifier{Name: "queue_length", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "orders"}}},
+				Target: autoscalingv2beta2.MetricTarget{Type: autoscalingv2beta2.ValueMetricType, Value: resource.NewQuantity(30, resource.DecimalSI)},
+			},
+		},
+	}
+	hpa.Status.CurrentMetrics = []autoscalingv2beta2.MetricStatus{
+		{
+			Type: autoscalingv2beta2.ExternalMetricSourceType,
+			External: &autoscalingv2beta2.ExternalMetricStatus{
+				Metric:  autoscalingv2beta2.MetricIdentifier{Name: "queue_length", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "orders"}}},
+				Current: autoscalingv2beta2.MetricValueStatus{Value: resource.NewQuantity(12, resource.DecimalSI)},
+			},
+		},
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHpaExternalCurrentValue.Enabled = true
+	mbc.Metrics.K8sHpaExternalTargetValue.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetricsBeta(mb, hpa, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	values := map[string]float64{}
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "k8s.hpa.external_current_value" && ms.At(i).Name() != "k8s.hpa.external_target_value" {
+			continue
+		}
+		dp := ms.At(i).Gauge().DataPoints().At(0)
+		sel, _ := dp.Attributes().Get("hpa.metric.selector")
+		values[ms.At(i).Name()+" "+sel.Str()] = dp.DoubleValue()
+	}
+	assert.Equal(t, map[string]float64{
+		"k8s.hpa.external_current_value queue=orders": 12,
+		"k8s.hpa.external_target_value queue=orders":  30,
+	}, values)
+}
+
+func TestHPAWithoutExternalMetrics(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHpaExternalCurrentValue.Enabled = true
+	mbc.Metrics.K8sHpaExternalTargetValue.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, testutils.NewHPA("1"), pcommon.Timestamp(time.Now().UnixNano()))
+	ms := mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		assert.NotContains(t, []string{"k8s.hpa.external_current_value", "k8s.hpa.external_target_value"}, ms.At(i).Name())
+	}
+}
+
+func TestHPAConditionMetrics(t *testing.T) {
+	hpa := testutils.NewHPA("1")
+	hpa.Status.Conditions = []autoscalingv2.HorizontalPodAutoscalerCondition{
+		{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue},
+		{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionUnknown},
+		{Type: autoscalingv2.ScalingLimited, Status: corev1.ConditionTrue},
+	}
+	hpaBeta := testutils.NewHPABeta("2")
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHpaCondition.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, hpa, ts)
+	// Beta objects without conditions don't get condition data points.
+	RecordMetricsBeta(mb, hpaBeta, ts)
+	m := mb.Emit()
+
+	conditions := map[string]map[string]int64{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, _ := rm.Resource().Attributes().Get("k8s.hpa.name")
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() != "k8s.hpa.condition" {
+				continue
+			}
+			conditions[name.Str()] = map[string]int64{}
+			dps := ms.At(j).Gauge().DataPoints()
+			for k := 0; k < dps.Len(); k++ {
+				condition, _ := dps.At(k).Attributes().Get("condition")
+				conditions[name.Str()][condition.Str()] = dps.At(k).IntValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]map[string]int64{
+		"test-hpa-1": {
+			"AbleToScale":    1,
+			"ScalingActive":  -1,
+			"ScalingLimited": 1,
+		},
+	}, conditions)
+}
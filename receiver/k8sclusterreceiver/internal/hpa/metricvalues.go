@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hpa // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/hpa"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// metricValue holds the value fields shared by HPA metric targets and metric statuses.
+type metricValue struct {
+	value              *resource.Quantity
+	averageValue       *resource.Quantity
+	averageUtilization *int32
+}
+
+// get returns the value for the given target type: "Utilization", "AverageValue" or "Value".
+func (mv metricValue) get(targetType string) (float64, bool) {
+	switch targetType {
+	case string(autoscalingv2.UtilizationMetricType):
+		if mv.averageUtilization != nil {
+			return float64(*mv.averageUtilization), true
+		}
+	case string(autoscalingv2.AverageValueMetricType):
+		if mv.averageValue != nil {
+			return mv.averageValue.AsApproximateFloat64(), true
+		}
+	case string(autoscalingv2.ValueMetricType):
+		if mv.value != nil {
+			return mv.value.AsApproximateFloat64(), true
+		}
+	}
+	return 0, false
+}
+
+// metricKey identifies a metric tracked by an HPA, so that its current value can be matched with
+// its spec. Metrics of the same type and name can differ by their selector or described object.
+type metricKey struct {
+	metricType string
+	name       string
+	// Label selector of pods, object and external metrics in its string form.
+	selector string
+	// Object described by object metrics, as "<kind>/<name>".
+	object string
+}
+
+// metricSpec is a metric tracked by an HPA together with its target value.
+type metricSpec struct {
+	metricKey
+	targetType string
+	target     metricValue
+}
+
+// metricSpecs converts the metrics tracked by an HPA into metric specs.
+func metricSpecs(metrics []autoscalingv2.MetricSpec) []metricSpec {
+	var specs []metricSpec
+	for _, m := range metrics {
+		key := metricKey{metricType: string(m.Type)}
+		var target autoscalingv2.MetricTarget
+		switch {
+		case m.Resource != nil:
+			key.name, target = string(m.Resource.Name), m.Resource.Target
+		case m.ContainerResource != nil:
+			key.name, target = m.ContainerResource.Container+"/"+string(m.ContainerResource.Name), m.ContainerResource.Target
+		case m.Pods != nil:
+			key.name, key.selector, target = m.Pods.Metric.Name, selectorString(m.Pods.Metric.Selector), m.Pods.Target
+		case m.Object != nil:
+			key.name, key.selector, target = m.Object.Metric.Name, selectorString(m.Object.Metric.Selector), m.Object.Target
+			key.object = m.Object.DescribedObject.Kind + "/" + m.Object.DescribedObject.Name
+		case m.External != nil:
+			key.name, key.selector, target = m.External.Metric.Name, selectorString(m.External.Metric.Selector), m.External.Target
+		default:
+			continue
+		}
+		specs = append(specs, metricSpec{
+			metricKey:  key,
+			targetType: string(target.Type),
+			target:     metricValue{value: target.Value, averageValue: target.AverageValue, averageUtilization: target.AverageUtilization},
+		})
+	}
+	return specs
+}
+
+// currentMetricValues returns the current values of the metrics tracked by an HPA by metric key.
+func currentMetricValues(statuses []autoscalingv2.MetricStatus) map[metricKey]metricValue {
+	current := map[metricKey]metricValue{}
+	for _, m := range statuses {
+		key := metricKey{metricType: string(m.Type)}
+		var cur autoscalingv2.MetricValueStatus
+		switch {
+		case m.Resource != nil:
+			key.name, cur = string(m.Resource.Name), m.Resource.Current
+		case m.ContainerResource != nil:
+			key.name, cur = m.ContainerResource.Container+"/"+string(m.ContainerResource.Name), m.ContainerResource.Current
+		case m.Pods != nil:
+			key.name, key.selector, cur = m.Pods.Metric.Name, selectorString(m.Pods.Metric.Selector), m.Pods.Current
+		case m.Object != nil:
+			key.name, key.selector, cur = m.Object.Metric.Name, selectorString(m.Object.Metric.Selector), m.Object.Current
+			key.object = m.Object.DescribedObject.Kind + "/" + m.Object.DescribedObject.Name
+		case m.External != nil:
+			key.name, key.selector, cur = m.External.Metric.Name, selectorString(m.External.Metric.Selector), m.External.Current
+		default:
+			continue
+		}
+		current[key] = metricValue{value: cur.Value, averageValue: cur.AverageValue, averageUtilization: cur.AverageUtilization}
+	}
+	return current
+}
+
+// recordMetricValues records the target and current value of each metric tracked by an HPA.
+// Metrics without a current value, e.g. of autoscalers that were just created, are skipped,
+// except for the target value of external metrics, which is recorded with their selector.
+func recordMetricValues(mb *metadata.MetricsBuilder, metrics []autoscalingv2.MetricSpec, statuses []autoscalingv2.MetricStatus, ts pcommon.Timestamp) {
+	current := currentMetricValues(statuses)
+	for _, spec := range metricSpecs(metrics) {
+		external := spec.metricType == string(autoscalingv2.ExternalMetricSourceType)
+		targetVal, hasTarget := spec.target.get(spec.targetType)
+		if external && hasTarget {
+			mb.RecordK8sHpaExternalTargetValueDataPoint(ts, targetVal, spec.name, spec.selector)
+		}
+		cur, ok := current[spec.metricKey]
+		if !ok {
+			continue
+		}
+		curVal, ok := cur.get(spec.targetType)
+		if !ok {
+			continue
+		}
+		if external {
+			mb.RecordK8sHpaExternalCurrentValueDataPoint(ts, curVal, spec.name, spec.selector)
+		}
+		mb.RecordK8sHpaCurrentMetricValueDataPoint(ts, curVal, spec.name, spec.metricType)
+		if hasTarget {
+			mb.RecordK8sHpaTargetMetricValueDataPoint(ts, targetVal, spec.name, spec.metricType)
+		}
+	}
+}
+
+// selectorString returns the label selector of an external metric in its string form, or an
+// empty string for metrics without a selector.
+func selectorString(selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return ""
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return metav1.FormatLabelSelector(selector)
+	}
+	return s.String()
+}
+
+// metricSpecsFromBeta converts autoscaling/v2beta2 metric specs to autoscaling/v2, which has the
+// same fields, so that both versions share the same metric spec conversion.
+func metricSpecsFromBeta(metrics []autoscalingv2beta2.MetricSpec) []autoscalingv2.MetricSpec {
+	specs := make([]autoscalingv2.MetricSpec, 0, len(metrics))
+	for _, m := range metrics {
+		spec := autoscalingv2.MetricSpec{Type: autoscalingv2.MetricSourceType(m.Type)}
+		switch {
+		case m.Resource != nil:
+			spec.Resource = &autoscalingv2.ResourceMetricSource{
+				Name:   m.Resource.Name,
+				Target: metricTargetFromBeta(m.Resource.Target),
+			}
+		case m.ContainerResource != nil:
+			spec.ContainerResource = &autoscalingv2.ContainerResourceMetricSource{
+				Name:      m.ContainerResource.Name,
+				Container: m.ContainerResource.Container,
+				Target:    metricTargetFromBeta(m.ContainerResource.Target),
+			}
+		case m.Pods != nil:
+			spec.Pods = &autoscalingv2.PodsMetricSource{
+				Metric: metricIdentifierFromBeta(m.Pods.Metric),
+				Target: metricTargetFromBeta(m.Pods.Target),
+			}
+		case m.Object != nil:
+			spec.Object = &autoscalingv2.ObjectMetricSource{
+				DescribedObject: objectReferenceFromBeta(m.Object.DescribedObject),
+				Metric:          metricIdentifierFromBeta(m.Object.Metric),
+				Target:          metricTargetFromBeta(m.Object.Target),
+			}
+		case m.External != nil:
+			spec.External = &autoscalingv2.ExternalMetricSource{
+				Metric: metricIdentifierFromBeta(m.External.Metric),
+				Target: metricTargetFromBeta(m.External.Target),
+			}
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// metricStatusesFromBeta converts autoscaling/v2beta2 metric statuses to autoscaling/v2.
+func metricStatusesFromBeta(statuses []autoscalingv2beta2.MetricStatus) []autoscalingv2.MetricStatus {
+	converted := make([]autoscalingv2.MetricStatus, 0, len(statuses))
+	for _, m := range statuses {
+		status := autoscalingv2.MetricStatus{Type: autoscalingv2.MetricSourceType(m.Type)}
+		switch {
+		case m.Resource != nil:
+			status.Resource = &autoscalingv2.ResourceMetricStatus{
+				Name:    m.Resource.Name,
+				Current: metricValueStatusFromBeta(m.Resource.Current),
+			}
+		case m.ContainerResource != nil:
+			status.ContainerResource = &autoscalingv2.ContainerResourceMetricStatus{
+				Name:      m.ContainerResource.Name,
+				Container: m.ContainerResource.Container,
+				Current:   metricValueStatusFromBeta(m.ContainerResource.Current),
+			}
+		case m.Pods != nil:
+			status.Pods = &autoscalingv2.PodsMetricStatus{
+				Metric:  metricIdentifierFromBeta(m.Pods.Metric),
+				Current: metricValueStatusFromBeta(m.Pods.Current),
+			}
+		case m.Object != nil:
+			status.Object = &autoscalingv2.ObjectMetricStatus{
+				DescribedObject: objectReferenceFromBeta(m.Object.DescribedObject),
+				Metric:          metricIdentifierFromBeta(m.Object.Metric),
+				Current:         metricValueStatusFromBeta(m.Object.Current),
+			}
+		case m.External != nil:
+			status.External = &autoscalingv2.ExternalMetricStatus{
+				Metric:  metricIdentifierFromBeta(m.External.Metric),
+				Current: metricValueStatusFromBeta(m.External.Current),
+			}
+		}
+		converted = append(converted, status)
+	}
+	return converted
+}
+
+func metricIdentifierFromBeta(id autoscalingv2beta2.MetricIdentifier) autoscalingv2.MetricIdentifier {
+	return autoscalingv2.MetricIdentifier{Name: id.Name, Selector: id.Selector}
+}
+
+func metricTargetFromBeta(target autoscalingv2beta2.MetricTarget) autoscalingv2.MetricTarget {
+	return autoscalingv2.MetricTarget{
+		Type:               autoscalingv2.MetricTargetType(target.Type),
+		Value:              target.Value,
+		AverageValue:       target.AverageValue,
+		AverageUtilization: target.AverageUtilization,
+	}
+}
+
+func metricValueStatusFromBeta(status autoscalingv2beta2.MetricValueStatus) autoscalingv2.MetricValueStatus {
+	return autoscalingv2.MetricValueStatus{
+		Value:              status.Value,
+		AverageValue:       status.AverageValue,
+		AverageUtilization: status.AverageUtilization,
+	}
+}
+
+func objectReferenceFromBeta(ref autoscalingv2beta2.CrossVersionObjectReference) autoscalingv2.CrossVersionObjectReference {
+	return autoscalingv2.CrossVersionObjectReference{Kind: ref.Kind, Name: ref.Name, APIVersion: ref.APIVersion}
+}
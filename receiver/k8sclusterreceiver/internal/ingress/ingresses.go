@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/ingress"
+
+import (
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+const (
+	// Keys for ingress metadata.
+	ingressKeyClassName = "ingress_class_name"
+	ingressKeyHosts     = "hosts"
+)
+
+// Transform transforms the ingress to remove the fields that we don't use to reduce RAM utilization.
+// IMPORTANT: Make sure to update this function before using new ingress fields.
+func Transform(ingress *networkingv1.Ingress) *networkingv1.Ingress {
+	newIngress := &networkingv1.Ingress{
+		ObjectMeta: metadata.TransformObjectMeta(ingress.ObjectMeta),
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: ingress.Spec.IngressClassName,
+		},
+	}
+	for _, rule := range ingress.Spec.Rules {
+		newIngress.Spec.Rules = append(newIngress.Spec.Rules, networkingv1.IngressRule{Host: rule.Host})
+	}
+	for _, tls := range ingress.Spec.TLS {
+		newIngress.Spec.TLS = append(newIngress.Spec.TLS, networkingv1.IngressTLS{Hosts: tls.Hosts})
+	}
+	return newIngress
+}
+
+// RecordMetrics records the rule and TLS counts of the ingress. Ingresses with only a default
+// backend are reported with a rule count of 0.
+func RecordMetrics(mb *metadata.MetricsBuilder, ingress *networkingv1.Ingress, ts pcommon.Timestamp) {
+	mb.RecordK8sIngressRuleCountDataPoint(ts, int64(len(ingress.Spec.Rules)))
+	mb.RecordK8sIngressTLSCountDataPoint(ts, int64(len(ingress.Spec.TLS)))
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(ingress.Namespace)
+	rb.SetK8sIngressName(ingress.Name)
+	rb.SetK8sIngressUID(string(ingress.UID))
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// GetMetadata returns all metadata associated with the ingress, including its class name
+// and the sorted, comma separated hosts of its rules.
+func GetMetadata(ingress *networkingv1.Ingress) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	km := metadata.GetGenericMetadata(&ingress.ObjectMeta, constants.K8sKindIngress)
+	if ingress.Spec.IngressClassName != nil {
+		km.Metadata[ingressKeyClassName] = *ingress.Spec.IngressClassName
+	}
+	if hosts := getHosts(ingress); hosts != "" {
+		km.Metadata[ingressKeyHosts] = hosts
+	}
+
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(ingress.UID): km}
+}
+
+func getHosts(ingress *networkingv1.Ingress) string {
+	seen := map[string]bool{}
+	var hosts []string
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host != "" && !seen[rule.Host] {
+			seen[rule.Host] = true
+			hosts = append(hosts, rule.Host)
+		}
+	}
+	sort.Strings(hosts)
+	return strings.Join(hosts, ",")
+}
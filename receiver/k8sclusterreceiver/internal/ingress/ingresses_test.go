@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestIngressMetrics(t *testing.T) {
+	ingress := testutils.NewIngress("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ingress, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.ingress.uid":    "test-ingress-1-uid",
+			"k8s.ingress.name":   "test-ingress-1",
+			"k8s.namespace.name": "test-namespace",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.ingress.rule.count", pmetric.MetricTypeGauge, int64(2))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.ingress.tls.count", pmetric.MetricTypeGauge, int64(1))
+}
+
+func TestDefaultBackendIngressMetrics(t *testing.T) {
+	ingress := testutils.NewIngress("1")
+	ingress.Spec.Rules = nil
+	ingress.Spec.TLS = nil
+	ingress.Spec.DefaultBackend = &networkingv1.IngressBackend{
+		Service: &networkingv1.IngressServiceBackend{Name: "default"},
+	}
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, ingress, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	sms := m.ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.ingress.rule.count", pmetric.MetricTypeGauge, int64(0))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.ingress.tls.count", pmetric.MetricTypeGauge, int64(0))
+}
+
+func TestIngressMetadata(t *testing.T) {
+	ingress := testutils.NewIngress("1")
+	ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{Host: "a.example.com"}, networkingv1.IngressRule{})
+
+	actual := GetMetadata(ingress)
+	require.Len(t, actual, 1)
+	km := actual["test-ingress-1-uid"]
+	require.NotNil(t, km)
+	assert.Equal(t, "k8s.ingress", km.EntityType)
+	assert.Equal(t, "k8s.ingress.uid", km.ResourceIDKey)
+	assert.Equal(t, "nginx", km.Metadata["ingress_class_name"])
+	assert.Equal(t, "a.example.com,b.example.com", km.Metadata["hosts"])
+
+	// Ingresses without a class or hosts have no such metadata.
+	ingress.Spec.IngressClassName = nil
+	ingress.Spec.Rules = nil
+	km = GetMetadata(ingress)["test-ingress-1-uid"]
+	assert.NotContains(t, km.Metadata, "ingress_class_name")
+	assert.NotContains(t, km.Metadata, "hosts")
+}
+
+func metricsBuilderConfig() metadata.MetricsBuilderConfig {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sIngressRuleCount.Enabled = true
+	mbc.Metrics.K8sIngressTLSCount.Enabled = true
+	return mbc
+}
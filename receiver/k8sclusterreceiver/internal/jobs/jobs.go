@@ -4,8 +4,12 @@
 package jobs // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
 
 import (
+	"strconv"
+	"strings"
+
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
@@ -23,6 +27,18 @@ func RecordMetrics(mb *metadata.MetricsBuilder, j *batchv1.Job, ts pcommon.Times
 	if j.Spec.Parallelism != nil {
 		mb.RecordK8sJobMaxParallelPodsDataPoint(ts, int64(*j.Spec.Parallelism))
 	}
+	if j.Status.StartTime != nil && j.Status.CompletionTime != nil {
+		mb.RecordK8sJobDurationDataPoint(ts, int64(j.Status.CompletionTime.Sub(j.Status.StartTime.Time).Seconds()))
+	}
+	if j.Spec.CompletionMode != nil && *j.Spec.CompletionMode == batchv1.IndexedCompletion {
+		if count, ok := countCompletedIndexes(j.Status.CompletedIndexes); ok {
+			mb.RecordK8sJobCompletedIndexesCountDataPoint(ts, count)
+		}
+	}
+	if j.Spec.BackoffLimit != nil {
+		mb.RecordK8sJobBackoffLimitDataPoint(ts, int64(*j.Spec.BackoffLimit))
+	}
+	mb.RecordK8sJobFailedExhaustedDataPoint(ts, boolToInt64(hasFailed(j)))
 
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sNamespaceName(j.Namespace)
@@ -31,21 +47,78 @@ func RecordMetrics(mb *metadata.MetricsBuilder, j *batchv1.Job, ts pcommon.Times
 	mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// hasFailed returns whether the job has a true Failed condition, or a true FailureTarget condition
+// which is set first when a pod failure policy fails the job. The reason of the condition, e.g.
+// BackoffLimitExceeded, DeadlineExceeded or PodFailurePolicy, doesn't matter.
+func hasFailed(j *batchv1.Job) bool {
+	for _, c := range j.Status.Conditions {
+		if (c.Type == batchv1.JobFailed || c.Type == batchv1.JobFailureTarget) && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// countCompletedIndexes returns the number of indexes in the compressed format used by
+// the job status, e.g. "1,3-5,7" contains 5 indexes. It returns false if the format is invalid.
+func countCompletedIndexes(completedIndexes string) (int64, bool) {
+	if completedIndexes == "" {
+		return 0, true
+	}
+	var count int64
+	for _, interval := range strings.Split(completedIndexes, ",") {
+		first, last, isRange := strings.Cut(interval, "-")
+		start, err := strconv.ParseInt(first, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+				return 0, false
+			}
+		}
+		count += end - start + 1
+	}
+	return count, true
+}
+
 // Transform transforms the job to remove the fields that we don't use to reduce RAM utilization.
 // IMPORTANT: Make sure to update this function before using new job fields.
 func Transform(job *batchv1.Job) *batchv1.Job {
-	return &batchv1.Job{
+	newJob := &batchv1.Job{
 		ObjectMeta: metadata.TransformObjectMeta(job.ObjectMeta),
 		Spec: batchv1.JobSpec{
-			Completions: job.Spec.Completions,
-			Parallelism: job.Spec.Parallelism,
+			Completions:    job.Spec.Completions,
+			Parallelism:    job.Spec.Parallelism,
+			CompletionMode: job.Spec.CompletionMode,
+			BackoffLimit:   job.Spec.BackoffLimit,
 		},
 		Status: batchv1.JobStatus{
-			Active:    job.Status.Active,
-			Succeeded: job.Status.Succeeded,
-			Failed:    job.Status.Failed,
+			Active:           job.Status.Active,
+			Succeeded:        job.Status.Succeeded,
+			Failed:           job.Status.Failed,
+			StartTime:        job.Status.StartTime,
+			CompletionTime:   job.Status.CompletionTime,
+			CompletedIndexes: job.Status.CompletedIndexes,
 		},
 	}
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed || c.Type == batchv1.JobFailureTarget {
+			newJob.Status.Conditions = append(newJob.Status.Conditions, batchv1.JobCondition{
+				Type:   c.Type,
+				Status: c.Status,
+			})
+		}
+	}
+	return newJob
 }
 
 func GetMetadata(j *batchv1.Job) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -58,6 +59,149 @@ func TestJobMetrics(t *testing.T) {
 	)
 }
 
+func TestJobDurationAndCompletedIndexesMetrics(t *testing.T) {
+	start := metav1.NewTime(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC))
+	completion := metav1.NewTime(start.Add(90 * time.Second))
+	indexed := batchv1.IndexedCompletion
+
+	j := testutils.NewJob("1")
+	j.Spec.CompletionMode = &indexed
+	j.Status.StartTime = &start
+	j.Status.CompletionTime = &completion
+	j.Status.CompletedIndexes = "1,3-5,7"
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sJobDuration.Enabled = true
+	mbc.Metrics.K8sJobCompletedIndexesCount.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, j, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 7, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(1), "k8s.job.completed_indexes_count", pmetric.MetricTypeGauge, 5)
+	testutils.AssertMetricInt(t, ms.At(3), "k8s.job.duration", pmetric.MetricTypeGauge, 90)
+}
+
+func TestJobSpecMetricsOmittedIndependently(t *testing.T) {
+	j := testutils.NewJob("1")
+	j.Spec.Parallelism = nil
+
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, j, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(1), "k8s.job.desired_successful_pods", pmetric.MetricTypeGauge, 10)
+	for i := 0; i < ms.Len(); i++ {
+		assert.NotEqual(t, "k8s.job.max_parallel_pods", ms.At(i).Name())
+	}
+}
+
+func TestJobNotCompletedMetrics(t *testing.T) {
+	start := metav1.NewTime(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC))
+
+	j := testutils.NewJob("1")
+	j.Status.StartTime = &start
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sJobDuration.Enabled = true
+	mbc.Metrics.K8sJobCompletedIndexesCount.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, j, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	// Neither duration nor completed indexes count is emitted for a running non-indexed job.
+	require.Equal(t, 5, m.MetricCount())
+}
+
+func TestJobBackoffMetrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []batchv1.JobCondition
+		want       int64
+	}{
+		{
+			name: "running",
+		},
+		{
+			name:       "backoff_limit_exceeded",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"}},
+			want:       1,
+		},
+		{
+			name: "pod_failure_policy",
+			conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailureTarget, Status: corev1.ConditionTrue, Reason: "PodFailurePolicy"},
+			},
+			want: 1,
+		},
+		{
+			name:       "completed",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+		},
+		{
+			name:       "failed_false",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionFalse}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backoffLimit := int32(6)
+			j := testutils.NewJob("1")
+			j.Spec.BackoffLimit = &backoffLimit
+			j.Status.Conditions = tt.conditions
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sJobBackoffLimit.Enabled = true
+			mbc.Metrics.K8sJobFailedExhausted.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, Transform(j), pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			ms.Sort(func(a, b pmetric.Metric) bool {
+				return a.Name() < b.Name()
+			})
+			testutils.AssertMetricInt(t, ms.At(1), "k8s.job.backoff_limit", pmetric.MetricTypeGauge, 6)
+			testutils.AssertMetricInt(t, ms.At(3), "k8s.job.failed_exhausted", pmetric.MetricTypeGauge, tt.want)
+		})
+	}
+}
+
+func TestCountCompletedIndexes(t *testing.T) {
+	tests := []struct {
+		completedIndexes string
+		want             int64
+		valid            bool
+	}{
+		{completedIndexes: "", want: 0, valid: true},
+		{completedIndexes: "0", want: 1, valid: true},
+		{completedIndexes: "1,3-5,7", want: 5, valid: true},
+		{completedIndexes: "0-9", want: 10, valid: true},
+		{completedIndexes: "a", valid: false},
+		{completedIndexes: "5-3", valid: false},
+		{completedIndexes: "1-", valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.completedIndexes, func(t *testing.T) {
+			got, ok := countCompletedIndexes(tt.completedIndexes)
+			assert.Equal(t, tt.valid, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestTransform(t *testing.T) {
 	originalJob := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package limitrange // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/limitrange"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, lr *corev1.LimitRange, ts pcommon.Timestamp) {
+	for _, item := range lr.Spec.Limits {
+		limitType := string(item.Type)
+		if q, ok := item.DefaultRequest[corev1.ResourceCPU]; ok {
+			mb.RecordK8sLimitrangeDefaultCPURequestDataPoint(ts, float64(q.MilliValue())/1000.0, limitType)
+		}
+		if q, ok := item.DefaultRequest[corev1.ResourceMemory]; ok {
+			mb.RecordK8sLimitrangeDefaultMemoryRequestDataPoint(ts, q.Value(), limitType)
+		}
+		for k, v := range item.Max {
+			mb.RecordK8sLimitrangeMaxDataPoint(ts, quantityValue(k, v), limitType, string(k))
+		}
+		for k, v := range item.Min {
+			mb.RecordK8sLimitrangeMinDataPoint(ts, quantityValue(k, v), limitType, string(k))
+		}
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(lr.Namespace)
+	rb.SetK8sLimitrangeUID(string(lr.UID))
+	rb.SetK8sLimitrangeName(lr.Name)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func quantityValue(name corev1.ResourceName, q resource.Quantity) int64 {
+	if name == corev1.ResourceCPU {
+		return q.MilliValue()
+	}
+	return q.Value()
+}
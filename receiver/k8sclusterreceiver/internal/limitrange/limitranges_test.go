@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package limitrange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestLimitRangeMetrics(t *testing.T) {
+	lr := testutils.NewLimitRange("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, lr, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.limitrange.uid":  "test-limitrange-1-uid",
+			"k8s.limitrange.name": "test-limitrange-1",
+			"k8s.namespace.name":  "test-namespace",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 4, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+
+	defaultCPU := sms.Metrics().At(0)
+	assert.Equal(t, "k8s.limitrange.default_cpu_request", defaultCPU.Name())
+	require.Equal(t, 1, defaultCPU.Gauge().DataPoints().Len())
+	assert.Equal(t, 0.1, defaultCPU.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, map[string]any{"limit.type": "Container"}, defaultCPU.Gauge().DataPoints().At(0).Attributes().AsRaw())
+
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.limitrange.default_memory_request", pmetric.MetricTypeGauge, int64(64*1024*1024))
+
+	// Max is set for both the Container and the PersistentVolumeClaim items.
+	assert.Equal(t, map[string]int64{
+		"Container/cpu":                 2000,
+		"Container/memory":              1024 * 1024 * 1024,
+		"PersistentVolumeClaim/storage": 10 * 1024 * 1024 * 1024,
+	}, dataPointsByTypeAndResource(sms.Metrics().At(2)))
+	assert.Equal(t, "k8s.limitrange.max", sms.Metrics().At(2).Name())
+
+	// Only the PersistentVolumeClaim item sets a min.
+	assert.Equal(t, map[string]int64{
+		"PersistentVolumeClaim/storage": 1024 * 1024 * 1024,
+	}, dataPointsByTypeAndResource(sms.Metrics().At(3)))
+	assert.Equal(t, "k8s.limitrange.min", sms.Metrics().At(3).Name())
+}
+
+func dataPointsByTypeAndResource(m pmetric.Metric) map[string]int64 {
+	values := map[string]int64{}
+	dps := m.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		limitType, _ := dps.At(i).Attributes().Get("limit.type")
+		res, _ := dps.At(i).Attributes().Get("resource")
+		values[limitType.Str()+"/"+res.Str()] = dps.At(i).IntValue()
+	}
+	return values
+}
+
+func metricsBuilderConfig() metadata.MetricsBuilderConfig {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sLimitrangeDefaultCPURequest.Enabled = true
+	mbc.Metrics.K8sLimitrangeDefaultMemoryRequest.Enabled = true
+	mbc.Metrics.K8sLimitrangeMax.Enabled = true
+	mbc.Metrics.K8sLimitrangeMin.Enabled = true
+	return mbc
+}
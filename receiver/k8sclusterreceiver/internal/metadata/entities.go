@@ -23,8 +23,13 @@ func GetEntityEvents(oldMetadata, newMetadata map[metadataPkg.ResourceID]*Kubern
 		}
 	}
 
-	// All "new" are current objects. Create "state" events. "old" state does not matter.
-	for _, newObj := range newMetadata {
+	// Create "state" events for new objects and for objects whose metadata changed.
+	// Objects that are unchanged since the previous revision produce no events.
+	for id, newObj := range newMetadata {
+		if oldObj, ok := oldMetadata[id]; ok && oldObj.EntityType == newObj.EntityType &&
+			getMetadataDelta(oldObj.Metadata, newObj.Metadata) == nil {
+			continue
+		}
 		entityEvent := out.AppendEmpty()
 		entityEvent.SetTimestamp(timestamp)
 		entityEvent.ID().PutStr(newObj.ResourceIDKey, string(newObj.ResourceID))
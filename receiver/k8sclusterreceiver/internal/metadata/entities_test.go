@@ -124,17 +124,38 @@ func Test_GetEntityEvents(t *testing.T) {
 					},
 				},
 			},
+			events: metadataPkg.NewEntityEventsSlice(),
+		},
+		{
+			name: "removed label",
+			old: map[metadataPkg.ResourceID]*KubernetesMetadata{
+				"123": {
+					EntityType:    "k8s.pod",
+					ResourceIDKey: "k8s.pod.uid",
+					ResourceID:    "123",
+					Metadata: map[string]string{
+						"label1": "value1",
+						"label2": "value2",
+					},
+				},
+			},
+			new: map[metadataPkg.ResourceID]*KubernetesMetadata{
+				"123": {
+					EntityType:    "k8s.pod",
+					ResourceIDKey: "k8s.pod.uid",
+					ResourceID:    "123",
+					Metadata: map[string]string{
+						"label1": "value1",
+					},
+				},
+			},
 			events: func() metadataPkg.EntityEventsSlice {
 				out := metadataPkg.NewEntityEventsSlice()
 				event := out.AppendEmpty()
 				_ = event.ID().FromRaw(map[string]any{"k8s.pod.uid": "123"})
 				state := event.SetEntityState()
 				state.SetEntityType("k8s.pod")
-				_ = state.Attributes().FromRaw(
-					map[string]any{
-						"label1": "value1", "label2": "value2", "label3": "value3",
-					},
-				)
+				_ = state.Attributes().FromRaw(map[string]any{"label1": "value1"})
 				return out
 			}(),
 		},
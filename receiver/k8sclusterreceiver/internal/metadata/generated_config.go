@@ -25,123 +25,311 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for k8s_cluster metrics.
 type MetricsConfig struct {
-	K8sContainerCPULimit                MetricConfig `mapstructure:"k8s.container.cpu_limit"`
-	K8sContainerCPURequest              MetricConfig `mapstructure:"k8s.container.cpu_request"`
-	K8sContainerEphemeralstorageLimit   MetricConfig `mapstructure:"k8s.container.ephemeralstorage_limit"`
-	K8sContainerEphemeralstorageRequest MetricConfig `mapstructure:"k8s.container.ephemeralstorage_request"`
-	K8sContainerMemoryLimit             MetricConfig `mapstructure:"k8s.container.memory_limit"`
-	K8sContainerMemoryRequest           MetricConfig `mapstructure:"k8s.container.memory_request"`
-	K8sContainerReady                   MetricConfig `mapstructure:"k8s.container.ready"`
-	K8sContainerRestarts                MetricConfig `mapstructure:"k8s.container.restarts"`
-	K8sContainerStorageLimit            MetricConfig `mapstructure:"k8s.container.storage_limit"`
-	K8sContainerStorageRequest          MetricConfig `mapstructure:"k8s.container.storage_request"`
-	K8sCronjobActiveJobs                MetricConfig `mapstructure:"k8s.cronjob.active_jobs"`
-	K8sDaemonsetCurrentScheduledNodes   MetricConfig `mapstructure:"k8s.daemonset.current_scheduled_nodes"`
-	K8sDaemonsetDesiredScheduledNodes   MetricConfig `mapstructure:"k8s.daemonset.desired_scheduled_nodes"`
-	K8sDaemonsetMisscheduledNodes       MetricConfig `mapstructure:"k8s.daemonset.misscheduled_nodes"`
-	K8sDaemonsetReadyNodes              MetricConfig `mapstructure:"k8s.daemonset.ready_nodes"`
-	K8sDeploymentAvailable              MetricConfig `mapstructure:"k8s.deployment.available"`
-	K8sDeploymentDesired                MetricConfig `mapstructure:"k8s.deployment.desired"`
-	K8sHpaCurrentReplicas               MetricConfig `mapstructure:"k8s.hpa.current_replicas"`
-	K8sHpaDesiredReplicas               MetricConfig `mapstructure:"k8s.hpa.desired_replicas"`
-	K8sHpaMaxReplicas                   MetricConfig `mapstructure:"k8s.hpa.max_replicas"`
-	K8sHpaMinReplicas                   MetricConfig `mapstructure:"k8s.hpa.min_replicas"`
-	K8sJobActivePods                    MetricConfig `mapstructure:"k8s.job.active_pods"`
-	K8sJobDesiredSuccessfulPods         MetricConfig `mapstructure:"k8s.job.desired_successful_pods"`
-	K8sJobFailedPods                    MetricConfig `mapstructure:"k8s.job.failed_pods"`
-	K8sJobMaxParallelPods               MetricConfig `mapstructure:"k8s.job.max_parallel_pods"`
-	K8sJobSuccessfulPods                MetricConfig `mapstructure:"k8s.job.successful_pods"`
-	K8sNamespacePhase                   MetricConfig `mapstructure:"k8s.namespace.phase"`
-	K8sNodeCondition                    MetricConfig `mapstructure:"k8s.node.condition"`
-	K8sPodPhase                         MetricConfig `mapstructure:"k8s.pod.phase"`
-	K8sPodStatusReason                  MetricConfig `mapstructure:"k8s.pod.status_reason"`
-	K8sReplicasetAvailable              MetricConfig `mapstructure:"k8s.replicaset.available"`
-	K8sReplicasetDesired                MetricConfig `mapstructure:"k8s.replicaset.desired"`
-	K8sReplicationControllerAvailable   MetricConfig `mapstructure:"k8s.replication_controller.available"`
-	K8sReplicationControllerDesired     MetricConfig `mapstructure:"k8s.replication_controller.desired"`
-	K8sResourceQuotaHardLimit           MetricConfig `mapstructure:"k8s.resource_quota.hard_limit"`
-	K8sResourceQuotaUsed                MetricConfig `mapstructure:"k8s.resource_quota.used"`
-	K8sStatefulsetCurrentPods           MetricConfig `mapstructure:"k8s.statefulset.current_pods"`
-	K8sStatefulsetDesiredPods           MetricConfig `mapstructure:"k8s.statefulset.desired_pods"`
-	K8sStatefulsetReadyPods             MetricConfig `mapstructure:"k8s.statefulset.ready_pods"`
-	K8sStatefulsetUpdatedPods           MetricConfig `mapstructure:"k8s.statefulset.updated_pods"`
-	OpenshiftAppliedclusterquotaLimit   MetricConfig `mapstructure:"openshift.appliedclusterquota.limit"`
-	OpenshiftAppliedclusterquotaUsed    MetricConfig `mapstructure:"openshift.appliedclusterquota.used"`
-	OpenshiftClusterquotaLimit          MetricConfig `mapstructure:"openshift.clusterquota.limit"`
-	OpenshiftClusterquotaUsed           MetricConfig `mapstructure:"openshift.clusterquota.used"`
+	K8sClusterPodCount                       MetricConfig `mapstructure:"k8s.cluster.pod.count"`
+	K8sContainerCPULimit                     MetricConfig `mapstructure:"k8s.container.cpu_limit"`
+	K8sContainerCPULimitRatio                MetricConfig `mapstructure:"k8s.container.cpu_limit_ratio"`
+	K8sContainerCPURequest                   MetricConfig `mapstructure:"k8s.container.cpu_request"`
+	K8sContainerCPURequestNodeFraction       MetricConfig `mapstructure:"k8s.container.cpu_request_node_fraction"`
+	K8sContainerEphemeralstorageLimit        MetricConfig `mapstructure:"k8s.container.ephemeralstorage_limit"`
+	K8sContainerEphemeralstorageRequest      MetricConfig `mapstructure:"k8s.container.ephemeralstorage_request"`
+	K8sContainerLastExitCode                 MetricConfig `mapstructure:"k8s.container.last_exit_code"`
+	K8sContainerLastTerminationReason        MetricConfig `mapstructure:"k8s.container.last_termination_reason"`
+	K8sContainerMemoryLimit                  MetricConfig `mapstructure:"k8s.container.memory_limit"`
+	K8sContainerMemoryLimitRatio             MetricConfig `mapstructure:"k8s.container.memory_limit_ratio"`
+	K8sContainerMemoryRequest                MetricConfig `mapstructure:"k8s.container.memory_request"`
+	K8sContainerMemoryRequestNodeFraction    MetricConfig `mapstructure:"k8s.container.memory_request_node_fraction"`
+	K8sContainerReady                        MetricConfig `mapstructure:"k8s.container.ready"`
+	K8sContainerRestarts                     MetricConfig `mapstructure:"k8s.container.restarts"`
+	K8sContainerStarted                      MetricConfig `mapstructure:"k8s.container.started"`
+	K8sContainerStorageLimit                 MetricConfig `mapstructure:"k8s.container.storage_limit"`
+	K8sContainerStorageRequest               MetricConfig `mapstructure:"k8s.container.storage_request"`
+	K8sContainerWaiting                      MetricConfig `mapstructure:"k8s.container.waiting"`
+	K8sCronjobActiveJobs                     MetricConfig `mapstructure:"k8s.cronjob.active_jobs"`
+	K8sCronjobLastScheduleAge                MetricConfig `mapstructure:"k8s.cronjob.last_schedule_age"`
+	K8sCronjobSuspended                      MetricConfig `mapstructure:"k8s.cronjob.suspended"`
+	K8sCustomResourceCount                   MetricConfig `mapstructure:"k8s.custom_resource.count"`
+	K8sDaemonsetCurrentScheduledNodes        MetricConfig `mapstructure:"k8s.daemonset.current_scheduled_nodes"`
+	K8sDaemonsetDesiredScheduledNodes        MetricConfig `mapstructure:"k8s.daemonset.desired_scheduled_nodes"`
+	K8sDaemonsetGenerationSkew               MetricConfig `mapstructure:"k8s.daemonset.generation_skew"`
+	K8sDaemonsetMisscheduledNodes            MetricConfig `mapstructure:"k8s.daemonset.misscheduled_nodes"`
+	K8sDaemonsetReadyNodes                   MetricConfig `mapstructure:"k8s.daemonset.ready_nodes"`
+	K8sDaemonsetReplicasUnavailable          MetricConfig `mapstructure:"k8s.daemonset.replicas_unavailable"`
+	K8sDaemonsetUnavailableNodes             MetricConfig `mapstructure:"k8s.daemonset.unavailable_nodes"`
+	K8sDaemonsetUpdatedRatio                 MetricConfig `mapstructure:"k8s.daemonset.updated_ratio"`
+	K8sDeploymentAvailable                   MetricConfig `mapstructure:"k8s.deployment.available"`
+	K8sDeploymentCollisionCount              MetricConfig `mapstructure:"k8s.deployment.collision_count"`
+	K8sDeploymentCondition                   MetricConfig `mapstructure:"k8s.deployment.condition"`
+	K8sDeploymentDesired                     MetricConfig `mapstructure:"k8s.deployment.desired"`
+	K8sDeploymentGenerationSkew              MetricConfig `mapstructure:"k8s.deployment.generation_skew"`
+	K8sDeploymentPaused                      MetricConfig `mapstructure:"k8s.deployment.paused"`
+	K8sDeploymentReplicasUnavailable         MetricConfig `mapstructure:"k8s.deployment.replicas_unavailable"`
+	K8sEndpointsAddressCount                 MetricConfig `mapstructure:"k8s.endpoints.address.count"`
+	K8sEndpointsNotReadyAddressCount         MetricConfig `mapstructure:"k8s.endpoints.not_ready_address.count"`
+	K8sEndpointsliceAddressCount             MetricConfig `mapstructure:"k8s.endpointslice.address.count"`
+	K8sEndpointsliceReadyCount               MetricConfig `mapstructure:"k8s.endpointslice.ready.count"`
+	K8sHpaCondition                          MetricConfig `mapstructure:"k8s.hpa.condition"`
+	K8sHpaCurrentMetricValue                 MetricConfig `mapstructure:"k8s.hpa.current_metric_value"`
+	K8sHpaCurrentReplicas                    MetricConfig `mapstructure:"k8s.hpa.current_replicas"`
+	K8sHpaDesiredReplicas                    MetricConfig `mapstructure:"k8s.hpa.desired_replicas"`
+	K8sHpaExternalCurrentValue               MetricConfig `mapstructure:"k8s.hpa.external_current_value"`
+	K8sHpaExternalTargetValue                MetricConfig `mapstructure:"k8s.hpa.external_target_value"`
+	K8sHpaMaxReplicas                        MetricConfig `mapstructure:"k8s.hpa.max_replicas"`
+	K8sHpaMinReplicas                        MetricConfig `mapstructure:"k8s.hpa.min_replicas"`
+	K8sHpaTargetMetricValue                  MetricConfig `mapstructure:"k8s.hpa.target_metric_value"`
+	K8sIngressRuleCount                      MetricConfig `mapstructure:"k8s.ingress.rule.count"`
+	K8sIngressTLSCount                       MetricConfig `mapstructure:"k8s.ingress.tls.count"`
+	K8sJobActivePods                         MetricConfig `mapstructure:"k8s.job.active_pods"`
+	K8sJobBackoffLimit                       MetricConfig `mapstructure:"k8s.job.backoff_limit"`
+	K8sJobCompletedIndexesCount              MetricConfig `mapstructure:"k8s.job.completed_indexes_count"`
+	K8sJobDesiredSuccessfulPods              MetricConfig `mapstructure:"k8s.job.desired_successful_pods"`
+	K8sJobDuration                           MetricConfig `mapstructure:"k8s.job.duration"`
+	K8sJobFailedExhausted                    MetricConfig `mapstructure:"k8s.job.failed_exhausted"`
+	K8sJobFailedPods                         MetricConfig `mapstructure:"k8s.job.failed_pods"`
+	K8sJobMaxParallelPods                    MetricConfig `mapstructure:"k8s.job.max_parallel_pods"`
+	K8sJobSuccessfulPods                     MetricConfig `mapstructure:"k8s.job.successful_pods"`
+	K8sLimitrangeDefaultCPURequest           MetricConfig `mapstructure:"k8s.limitrange.default_cpu_request"`
+	K8sLimitrangeDefaultMemoryRequest        MetricConfig `mapstructure:"k8s.limitrange.default_memory_request"`
+	K8sLimitrangeMax                         MetricConfig `mapstructure:"k8s.limitrange.max"`
+	K8sLimitrangeMin                         MetricConfig `mapstructure:"k8s.limitrange.min"`
+	K8sNamespacePhase                        MetricConfig `mapstructure:"k8s.namespace.phase"`
+	K8sNamespaceSecretCount                  MetricConfig `mapstructure:"k8s.namespace.secret.count"`
+	K8sNamespaceServiceaccountCount          MetricConfig `mapstructure:"k8s.namespace.serviceaccount.count"`
+	K8sNodeAge                               MetricConfig `mapstructure:"k8s.node.age"`
+	K8sNodeCondition                         MetricConfig `mapstructure:"k8s.node.condition"`
+	K8sNodeEvictionRisk                      MetricConfig `mapstructure:"k8s.node.eviction_risk"`
+	K8sNodeLeaseRenewAge                     MetricConfig `mapstructure:"k8s.node.lease_renew_age"`
+	K8sNodePodCount                          MetricConfig `mapstructure:"k8s.node.pod_count"`
+	K8sNodeTaintCount                        MetricConfig `mapstructure:"k8s.node.taint.count"`
+	K8sNodeUnschedulable                     MetricConfig `mapstructure:"k8s.node.unschedulable"`
+	K8sPdbCurrentHealthy                     MetricConfig `mapstructure:"k8s.pdb.current_healthy"`
+	K8sPdbDesiredHealthy                     MetricConfig `mapstructure:"k8s.pdb.desired_healthy"`
+	K8sPdbDisruptionsAllowed                 MetricConfig `mapstructure:"k8s.pdb.disruptions_allowed"`
+	K8sPdbExpectedPods                       MetricConfig `mapstructure:"k8s.pdb.expected_pods"`
+	K8sPersistentvolumeCapacity              MetricConfig `mapstructure:"k8s.persistentvolume.capacity"`
+	K8sPersistentvolumePhase                 MetricConfig `mapstructure:"k8s.persistentvolume.phase"`
+	K8sPersistentvolumeclaimActualCapacity   MetricConfig `mapstructure:"k8s.persistentvolumeclaim.actual_capacity"`
+	K8sPersistentvolumeclaimPhase            MetricConfig `mapstructure:"k8s.persistentvolumeclaim.phase"`
+	K8sPersistentvolumeclaimRequestedStorage MetricConfig `mapstructure:"k8s.persistentvolumeclaim.requested_storage"`
+	K8sPodAge                                MetricConfig `mapstructure:"k8s.pod.age"`
+	K8sPodCondition                          MetricConfig `mapstructure:"k8s.pod.condition"`
+	K8sPodOrphaned                           MetricConfig `mapstructure:"k8s.pod.orphaned"`
+	K8sPodPhase                              MetricConfig `mapstructure:"k8s.pod.phase"`
+	K8sPodPriority                           MetricConfig `mapstructure:"k8s.pod.priority"`
+	K8sPodSchedulingLatency                  MetricConfig `mapstructure:"k8s.pod.scheduling_latency"`
+	K8sPodStatusReason                       MetricConfig `mapstructure:"k8s.pod.status_reason"`
+	K8sPodUnschedulable                      MetricConfig `mapstructure:"k8s.pod.unschedulable"`
+	K8sPriorityclassGlobalDefault            MetricConfig `mapstructure:"k8s.priorityclass.global_default"`
+	K8sPriorityclassValue                    MetricConfig `mapstructure:"k8s.priorityclass.value"`
+	K8sReplicasetAvailable                   MetricConfig `mapstructure:"k8s.replicaset.available"`
+	K8sReplicasetDesired                     MetricConfig `mapstructure:"k8s.replicaset.desired"`
+	K8sReplicasetFullyLabeledReplicas        MetricConfig `mapstructure:"k8s.replicaset.fully_labeled_replicas"`
+	K8sReplicationControllerAvailable        MetricConfig `mapstructure:"k8s.replication_controller.available"`
+	K8sReplicationControllerDesired          MetricConfig `mapstructure:"k8s.replication_controller.desired"`
+	K8sResourceQuotaHardLimit                MetricConfig `mapstructure:"k8s.resource_quota.hard_limit"`
+	K8sResourceQuotaUsed                     MetricConfig `mapstructure:"k8s.resource_quota.used"`
+	K8sResourceQuotaUtilization              MetricConfig `mapstructure:"k8s.resource_quota.utilization"`
+	K8sServicePortCount                      MetricConfig `mapstructure:"k8s.service.port.count"`
+	K8sServiceType                           MetricConfig `mapstructure:"k8s.service.type"`
+	K8sStatefulsetCollisionCount             MetricConfig `mapstructure:"k8s.statefulset.collision_count"`
+	K8sStatefulsetCurrentPods                MetricConfig `mapstructure:"k8s.statefulset.current_pods"`
+	K8sStatefulsetDesiredPods                MetricConfig `mapstructure:"k8s.statefulset.desired_pods"`
+	K8sStatefulsetGenerationSkew             MetricConfig `mapstructure:"k8s.statefulset.generation_skew"`
+	K8sStatefulsetReadyPods                  MetricConfig `mapstructure:"k8s.statefulset.ready_pods"`
+	K8sStatefulsetRevisionMismatch           MetricConfig `mapstructure:"k8s.statefulset.revision_mismatch"`
+	K8sStatefulsetUpdatePartition            MetricConfig `mapstructure:"k8s.statefulset.update_partition"`
+	K8sStatefulsetUpdatedPods                MetricConfig `mapstructure:"k8s.statefulset.updated_pods"`
+	K8sStorageclassInfo                      MetricConfig `mapstructure:"k8s.storageclass.info"`
+	K8sVpaTargetCPU                          MetricConfig `mapstructure:"k8s.vpa.target_cpu"`
+	K8sVpaTargetMemory                       MetricConfig `mapstructure:"k8s.vpa.target_memory"`
+	OpenshiftAppliedclusterquotaLimit        MetricConfig `mapstructure:"openshift.appliedclusterquota.limit"`
+	OpenshiftAppliedclusterquotaUsed         MetricConfig `mapstructure:"openshift.appliedclusterquota.used"`
+	OpenshiftClusterquotaLimit               MetricConfig `mapstructure:"openshift.clusterquota.limit"`
+	OpenshiftClusterquotaUsed                MetricConfig `mapstructure:"openshift.clusterquota.used"`
+	OpenshiftDeploymentconfigAvailable       MetricConfig `mapstructure:"openshift.deploymentconfig.available"`
+	OpenshiftDeploymentconfigDesired         MetricConfig `mapstructure:"openshift.deploymentconfig.desired"`
+	OpenshiftDeploymentconfigReady           MetricConfig `mapstructure:"openshift.deploymentconfig.ready"`
+	OpenshiftDeploymentconfigUpdated         MetricConfig `mapstructure:"openshift.deploymentconfig.updated"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		K8sClusterPodCount: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerCPULimit: MetricConfig{
 			Enabled: true,
 		},
+		K8sContainerCPULimitRatio: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerCPURequest: MetricConfig{
 			Enabled: true,
 		},
+		K8sContainerCPURequestNodeFraction: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerEphemeralstorageLimit: MetricConfig{
 			Enabled: true,
 		},
 		K8sContainerEphemeralstorageRequest: MetricConfig{
 			Enabled: true,
 		},
+		K8sContainerLastExitCode: MetricConfig{
+			Enabled: false,
+		},
+		K8sContainerLastTerminationReason: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerMemoryLimit: MetricConfig{
 			Enabled: true,
 		},
+		K8sContainerMemoryLimitRatio: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerMemoryRequest: MetricConfig{
 			Enabled: true,
 		},
+		K8sContainerMemoryRequestNodeFraction: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerReady: MetricConfig{
 			Enabled: true,
 		},
 		K8sContainerRestarts: MetricConfig{
 			Enabled: true,
 		},
+		K8sContainerStarted: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerStorageLimit: MetricConfig{
 			Enabled: true,
 		},
 		K8sContainerStorageRequest: MetricConfig{
 			Enabled: true,
 		},
+		K8sContainerWaiting: MetricConfig{
+			Enabled: false,
+		},
 		K8sCronjobActiveJobs: MetricConfig{
 			Enabled: true,
 		},
+		K8sCronjobLastScheduleAge: MetricConfig{
+			Enabled: false,
+		},
+		K8sCronjobSuspended: MetricConfig{
+			Enabled: false,
+		},
+		K8sCustomResourceCount: MetricConfig{
+			Enabled: true,
+		},
 		K8sDaemonsetCurrentScheduledNodes: MetricConfig{
 			Enabled: true,
 		},
 		K8sDaemonsetDesiredScheduledNodes: MetricConfig{
 			Enabled: true,
 		},
+		K8sDaemonsetGenerationSkew: MetricConfig{
+			Enabled: false,
+		},
 		K8sDaemonsetMisscheduledNodes: MetricConfig{
 			Enabled: true,
 		},
 		K8sDaemonsetReadyNodes: MetricConfig{
 			Enabled: true,
 		},
+		K8sDaemonsetReplicasUnavailable: MetricConfig{
+			Enabled: false,
+		},
+		K8sDaemonsetUnavailableNodes: MetricConfig{
+			Enabled: false,
+		},
+		K8sDaemonsetUpdatedRatio: MetricConfig{
+			Enabled: false,
+		},
 		K8sDeploymentAvailable: MetricConfig{
 			Enabled: true,
 		},
+		K8sDeploymentCollisionCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sDeploymentCondition: MetricConfig{
+			Enabled: false,
+		},
 		K8sDeploymentDesired: MetricConfig{
 			Enabled: true,
 		},
+		K8sDeploymentGenerationSkew: MetricConfig{
+			Enabled: false,
+		},
+		K8sDeploymentPaused: MetricConfig{
+			Enabled: false,
+		},
+		K8sDeploymentReplicasUnavailable: MetricConfig{
+			Enabled: false,
+		},
+		K8sEndpointsAddressCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sEndpointsNotReadyAddressCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sEndpointsliceAddressCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sEndpointsliceReadyCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sHpaCondition: MetricConfig{
+			Enabled: false,
+		},
+		K8sHpaCurrentMetricValue: MetricConfig{
+			Enabled: false,
+		},
 		K8sHpaCurrentReplicas: MetricConfig{
 			Enabled: true,
 		},
 		K8sHpaDesiredReplicas: MetricConfig{
 			Enabled: true,
 		},
+		K8sHpaExternalCurrentValue: MetricConfig{
+			Enabled: false,
+		},
+		K8sHpaExternalTargetValue: MetricConfig{
+			Enabled: false,
+		},
 		K8sHpaMaxReplicas: MetricConfig{
 			Enabled: true,
 		},
 		K8sHpaMinReplicas: MetricConfig{
 			Enabled: true,
 		},
+		K8sHpaTargetMetricValue: MetricConfig{
+			Enabled: false,
+		},
+		K8sIngressRuleCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sIngressTLSCount: MetricConfig{
+			Enabled: false,
+		},
 		K8sJobActivePods: MetricConfig{
 			Enabled: true,
 		},
+		K8sJobBackoffLimit: MetricConfig{
+			Enabled: false,
+		},
+		K8sJobCompletedIndexesCount: MetricConfig{
+			Enabled: false,
+		},
 		K8sJobDesiredSuccessfulPods: MetricConfig{
 			Enabled: true,
 		},
+		K8sJobDuration: MetricConfig{
+			Enabled: false,
+		},
+		K8sJobFailedExhausted: MetricConfig{
+			Enabled: false,
+		},
 		K8sJobFailedPods: MetricConfig{
 			Enabled: true,
 		},
@@ -151,24 +339,114 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sJobSuccessfulPods: MetricConfig{
 			Enabled: true,
 		},
+		K8sLimitrangeDefaultCPURequest: MetricConfig{
+			Enabled: false,
+		},
+		K8sLimitrangeDefaultMemoryRequest: MetricConfig{
+			Enabled: false,
+		},
+		K8sLimitrangeMax: MetricConfig{
+			Enabled: false,
+		},
+		K8sLimitrangeMin: MetricConfig{
+			Enabled: false,
+		},
 		K8sNamespacePhase: MetricConfig{
 			Enabled: true,
 		},
+		K8sNamespaceSecretCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sNamespaceServiceaccountCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sNodeAge: MetricConfig{
+			Enabled: false,
+		},
 		K8sNodeCondition: MetricConfig{
 			Enabled: false,
 		},
+		K8sNodeEvictionRisk: MetricConfig{
+			Enabled: false,
+		},
+		K8sNodeLeaseRenewAge: MetricConfig{
+			Enabled: false,
+		},
+		K8sNodePodCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sNodeTaintCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sNodeUnschedulable: MetricConfig{
+			Enabled: false,
+		},
+		K8sPdbCurrentHealthy: MetricConfig{
+			Enabled: false,
+		},
+		K8sPdbDesiredHealthy: MetricConfig{
+			Enabled: false,
+		},
+		K8sPdbDisruptionsAllowed: MetricConfig{
+			Enabled: false,
+		},
+		K8sPdbExpectedPods: MetricConfig{
+			Enabled: false,
+		},
+		K8sPersistentvolumeCapacity: MetricConfig{
+			Enabled: false,
+		},
+		K8sPersistentvolumePhase: MetricConfig{
+			Enabled: false,
+		},
+		K8sPersistentvolumeclaimActualCapacity: MetricConfig{
+			Enabled: false,
+		},
+		K8sPersistentvolumeclaimPhase: MetricConfig{
+			Enabled: false,
+		},
+		K8sPersistentvolumeclaimRequestedStorage: MetricConfig{
+			Enabled: false,
+		},
+		K8sPodAge: MetricConfig{
+			Enabled: false,
+		},
+		K8sPodCondition: MetricConfig{
+			Enabled: false,
+		},
+		K8sPodOrphaned: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodPhase: MetricConfig{
 			Enabled: true,
 		},
+		K8sPodPriority: MetricConfig{
+			Enabled: false,
+		},
+		K8sPodSchedulingLatency: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodStatusReason: MetricConfig{
 			Enabled: false,
 		},
+		K8sPodUnschedulable: MetricConfig{
+			Enabled: false,
+		},
+		K8sPriorityclassGlobalDefault: MetricConfig{
+			Enabled: false,
+		},
+		K8sPriorityclassValue: MetricConfig{
+			Enabled: false,
+		},
 		K8sReplicasetAvailable: MetricConfig{
 			Enabled: true,
 		},
 		K8sReplicasetDesired: MetricConfig{
 			Enabled: true,
 		},
+		K8sReplicasetFullyLabeledReplicas: MetricConfig{
+			Enabled: false,
+		},
 		K8sReplicationControllerAvailable: MetricConfig{
 			Enabled: true,
 		},
@@ -181,18 +459,48 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sResourceQuotaUsed: MetricConfig{
 			Enabled: true,
 		},
+		K8sResourceQuotaUtilization: MetricConfig{
+			Enabled: false,
+		},
+		K8sServicePortCount: MetricConfig{
+			Enabled: false,
+		},
+		K8sServiceType: MetricConfig{
+			Enabled: false,
+		},
+		K8sStatefulsetCollisionCount: MetricConfig{
+			Enabled: false,
+		},
 		K8sStatefulsetCurrentPods: MetricConfig{
 			Enabled: true,
 		},
 		K8sStatefulsetDesiredPods: MetricConfig{
 			Enabled: true,
 		},
+		K8sStatefulsetGenerationSkew: MetricConfig{
+			Enabled: false,
+		},
 		K8sStatefulsetReadyPods: MetricConfig{
 			Enabled: true,
 		},
+		K8sStatefulsetRevisionMismatch: MetricConfig{
+			Enabled: false,
+		},
+		K8sStatefulsetUpdatePartition: MetricConfig{
+			Enabled: false,
+		},
 		K8sStatefulsetUpdatedPods: MetricConfig{
 			Enabled: true,
 		},
+		K8sStorageclassInfo: MetricConfig{
+			Enabled: false,
+		},
+		K8sVpaTargetCPU: MetricConfig{
+			Enabled: false,
+		},
+		K8sVpaTargetMemory: MetricConfig{
+			Enabled: false,
+		},
 		OpenshiftAppliedclusterquotaLimit: MetricConfig{
 			Enabled: true,
 		},
@@ -205,6 +513,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		OpenshiftClusterquotaUsed: MetricConfig{
 			Enabled: true,
 		},
+		OpenshiftDeploymentconfigAvailable: MetricConfig{
+			Enabled: false,
+		},
+		OpenshiftDeploymentconfigDesired: MetricConfig{
+			Enabled: false,
+		},
+		OpenshiftDeploymentconfigReady: MetricConfig{
+			Enabled: false,
+		},
+		OpenshiftDeploymentconfigUpdated: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -229,47 +549,92 @@ func (rac *ResourceAttributeConfig) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesConfig provides config for k8s_cluster resource attributes.
 type ResourceAttributesConfig struct {
-	ContainerID                  ResourceAttributeConfig `mapstructure:"container.id"`
-	ContainerImageName           ResourceAttributeConfig `mapstructure:"container.image.name"`
-	ContainerImageTag            ResourceAttributeConfig `mapstructure:"container.image.tag"`
-	ContainerRuntime             ResourceAttributeConfig `mapstructure:"container.runtime"`
-	ContainerRuntimeVersion      ResourceAttributeConfig `mapstructure:"container.runtime.version"`
-	K8sContainerName             ResourceAttributeConfig `mapstructure:"k8s.container.name"`
-	K8sCronjobName               ResourceAttributeConfig `mapstructure:"k8s.cronjob.name"`
-	K8sCronjobUID                ResourceAttributeConfig `mapstructure:"k8s.cronjob.uid"`
-	K8sDaemonsetName             ResourceAttributeConfig `mapstructure:"k8s.daemonset.name"`
-	K8sDaemonsetUID              ResourceAttributeConfig `mapstructure:"k8s.daemonset.uid"`
-	K8sDeploymentName            ResourceAttributeConfig `mapstructure:"k8s.deployment.name"`
-	K8sDeploymentUID             ResourceAttributeConfig `mapstructure:"k8s.deployment.uid"`
-	K8sHpaName                   ResourceAttributeConfig `mapstructure:"k8s.hpa.name"`
-	K8sHpaUID                    ResourceAttributeConfig `mapstructure:"k8s.hpa.uid"`
-	K8sJobName                   ResourceAttributeConfig `mapstructure:"k8s.job.name"`
-	K8sJobUID                    ResourceAttributeConfig `mapstructure:"k8s.job.uid"`
-	K8sKubeletVersion            ResourceAttributeConfig `mapstructure:"k8s.kubelet.version"`
-	K8sKubeproxyVersion          ResourceAttributeConfig `mapstructure:"k8s.kubeproxy.version"`
-	K8sNamespaceName             ResourceAttributeConfig `mapstructure:"k8s.namespace.name"`
-	K8sNamespaceUID              ResourceAttributeConfig `mapstructure:"k8s.namespace.uid"`
-	K8sNodeName                  ResourceAttributeConfig `mapstructure:"k8s.node.name"`
-	K8sNodeUID                   ResourceAttributeConfig `mapstructure:"k8s.node.uid"`
-	K8sPodName                   ResourceAttributeConfig `mapstructure:"k8s.pod.name"`
-	K8sPodQosClass               ResourceAttributeConfig `mapstructure:"k8s.pod.qos_class"`
-	K8sPodUID                    ResourceAttributeConfig `mapstructure:"k8s.pod.uid"`
-	K8sReplicasetName            ResourceAttributeConfig `mapstructure:"k8s.replicaset.name"`
-	K8sReplicasetUID             ResourceAttributeConfig `mapstructure:"k8s.replicaset.uid"`
-	K8sReplicationcontrollerName ResourceAttributeConfig `mapstructure:"k8s.replicationcontroller.name"`
-	K8sReplicationcontrollerUID  ResourceAttributeConfig `mapstructure:"k8s.replicationcontroller.uid"`
-	K8sResourcequotaName         ResourceAttributeConfig `mapstructure:"k8s.resourcequota.name"`
-	K8sResourcequotaUID          ResourceAttributeConfig `mapstructure:"k8s.resourcequota.uid"`
-	K8sStatefulsetName           ResourceAttributeConfig `mapstructure:"k8s.statefulset.name"`
-	K8sStatefulsetUID            ResourceAttributeConfig `mapstructure:"k8s.statefulset.uid"`
-	OpenshiftClusterquotaName    ResourceAttributeConfig `mapstructure:"openshift.clusterquota.name"`
-	OpenshiftClusterquotaUID     ResourceAttributeConfig `mapstructure:"openshift.clusterquota.uid"`
-	OsDescription                ResourceAttributeConfig `mapstructure:"os.description"`
-	OsVersion                    ResourceAttributeConfig `mapstructure:"os.version"`
+	CloudAvailabilityZone            ResourceAttributeConfig `mapstructure:"cloud.availability_zone"`
+	CloudRegion                      ResourceAttributeConfig `mapstructure:"cloud.region"`
+	ContainerID                      ResourceAttributeConfig `mapstructure:"container.id"`
+	ContainerImageName               ResourceAttributeConfig `mapstructure:"container.image.name"`
+	ContainerImageTag                ResourceAttributeConfig `mapstructure:"container.image.tag"`
+	ContainerRuntime                 ResourceAttributeConfig `mapstructure:"container.runtime"`
+	ContainerRuntimeVersion          ResourceAttributeConfig `mapstructure:"container.runtime.version"`
+	HostType                         ResourceAttributeConfig `mapstructure:"host.type"`
+	K8sClusterName                   ResourceAttributeConfig `mapstructure:"k8s.cluster.name"`
+	K8sContainerName                 ResourceAttributeConfig `mapstructure:"k8s.container.name"`
+	K8sContainerType                 ResourceAttributeConfig `mapstructure:"k8s.container.type"`
+	K8sCronjobName                   ResourceAttributeConfig `mapstructure:"k8s.cronjob.name"`
+	K8sCronjobUID                    ResourceAttributeConfig `mapstructure:"k8s.cronjob.uid"`
+	K8sDaemonsetName                 ResourceAttributeConfig `mapstructure:"k8s.daemonset.name"`
+	K8sDaemonsetUID                  ResourceAttributeConfig `mapstructure:"k8s.daemonset.uid"`
+	K8sDeploymentName                ResourceAttributeConfig `mapstructure:"k8s.deployment.name"`
+	K8sDeploymentUID                 ResourceAttributeConfig `mapstructure:"k8s.deployment.uid"`
+	K8sEndpointsName                 ResourceAttributeConfig `mapstructure:"k8s.endpoints.name"`
+	K8sEndpointsUID                  ResourceAttributeConfig `mapstructure:"k8s.endpoints.uid"`
+	K8sEndpointsliceAddressType      ResourceAttributeConfig `mapstructure:"k8s.endpointslice.address_type"`
+	K8sEndpointsliceName             ResourceAttributeConfig `mapstructure:"k8s.endpointslice.name"`
+	K8sEndpointsliceUID              ResourceAttributeConfig `mapstructure:"k8s.endpointslice.uid"`
+	K8sHpaName                       ResourceAttributeConfig `mapstructure:"k8s.hpa.name"`
+	K8sHpaUID                        ResourceAttributeConfig `mapstructure:"k8s.hpa.uid"`
+	K8sIngressName                   ResourceAttributeConfig `mapstructure:"k8s.ingress.name"`
+	K8sIngressUID                    ResourceAttributeConfig `mapstructure:"k8s.ingress.uid"`
+	K8sJobName                       ResourceAttributeConfig `mapstructure:"k8s.job.name"`
+	K8sJobUID                        ResourceAttributeConfig `mapstructure:"k8s.job.uid"`
+	K8sKubeletVersion                ResourceAttributeConfig `mapstructure:"k8s.kubelet.version"`
+	K8sKubeproxyVersion              ResourceAttributeConfig `mapstructure:"k8s.kubeproxy.version"`
+	K8sLimitrangeName                ResourceAttributeConfig `mapstructure:"k8s.limitrange.name"`
+	K8sLimitrangeUID                 ResourceAttributeConfig `mapstructure:"k8s.limitrange.uid"`
+	K8sNamespaceName                 ResourceAttributeConfig `mapstructure:"k8s.namespace.name"`
+	K8sNamespaceUID                  ResourceAttributeConfig `mapstructure:"k8s.namespace.uid"`
+	K8sNodeName                      ResourceAttributeConfig `mapstructure:"k8s.node.name"`
+	K8sNodeUID                       ResourceAttributeConfig `mapstructure:"k8s.node.uid"`
+	K8sPdbName                       ResourceAttributeConfig `mapstructure:"k8s.pdb.name"`
+	K8sPdbUID                        ResourceAttributeConfig `mapstructure:"k8s.pdb.uid"`
+	K8sPersistentvolumeName          ResourceAttributeConfig `mapstructure:"k8s.persistentvolume.name"`
+	K8sPersistentvolumeUID           ResourceAttributeConfig `mapstructure:"k8s.persistentvolume.uid"`
+	K8sPersistentvolumeclaimName     ResourceAttributeConfig `mapstructure:"k8s.persistentvolumeclaim.name"`
+	K8sPersistentvolumeclaimUID      ResourceAttributeConfig `mapstructure:"k8s.persistentvolumeclaim.uid"`
+	K8sPodName                       ResourceAttributeConfig `mapstructure:"k8s.pod.name"`
+	K8sPodPriorityClassName          ResourceAttributeConfig `mapstructure:"k8s.pod.priority_class_name"`
+	K8sPodQosClass                   ResourceAttributeConfig `mapstructure:"k8s.pod.qos_class"`
+	K8sPodUID                        ResourceAttributeConfig `mapstructure:"k8s.pod.uid"`
+	K8sPriorityclassName             ResourceAttributeConfig `mapstructure:"k8s.priorityclass.name"`
+	K8sPriorityclassPreemptionPolicy ResourceAttributeConfig `mapstructure:"k8s.priorityclass.preemption_policy"`
+	K8sPriorityclassUID              ResourceAttributeConfig `mapstructure:"k8s.priorityclass.uid"`
+	K8sReplicasetName                ResourceAttributeConfig `mapstructure:"k8s.replicaset.name"`
+	K8sReplicasetUID                 ResourceAttributeConfig `mapstructure:"k8s.replicaset.uid"`
+	K8sReplicationcontrollerName     ResourceAttributeConfig `mapstructure:"k8s.replicationcontroller.name"`
+	K8sReplicationcontrollerUID      ResourceAttributeConfig `mapstructure:"k8s.replicationcontroller.uid"`
+	K8sResourcequotaName             ResourceAttributeConfig `mapstructure:"k8s.resourcequota.name"`
+	K8sResourcequotaScope            ResourceAttributeConfig `mapstructure:"k8s.resourcequota.scope"`
+	K8sResourcequotaUID              ResourceAttributeConfig `mapstructure:"k8s.resourcequota.uid"`
+	K8sServiceName                   ResourceAttributeConfig `mapstructure:"k8s.service.name"`
+	K8sServiceUID                    ResourceAttributeConfig `mapstructure:"k8s.service.uid"`
+	K8sStatefulsetName               ResourceAttributeConfig `mapstructure:"k8s.statefulset.name"`
+	K8sStatefulsetUID                ResourceAttributeConfig `mapstructure:"k8s.statefulset.uid"`
+	K8sStorageclassIsDefault         ResourceAttributeConfig `mapstructure:"k8s.storageclass.is_default"`
+	K8sStorageclassName              ResourceAttributeConfig `mapstructure:"k8s.storageclass.name"`
+	K8sStorageclassProvisioner       ResourceAttributeConfig `mapstructure:"k8s.storageclass.provisioner"`
+	K8sStorageclassReclaimPolicy     ResourceAttributeConfig `mapstructure:"k8s.storageclass.reclaim_policy"`
+	K8sStorageclassUID               ResourceAttributeConfig `mapstructure:"k8s.storageclass.uid"`
+	K8sStorageclassVolumeBindingMode ResourceAttributeConfig `mapstructure:"k8s.storageclass.volume_binding_mode"`
+	K8sVpaName                       ResourceAttributeConfig `mapstructure:"k8s.vpa.name"`
+	K8sVpaTargetKind                 ResourceAttributeConfig `mapstructure:"k8s.vpa.target.kind"`
+	K8sVpaTargetName                 ResourceAttributeConfig `mapstructure:"k8s.vpa.target.name"`
+	K8sVpaUID                        ResourceAttributeConfig `mapstructure:"k8s.vpa.uid"`
+	OpenshiftClusterquotaName        ResourceAttributeConfig `mapstructure:"openshift.clusterquota.name"`
+	OpenshiftClusterquotaUID         ResourceAttributeConfig `mapstructure:"openshift.clusterquota.uid"`
+	OpenshiftDeploymentconfigName    ResourceAttributeConfig `mapstructure:"openshift.deploymentconfig.name"`
+	OpenshiftDeploymentconfigUID     ResourceAttributeConfig `mapstructure:"openshift.deploymentconfig.uid"`
+	OsDescription                    ResourceAttributeConfig `mapstructure:"os.description"`
+	OsVersion                        ResourceAttributeConfig `mapstructure:"os.version"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		CloudAvailabilityZone: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		CloudRegion: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		ContainerID: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -285,9 +650,18 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		ContainerRuntimeVersion: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		HostType: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sClusterName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sContainerName: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sContainerType: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sCronjobName: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -306,12 +680,33 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sDeploymentUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sEndpointsName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sEndpointsUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sEndpointsliceAddressType: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sEndpointsliceName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sEndpointsliceUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sHpaName: ResourceAttributeConfig{
 			Enabled: true,
 		},
 		K8sHpaUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sIngressName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sIngressUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sJobName: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -324,6 +719,12 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sKubeproxyVersion: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		K8sLimitrangeName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sLimitrangeUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sNamespaceName: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -336,15 +737,45 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sNodeUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sPdbName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sPdbUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sPersistentvolumeName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sPersistentvolumeUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sPersistentvolumeclaimName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sPersistentvolumeclaimUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sPodName: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sPodPriorityClassName: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sPodQosClass: ResourceAttributeConfig{
 			Enabled: false,
 		},
 		K8sPodUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sPriorityclassName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sPriorityclassPreemptionPolicy: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sPriorityclassUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sReplicasetName: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -360,21 +791,66 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sResourcequotaName: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sResourcequotaScope: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sResourcequotaUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sServiceName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sServiceUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sStatefulsetName: ResourceAttributeConfig{
 			Enabled: true,
 		},
 		K8sStatefulsetUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sStorageclassIsDefault: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sStorageclassName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sStorageclassProvisioner: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sStorageclassReclaimPolicy: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sStorageclassUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sStorageclassVolumeBindingMode: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sVpaName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sVpaTargetKind: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sVpaTargetName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sVpaUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		OpenshiftClusterquotaName: ResourceAttributeConfig{
 			Enabled: true,
 		},
 		OpenshiftClusterquotaUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		OpenshiftDeploymentconfigName: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		OpenshiftDeploymentconfigUID: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		OsDescription: ResourceAttributeConfig{
 			Enabled: false,
 		},
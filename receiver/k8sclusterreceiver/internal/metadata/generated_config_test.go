@@ -26,89 +26,208 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					K8sContainerCPULimit:                MetricConfig{Enabled: true},
-					K8sContainerCPURequest:              MetricConfig{Enabled: true},
-					K8sContainerEphemeralstorageLimit:   MetricConfig{Enabled: true},
-					K8sContainerEphemeralstorageRequest: MetricConfig{Enabled: true},
-					K8sContainerMemoryLimit:             MetricConfig{Enabled: true},
-					K8sContainerMemoryRequest:           MetricConfig{Enabled: true},
-					K8sContainerReady:                   MetricConfig{Enabled: true},
-					K8sContainerRestarts:                MetricConfig{Enabled: true},
-					K8sContainerStorageLimit:            MetricConfig{Enabled: true},
-					K8sContainerStorageRequest:          MetricConfig{Enabled: true},
-					K8sCronjobActiveJobs:                MetricConfig{Enabled: true},
-					K8sDaemonsetCurrentScheduledNodes:   MetricConfig{Enabled: true},
-					K8sDaemonsetDesiredScheduledNodes:   MetricConfig{Enabled: true},
-					K8sDaemonsetMisscheduledNodes:       MetricConfig{Enabled: true},
-					K8sDaemonsetReadyNodes:              MetricConfig{Enabled: true},
-					K8sDeploymentAvailable:              MetricConfig{Enabled: true},
-					K8sDeploymentDesired:                MetricConfig{Enabled: true},
-					K8sHpaCurrentReplicas:               MetricConfig{Enabled: true},
-					K8sHpaDesiredReplicas:               MetricConfig{Enabled: true},
-					K8sHpaMaxReplicas:                   MetricConfig{Enabled: true},
-					K8sHpaMinReplicas:                   MetricConfig{Enabled: true},
-					K8sJobActivePods:                    MetricConfig{Enabled: true},
-					K8sJobDesiredSuccessfulPods:         MetricConfig{Enabled: true},
-					K8sJobFailedPods:                    MetricConfig{Enabled: true},
-					K8sJobMaxParallelPods:               MetricConfig{Enabled: true},
-					K8sJobSuccessfulPods:                MetricConfig{Enabled: true},
-					K8sNamespacePhase:                   MetricConfig{Enabled: true},
-					K8sNodeCondition:                    MetricConfig{Enabled: true},
-					K8sPodPhase:                         MetricConfig{Enabled: true},
-					K8sPodStatusReason:                  MetricConfig{Enabled: true},
-					K8sReplicasetAvailable:              MetricConfig{Enabled: true},
-					K8sReplicasetDesired:                MetricConfig{Enabled: true},
-					K8sReplicationControllerAvailable:   MetricConfig{Enabled: true},
-					K8sReplicationControllerDesired:     MetricConfig{Enabled: true},
-					K8sResourceQuotaHardLimit:           MetricConfig{Enabled: true},
-					K8sResourceQuotaUsed:                MetricConfig{Enabled: true},
-					K8sStatefulsetCurrentPods:           MetricConfig{Enabled: true},
-					K8sStatefulsetDesiredPods:           MetricConfig{Enabled: true},
-					K8sStatefulsetReadyPods:             MetricConfig{Enabled: true},
-					K8sStatefulsetUpdatedPods:           MetricConfig{Enabled: true},
-					OpenshiftAppliedclusterquotaLimit:   MetricConfig{Enabled: true},
-					OpenshiftAppliedclusterquotaUsed:    MetricConfig{Enabled: true},
-					OpenshiftClusterquotaLimit:          MetricConfig{Enabled: true},
-					OpenshiftClusterquotaUsed:           MetricConfig{Enabled: true},
+					K8sClusterPodCount:                       MetricConfig{Enabled: true},
+					K8sContainerCPULimit:                     MetricConfig{Enabled: true},
+					K8sContainerCPULimitRatio:                MetricConfig{Enabled: true},
+					K8sContainerCPURequest:                   MetricConfig{Enabled: true},
+					K8sContainerCPURequestNodeFraction:       MetricConfig{Enabled: true},
+					K8sContainerEphemeralstorageLimit:        MetricConfig{Enabled: true},
+					K8sContainerEphemeralstorageRequest:      MetricConfig{Enabled: true},
+					K8sContainerLastExitCode:                 MetricConfig{Enabled: true},
+					K8sContainerLastTerminationReason:        MetricConfig{Enabled: true},
+					K8sContainerMemoryLimit:                  MetricConfig{Enabled: true},
+					K8sContainerMemoryLimitRatio:             MetricConfig{Enabled: true},
+					K8sContainerMemoryRequest:                MetricConfig{Enabled: true},
+					K8sContainerMemoryRequestNodeFraction:    MetricConfig{Enabled: true},
+					K8sContainerReady:                        MetricConfig{Enabled: true},
+					K8sContainerRestarts:                     MetricConfig{Enabled: true},
+					K8sContainerStarted:                      MetricConfig{Enabled: true},
+					K8sContainerStorageLimit:                 MetricConfig{Enabled: true},
+					K8sContainerStorageRequest:               MetricConfig{Enabled: true},
+					K8sContainerWaiting:                      MetricConfig{Enabled: true},
+					K8sCronjobActiveJobs:                     MetricConfig{Enabled: true},
+					K8sCronjobLastScheduleAge:                MetricConfig{Enabled: true},
+					K8sCronjobSuspended:                      MetricConfig{Enabled: true},
+					K8sCustomResourceCount:                   MetricConfig{Enabled: true},
+					K8sDaemonsetCurrentScheduledNodes:        MetricConfig{Enabled: true},
+					K8sDaemonsetDesiredScheduledNodes:        MetricConfig{Enabled: true},
+					K8sDaemonsetGenerationSkew:               MetricConfig{Enabled: true},
+					K8sDaemonsetMisscheduledNodes:            MetricConfig{Enabled: true},
+					K8sDaemonsetReadyNodes:                   MetricConfig{Enabled: true},
+					K8sDaemonsetReplicasUnavailable:          MetricConfig{Enabled: true},
+					K8sDaemonsetUnavailableNodes:             MetricConfig{Enabled: true},
+					K8sDaemonsetUpdatedRatio:                 MetricConfig{Enabled: true},
+					K8sDeploymentAvailable:                   MetricConfig{Enabled: true},
+					K8sDeploymentCollisionCount:              MetricConfig{Enabled: true},
+					K8sDeploymentCondition:                   MetricConfig{Enabled: true},
+					K8sDeploymentDesired:                     MetricConfig{Enabled: true},
+					K8sDeploymentGenerationSkew:              MetricConfig{Enabled: true},
+					K8sDeploymentPaused:                      MetricConfig{Enabled: true},
+					K8sDeploymentReplicasUnavailable:         MetricConfig{Enabled: true},
+					K8sEndpointsAddressCount:                 MetricConfig{Enabled: true},
+					K8sEndpointsNotReadyAddressCount:         MetricConfig{Enabled: true},
+					K8sEndpointsliceAddressCount:             MetricConfig{Enabled: true},
+					K8sEndpointsliceReadyCount:               MetricConfig{Enabled: true},
+					K8sHpaCondition:                          MetricConfig{Enabled: true},
+					K8sHpaCurrentMetricValue:                 MetricConfig{Enabled: true},
+					K8sHpaCurrentReplicas:                    MetricConfig{Enabled: true},
+					K8sHpaDesiredReplicas:                    MetricConfig{Enabled: true},
+					K8sHpaExternalCurrentValue:               MetricConfig{Enabled: true},
+					K8sHpaExternalTargetValue:                MetricConfig{Enabled: true},
+					K8sHpaMaxReplicas:                        MetricConfig{Enabled: true},
+					K8sHpaMinReplicas:                        MetricConfig{Enabled: true},
+					K8sHpaTargetMetricValue:                  MetricConfig{Enabled: true},
+					K8sIngressRuleCount:                      MetricConfig{Enabled: true},
+					K8sIngressTLSCount:                       MetricConfig{Enabled: true},
+					K8sJobActivePods:                         MetricConfig{Enabled: true},
+					K8sJobBackoffLimit:                       MetricConfig{Enabled: true},
+					K8sJobCompletedIndexesCount:              MetricConfig{Enabled: true},
+					K8sJobDesiredSuccessfulPods:              MetricConfig{Enabled: true},
+					K8sJobDuration:                           MetricConfig{Enabled: true},
+					K8sJobFailedExhausted:                    MetricConfig{Enabled: true},
+					K8sJobFailedPods:                         MetricConfig{Enabled: true},
+					K8sJobMaxParallelPods:                    MetricConfig{Enabled: true},
+					K8sJobSuccessfulPods:                     MetricConfig{Enabled: true},
+					K8sLimitrangeDefaultCPURequest:           MetricConfig{Enabled: true},
+					K8sLimitrangeDefaultMemoryRequest:        MetricConfig{Enabled: true},
+					K8sLimitrangeMax:                         MetricConfig{Enabled: true},
+					K8sLimitrangeMin:                         MetricConfig{Enabled: true},
+					K8sNamespacePhase:                        MetricConfig{Enabled: true},
+					K8sNamespaceSecretCount:                  MetricConfig{Enabled: true},
+					K8sNamespaceServiceaccountCount:          MetricConfig{Enabled: true},
+					K8sNodeAge:                               MetricConfig{Enabled: true},
+					K8sNodeCondition:                         MetricConfig{Enabled: true},
+					K8sNodeEvictionRisk:                      MetricConfig{Enabled: true},
+					K8sNodeLeaseRenewAge:                     MetricConfig{Enabled: true},
+					K8sNodePodCount:                          MetricConfig{Enabled: true},
+					K8sNodeTaintCount:                        MetricConfig{Enabled: true},
+					K8sNodeUnschedulable:                     MetricConfig{Enabled: true},
+					K8sPdbCurrentHealthy:                     MetricConfig{Enabled: true},
+					K8sPdbDesiredHealthy:                     MetricConfig{Enabled: true},
+					K8sPdbDisruptionsAllowed:                 MetricConfig{Enabled: true},
+					K8sPdbExpectedPods:                       MetricConfig{Enabled: true},
+					K8sPersistentvolumeCapacity:              MetricConfig{Enabled: true},
+					K8sPersistentvolumePhase:                 MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimActualCapacity:   MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimPhase:            MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimRequestedStorage: MetricConfig{Enabled: true},
+					K8sPodAge:                                MetricConfig{Enabled: true},
+					K8sPodCondition:                          MetricConfig{Enabled: true},
+					K8sPodOrphaned:                           MetricConfig{Enabled: true},
+					K8sPodPhase:                              MetricConfig{Enabled: true},
+					K8sPodPriority:                           MetricConfig{Enabled: true},
+					K8sPodSchedulingLatency:                  MetricConfig{Enabled: true},
+					K8sPodStatusReason:                       MetricConfig{Enabled: true},
+					K8sPodUnschedulable:                      MetricConfig{Enabled: true},
+					K8sPriorityclassGlobalDefault:            MetricConfig{Enabled: true},
+					K8sPriorityclassValue:                    MetricConfig{Enabled: true},
+					K8sReplicasetAvailable:                   MetricConfig{Enabled: true},
+					K8sReplicasetDesired:                     MetricConfig{Enabled: true},
+					K8sReplicasetFullyLabeledReplicas:        MetricConfig{Enabled: true},
+					K8sReplicationControllerAvailable:        MetricConfig{Enabled: true},
+					K8sReplicationControllerDesired:          MetricConfig{Enabled: true},
+					K8sResourceQuotaHardLimit:                MetricConfig{Enabled: true},
+					K8sResourceQuotaUsed:                     MetricConfig{Enabled: true},
+					K8sResourceQuotaUtilization:              MetricConfig{Enabled: true},
+					K8sServicePortCount:                      MetricConfig{Enabled: true},
+					K8sServiceType:                           MetricConfig{Enabled: true},
+					K8sStatefulsetCollisionCount:             MetricConfig{Enabled: true},
+					K8sStatefulsetCurrentPods:                MetricConfig{Enabled: true},
+					K8sStatefulsetDesiredPods:                MetricConfig{Enabled: true},
+					K8sStatefulsetGenerationSkew:             MetricConfig{Enabled: true},
+					K8sStatefulsetReadyPods:                  MetricConfig{Enabled: true},
+					K8sStatefulsetRevisionMismatch:           MetricConfig{Enabled: true},
+					K8sStatefulsetUpdatePartition:            MetricConfig{Enabled: true},
+					K8sStatefulsetUpdatedPods:                MetricConfig{Enabled: true},
+					K8sStorageclassInfo:                      MetricConfig{Enabled: true},
+					K8sVpaTargetCPU:                          MetricConfig{Enabled: true},
+					K8sVpaTargetMemory:                       MetricConfig{Enabled: true},
+					OpenshiftAppliedclusterquotaLimit:        MetricConfig{Enabled: true},
+					OpenshiftAppliedclusterquotaUsed:         MetricConfig{Enabled: true},
+					OpenshiftClusterquotaLimit:               MetricConfig{Enabled: true},
+					OpenshiftClusterquotaUsed:                MetricConfig{Enabled: true},
+					OpenshiftDeploymentconfigAvailable:       MetricConfig{Enabled: true},
+					OpenshiftDeploymentconfigDesired:         MetricConfig{Enabled: true},
+					OpenshiftDeploymentconfigReady:           MetricConfig{Enabled: true},
+					OpenshiftDeploymentconfigUpdated:         MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					ContainerID:                  ResourceAttributeConfig{Enabled: true},
-					ContainerImageName:           ResourceAttributeConfig{Enabled: true},
-					ContainerImageTag:            ResourceAttributeConfig{Enabled: true},
-					ContainerRuntime:             ResourceAttributeConfig{Enabled: true},
-					ContainerRuntimeVersion:      ResourceAttributeConfig{Enabled: true},
-					K8sContainerName:             ResourceAttributeConfig{Enabled: true},
-					K8sCronjobName:               ResourceAttributeConfig{Enabled: true},
-					K8sCronjobUID:                ResourceAttributeConfig{Enabled: true},
-					K8sDaemonsetName:             ResourceAttributeConfig{Enabled: true},
-					K8sDaemonsetUID:              ResourceAttributeConfig{Enabled: true},
-					K8sDeploymentName:            ResourceAttributeConfig{Enabled: true},
-					K8sDeploymentUID:             ResourceAttributeConfig{Enabled: true},
-					K8sHpaName:                   ResourceAttributeConfig{Enabled: true},
-					K8sHpaUID:                    ResourceAttributeConfig{Enabled: true},
-					K8sJobName:                   ResourceAttributeConfig{Enabled: true},
-					K8sJobUID:                    ResourceAttributeConfig{Enabled: true},
-					K8sKubeletVersion:            ResourceAttributeConfig{Enabled: true},
-					K8sKubeproxyVersion:          ResourceAttributeConfig{Enabled: true},
-					K8sNamespaceName:             ResourceAttributeConfig{Enabled: true},
-					K8sNamespaceUID:              ResourceAttributeConfig{Enabled: true},
-					K8sNodeName:                  ResourceAttributeConfig{Enabled: true},
-					K8sNodeUID:                   ResourceAttributeConfig{Enabled: true},
-					K8sPodName:                   ResourceAttributeConfig{Enabled: true},
-					K8sPodQosClass:               ResourceAttributeConfig{Enabled: true},
-					K8sPodUID:                    ResourceAttributeConfig{Enabled: true},
-					K8sReplicasetName:            ResourceAttributeConfig{Enabled: true},
-					K8sReplicasetUID:             ResourceAttributeConfig{Enabled: true},
-					K8sReplicationcontrollerName: ResourceAttributeConfig{Enabled: true},
-					K8sReplicationcontrollerUID:  ResourceAttributeConfig{Enabled: true},
-					K8sResourcequotaName:         ResourceAttributeConfig{Enabled: true},
-					K8sResourcequotaUID:          ResourceAttributeConfig{Enabled: true},
-					K8sStatefulsetName:           ResourceAttributeConfig{Enabled: true},
-					K8sStatefulsetUID:            ResourceAttributeConfig{Enabled: true},
-					OpenshiftClusterquotaName:    ResourceAttributeConfig{Enabled: true},
-					OpenshiftClusterquotaUID:     ResourceAttributeConfig{Enabled: true},
-					OsDescription:                ResourceAttributeConfig{Enabled: true},
-					OsVersion:                    ResourceAttributeConfig{Enabled: true},
+					CloudAvailabilityZone:            ResourceAttributeConfig{Enabled: true},
+					CloudRegion:                      ResourceAttributeConfig{Enabled: true},
+					ContainerID:                      ResourceAttributeConfig{Enabled: true},
+					ContainerImageName:               ResourceAttributeConfig{Enabled: true},
+					ContainerImageTag:                ResourceAttributeConfig{Enabled: true},
+					ContainerRuntime:                 ResourceAttributeConfig{Enabled: true},
+					ContainerRuntimeVersion:          ResourceAttributeConfig{Enabled: true},
+					HostType:                         ResourceAttributeConfig{Enabled: true},
+					K8sClusterName:                   ResourceAttributeConfig{Enabled: true},
+					K8sContainerName:                 ResourceAttributeConfig{Enabled: true},
+					K8sContainerType:                 ResourceAttributeConfig{Enabled: true},
+					K8sCronjobName:                   ResourceAttributeConfig{Enabled: true},
+					K8sCronjobUID:                    ResourceAttributeConfig{Enabled: true},
+					K8sDaemonsetName:                 ResourceAttributeConfig{Enabled: true},
+					K8sDaemonsetUID:                  ResourceAttributeConfig{Enabled: true},
+					K8sDeploymentName:                ResourceAttributeConfig{Enabled: true},
+					K8sDeploymentUID:                 ResourceAttributeConfig{Enabled: true},
+					K8sEndpointsName:                 ResourceAttributeConfig{Enabled: true},
+					K8sEndpointsUID:                  ResourceAttributeConfig{Enabled: true},
+					K8sEndpointsliceAddressType:      ResourceAttributeConfig{Enabled: true},
+					K8sEndpointsliceName:             ResourceAttributeConfig{Enabled: true},
+					K8sEndpointsliceUID:              ResourceAttributeConfig{Enabled: true},
+					K8sHpaName:                       ResourceAttributeConfig{Enabled: true},
+					K8sHpaUID:                        ResourceAttributeConfig{Enabled: true},
+					K8sIngressName:                   ResourceAttributeConfig{Enabled: true},
+					K8sIngressUID:                    ResourceAttributeConfig{Enabled: true},
+					K8sJobName:                       ResourceAttributeConfig{Enabled: true},
+					K8sJobUID:                        ResourceAttributeConfig{Enabled: true},
+					K8sKubeletVersion:                ResourceAttributeConfig{Enabled: true},
+					K8sKubeproxyVersion:              ResourceAttributeConfig{Enabled: true},
+					K8sLimitrangeName:                ResourceAttributeConfig{Enabled: true},
+					K8sLimitrangeUID:                 ResourceAttributeConfig{Enabled: true},
+					K8sNamespaceName:                 ResourceAttributeConfig{Enabled: true},
+					K8sNamespaceUID:                  ResourceAttributeConfig{Enabled: true},
+					K8sNodeName:                      ResourceAttributeConfig{Enabled: true},
+					K8sNodeUID:                       ResourceAttributeConfig{Enabled: true},
+					K8sPdbName:                       ResourceAttributeConfig{Enabled: true},
+					K8sPdbUID:                        ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeName:          ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeUID:           ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeclaimName:     ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeclaimUID:      ResourceAttributeConfig{Enabled: true},
+					K8sPodName:                       ResourceAttributeConfig{Enabled: true},
+					K8sPodPriorityClassName:          ResourceAttributeConfig{Enabled: true},
+					K8sPodQosClass:                   ResourceAttributeConfig{Enabled: true},
+					K8sPodUID:                        ResourceAttributeConfig{Enabled: true},
+					K8sPriorityclassName:             ResourceAttributeConfig{Enabled: true},
+					K8sPriorityclassPreemptionPolicy: ResourceAttributeConfig{Enabled: true},
+					K8sPriorityclassUID:              ResourceAttributeConfig{Enabled: true},
+					K8sReplicasetName:                ResourceAttributeConfig{Enabled: true},
+					K8sReplicasetUID:                 ResourceAttributeConfig{Enabled: true},
+					K8sReplicationcontrollerName:     ResourceAttributeConfig{Enabled: true},
+					K8sReplicationcontrollerUID:      ResourceAttributeConfig{Enabled: true},
+					K8sResourcequotaName:             ResourceAttributeConfig{Enabled: true},
+					K8sResourcequotaScope:            ResourceAttributeConfig{Enabled: true},
+					K8sResourcequotaUID:              ResourceAttributeConfig{Enabled: true},
+					K8sServiceName:                   ResourceAttributeConfig{Enabled: true},
+					K8sServiceUID:                    ResourceAttributeConfig{Enabled: true},
+					K8sStatefulsetName:               ResourceAttributeConfig{Enabled: true},
+					K8sStatefulsetUID:                ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassIsDefault:         ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassName:              ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassProvisioner:       ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassReclaimPolicy:     ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassUID:               ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassVolumeBindingMode: ResourceAttributeConfig{Enabled: true},
+					K8sVpaName:                       ResourceAttributeConfig{Enabled: true},
+					K8sVpaTargetKind:                 ResourceAttributeConfig{Enabled: true},
+					K8sVpaTargetName:                 ResourceAttributeConfig{Enabled: true},
+					K8sVpaUID:                        ResourceAttributeConfig{Enabled: true},
+					OpenshiftClusterquotaName:        ResourceAttributeConfig{Enabled: true},
+					OpenshiftClusterquotaUID:         ResourceAttributeConfig{Enabled: true},
+					OpenshiftDeploymentconfigName:    ResourceAttributeConfig{Enabled: true},
+					OpenshiftDeploymentconfigUID:     ResourceAttributeConfig{Enabled: true},
+					OsDescription:                    ResourceAttributeConfig{Enabled: true},
+					OsVersion:                        ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -116,89 +235,208 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					K8sContainerCPULimit:                MetricConfig{Enabled: false},
-					K8sContainerCPURequest:              MetricConfig{Enabled: false},
-					K8sContainerEphemeralstorageLimit:   MetricConfig{Enabled: false},
-					K8sContainerEphemeralstorageRequest: MetricConfig{Enabled: false},
-					K8sContainerMemoryLimit:             MetricConfig{Enabled: false},
-					K8sContainerMemoryRequest:           MetricConfig{Enabled: false},
-					K8sContainerReady:                   MetricConfig{Enabled: false},
-					K8sContainerRestarts:                MetricConfig{Enabled: false},
-					K8sContainerStorageLimit:            MetricConfig{Enabled: false},
-					K8sContainerStorageRequest:          MetricConfig{Enabled: false},
-					K8sCronjobActiveJobs:                MetricConfig{Enabled: false},
-					K8sDaemonsetCurrentScheduledNodes:   MetricConfig{Enabled: false},
-					K8sDaemonsetDesiredScheduledNodes:   MetricConfig{Enabled: false},
-					K8sDaemonsetMisscheduledNodes:       MetricConfig{Enabled: false},
-					K8sDaemonsetReadyNodes:              MetricConfig{Enabled: false},
-					K8sDeploymentAvailable:              MetricConfig{Enabled: false},
-					K8sDeploymentDesired:                MetricConfig{Enabled: false},
-					K8sHpaCurrentReplicas:               MetricConfig{Enabled: false},
-					K8sHpaDesiredReplicas:               MetricConfig{Enabled: false},
-					K8sHpaMaxReplicas:                   MetricConfig{Enabled: false},
-					K8sHpaMinReplicas:                   MetricConfig{Enabled: false},
-					K8sJobActivePods:                    MetricConfig{Enabled: false},
-					K8sJobDesiredSuccessfulPods:         MetricConfig{Enabled: false},
-					K8sJobFailedPods:                    MetricConfig{Enabled: false},
-					K8sJobMaxParallelPods:               MetricConfig{Enabled: false},
-					K8sJobSuccessfulPods:                MetricConfig{Enabled: false},
-					K8sNamespacePhase:                   MetricConfig{Enabled: false},
-					K8sNodeCondition:                    MetricConfig{Enabled: false},
-					K8sPodPhase:                         MetricConfig{Enabled: false},
-					K8sPodStatusReason:                  MetricConfig{Enabled: false},
-					K8sReplicasetAvailable:              MetricConfig{Enabled: false},
-					K8sReplicasetDesired:                MetricConfig{Enabled: false},
-					K8sReplicationControllerAvailable:   MetricConfig{Enabled: false},
-					K8sReplicationControllerDesired:     MetricConfig{Enabled: false},
-					K8sResourceQuotaHardLimit:           MetricConfig{Enabled: false},
-					K8sResourceQuotaUsed:                MetricConfig{Enabled: false},
-					K8sStatefulsetCurrentPods:           MetricConfig{Enabled: false},
-					K8sStatefulsetDesiredPods:           MetricConfig{Enabled: false},
-					K8sStatefulsetReadyPods:             MetricConfig{Enabled: false},
-					K8sStatefulsetUpdatedPods:           MetricConfig{Enabled: false},
-					OpenshiftAppliedclusterquotaLimit:   MetricConfig{Enabled: false},
-					OpenshiftAppliedclusterquotaUsed:    MetricConfig{Enabled: false},
-					OpenshiftClusterquotaLimit:          MetricConfig{Enabled: false},
-					OpenshiftClusterquotaUsed:           MetricConfig{Enabled: false},
+					K8sClusterPodCount:                       MetricConfig{Enabled: false},
+					K8sContainerCPULimit:                     MetricConfig{Enabled: false},
+					K8sContainerCPULimitRatio:                MetricConfig{Enabled: false},
+					K8sContainerCPURequest:                   MetricConfig{Enabled: false},
+					K8sContainerCPURequestNodeFraction:       MetricConfig{Enabled: false},
+					K8sContainerEphemeralstorageLimit:        MetricConfig{Enabled: false},
+					K8sContainerEphemeralstorageRequest:      MetricConfig{Enabled: false},
+					K8sContainerLastExitCode:                 MetricConfig{Enabled: false},
+					K8sContainerLastTerminationReason:        MetricConfig{Enabled: false},
+					K8sContainerMemoryLimit:                  MetricConfig{Enabled: false},
+					K8sContainerMemoryLimitRatio:             MetricConfig{Enabled: false},
+					K8sContainerMemoryRequest:                MetricConfig{Enabled: false},
+					K8sContainerMemoryRequestNodeFraction:    MetricConfig{Enabled: false},
+					K8sContainerReady:                        MetricConfig{Enabled: false},
+					K8sContainerRestarts:                     MetricConfig{Enabled: false},
+					K8sContainerStarted:                      MetricConfig{Enabled: false},
+					K8sContainerStorageLimit:                 MetricConfig{Enabled: false},
+					K8sContainerStorageRequest:               MetricConfig{Enabled: false},
+					K8sContainerWaiting:                      MetricConfig{Enabled: false},
+					K8sCronjobActiveJobs:                     MetricConfig{Enabled: false},
+					K8sCronjobLastScheduleAge:                MetricConfig{Enabled: false},
+					K8sCronjobSuspended:                      MetricConfig{Enabled: false},
+					K8sCustomResourceCount:                   MetricConfig{Enabled: false},
+					K8sDaemonsetCurrentScheduledNodes:        MetricConfig{Enabled: false},
+					K8sDaemonsetDesiredScheduledNodes:        MetricConfig{Enabled: false},
+					K8sDaemonsetGenerationSkew:               MetricConfig{Enabled: false},
+					K8sDaemonsetMisscheduledNodes:            MetricConfig{Enabled: false},
+					K8sDaemonsetReadyNodes:                   MetricConfig{Enabled: false},
+					K8sDaemonsetReplicasUnavailable:          MetricConfig{Enabled: false},
+					K8sDaemonsetUnavailableNodes:             MetricConfig{Enabled: false},
+					K8sDaemonsetUpdatedRatio:                 MetricConfig{Enabled: false},
+					K8sDeploymentAvailable:                   MetricConfig{Enabled: false},
+					K8sDeploymentCollisionCount:              MetricConfig{Enabled: false},
+					K8sDeploymentCondition:                   MetricConfig{Enabled: false},
+					K8sDeploymentDesired:                     MetricConfig{Enabled: false},
+					K8sDeploymentGenerationSkew:              MetricConfig{Enabled: false},
+					K8sDeploymentPaused:                      MetricConfig{Enabled: false},
+					K8sDeploymentReplicasUnavailable:         MetricConfig{Enabled: false},
+					K8sEndpointsAddressCount:                 MetricConfig{Enabled: false},
+					K8sEndpointsNotReadyAddressCount:         MetricConfig{Enabled: false},
+					K8sEndpointsliceAddressCount:             MetricConfig{Enabled: false},
+					K8sEndpointsliceReadyCount:               MetricConfig{Enabled: false},
+					K8sHpaCondition:                          MetricConfig{Enabled: false},
+					K8sHpaCurrentMetricValue:                 MetricConfig{Enabled: false},
+					K8sHpaCurrentReplicas:                    MetricConfig{Enabled: false},
+					K8sHpaDesiredReplicas:                    MetricConfig{Enabled: false},
+					K8sHpaExternalCurrentValue:               MetricConfig{Enabled: false},
+					K8sHpaExternalTargetValue:                MetricConfig{Enabled: false},
+					K8sHpaMaxReplicas:                        MetricConfig{Enabled: false},
+					K8sHpaMinReplicas:                        MetricConfig{Enabled: false},
+					K8sHpaTargetMetricValue:                  MetricConfig{Enabled: false},
+					K8sIngressRuleCount:                      MetricConfig{Enabled: false},
+					K8sIngressTLSCount:                       MetricConfig{Enabled: false},
+					K8sJobActivePods:                         MetricConfig{Enabled: false},
+					K8sJobBackoffLimit:                       MetricConfig{Enabled: false},
+					K8sJobCompletedIndexesCount:              MetricConfig{Enabled: false},
+					K8sJobDesiredSuccessfulPods:              MetricConfig{Enabled: false},
+					K8sJobDuration:                           MetricConfig{Enabled: false},
+					K8sJobFailedExhausted:                    MetricConfig{Enabled: false},
+					K8sJobFailedPods:                         MetricConfig{Enabled: false},
+					K8sJobMaxParallelPods:                    MetricConfig{Enabled: false},
+					K8sJobSuccessfulPods:                     MetricConfig{Enabled: false},
+					K8sLimitrangeDefaultCPURequest:           MetricConfig{Enabled: false},
+					K8sLimitrangeDefaultMemoryRequest:        MetricConfig{Enabled: false},
+					K8sLimitrangeMax:                         MetricConfig{Enabled: false},
+					K8sLimitrangeMin:                         MetricConfig{Enabled: false},
+					K8sNamespacePhase:                        MetricConfig{Enabled: false},
+					K8sNamespaceSecretCount:                  MetricConfig{Enabled: false},
+					K8sNamespaceServiceaccountCount:          MetricConfig{Enabled: false},
+					K8sNodeAge:                               MetricConfig{Enabled: false},
+					K8sNodeCondition:                         MetricConfig{Enabled: false},
+					K8sNodeEvictionRisk:                      MetricConfig{Enabled: false},
+					K8sNodeLeaseRenewAge:                     MetricConfig{Enabled: false},
+					K8sNodePodCount:                          MetricConfig{Enabled: false},
+					K8sNodeTaintCount:                        MetricConfig{Enabled: false},
+					K8sNodeUnschedulable:                     MetricConfig{Enabled: false},
+					K8sPdbCurrentHealthy:                     MetricConfig{Enabled: false},
+					K8sPdbDesiredHealthy:                     MetricConfig{Enabled: false},
+					K8sPdbDisruptionsAllowed:                 MetricConfig{Enabled: false},
+					K8sPdbExpectedPods:                       MetricConfig{Enabled: false},
+					K8sPersistentvolumeCapacity:              MetricConfig{Enabled: false},
+					K8sPersistentvolumePhase:                 MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimActualCapacity:   MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimPhase:            MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimRequestedStorage: MetricConfig{Enabled: false},
+					K8sPodAge:                                MetricConfig{Enabled: false},
+					K8sPodCondition:                          MetricConfig{Enabled: false},
+					K8sPodOrphaned:                           MetricConfig{Enabled: false},
+					K8sPodPhase:                              MetricConfig{Enabled: false},
+					K8sPodPriority:                           MetricConfig{Enabled: false},
+					K8sPodSchedulingLatency:                  MetricConfig{Enabled: false},
+					K8sPodStatusReason:                       MetricConfig{Enabled: false},
+					K8sPodUnschedulable:                      MetricConfig{Enabled: false},
+					K8sPriorityclassGlobalDefault:            MetricConfig{Enabled: false},
+					K8sPriorityclassValue:                    MetricConfig{Enabled: false},
+					K8sReplicasetAvailable:                   MetricConfig{Enabled: false},
+					K8sReplicasetDesired:                     MetricConfig{Enabled: false},
+					K8sReplicasetFullyLabeledReplicas:        MetricConfig{Enabled: false},
+					K8sReplicationControllerAvailable:        MetricConfig{Enabled: false},
+					K8sReplicationControllerDesired:          MetricConfig{Enabled: false},
+					K8sResourceQuotaHardLimit:                MetricConfig{Enabled: false},
+					K8sResourceQuotaUsed:                     MetricConfig{Enabled: false},
+					K8sResourceQuotaUtilization:              MetricConfig{Enabled: false},
+					K8sServicePortCount:                      MetricConfig{Enabled: false},
+					K8sServiceType:                           MetricConfig{Enabled: false},
+					K8sStatefulsetCollisionCount:             MetricConfig{Enabled: false},
+					K8sStatefulsetCurrentPods:                MetricConfig{Enabled: false},
+					K8sStatefulsetDesiredPods:                MetricConfig{Enabled: false},
+					K8sStatefulsetGenerationSkew:             MetricConfig{Enabled: false},
+					K8sStatefulsetReadyPods:                  MetricConfig{Enabled: false},
+					K8sStatefulsetRevisionMismatch:           MetricConfig{Enabled: false},
+					K8sStatefulsetUpdatePartition:            MetricConfig{Enabled: false},
+					K8sStatefulsetUpdatedPods:                MetricConfig{Enabled: false},
+					K8sStorageclassInfo:                      MetricConfig{Enabled: false},
+					K8sVpaTargetCPU:                          MetricConfig{Enabled: false},
+					K8sVpaTargetMemory:                       MetricConfig{Enabled: false},
+					OpenshiftAppliedclusterquotaLimit:        MetricConfig{Enabled: false},
+					OpenshiftAppliedclusterquotaUsed:         MetricConfig{Enabled: false},
+					OpenshiftClusterquotaLimit:               MetricConfig{Enabled: false},
+					OpenshiftClusterquotaUsed:                MetricConfig{Enabled: false},
+					OpenshiftDeploymentconfigAvailable:       MetricConfig{Enabled: false},
+					OpenshiftDeploymentconfigDesired:         MetricConfig{Enabled: false},
+					OpenshiftDeploymentconfigReady:           MetricConfig{Enabled: false},
+					OpenshiftDeploymentconfigUpdated:         MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					ContainerID:                  ResourceAttributeConfig{Enabled: false},
-					ContainerImageName:           ResourceAttributeConfig{Enabled: false},
-					ContainerImageTag:            ResourceAttributeConfig{Enabled: false},
-					ContainerRuntime:             ResourceAttributeConfig{Enabled: false},
-					ContainerRuntimeVersion:      ResourceAttributeConfig{Enabled: false},
-					K8sContainerName:             ResourceAttributeConfig{Enabled: false},
-					K8sCronjobName:               ResourceAttributeConfig{Enabled: false},
-					K8sCronjobUID:                ResourceAttributeConfig{Enabled: false},
-					K8sDaemonsetName:             ResourceAttributeConfig{Enabled: false},
-					K8sDaemonsetUID:              ResourceAttributeConfig{Enabled: false},
-					K8sDeploymentName:            ResourceAttributeConfig{Enabled: false},
-					K8sDeploymentUID:             ResourceAttributeConfig{Enabled: false},
-					K8sHpaName:                   ResourceAttributeConfig{Enabled: false},
-					K8sHpaUID:                    ResourceAttributeConfig{Enabled: false},
-					K8sJobName:                   ResourceAttributeConfig{Enabled: false},
-					K8sJobUID:                    ResourceAttributeConfig{Enabled: false},
-					K8sKubeletVersion:            ResourceAttributeConfig{Enabled: false},
-					K8sKubeproxyVersion:          ResourceAttributeConfig{Enabled: false},
-					K8sNamespaceName:             ResourceAttributeConfig{Enabled: false},
-					K8sNamespaceUID:              ResourceAttributeConfig{Enabled: false},
-					K8sNodeName:                  ResourceAttributeConfig{Enabled: false},
-					K8sNodeUID:                   ResourceAttributeConfig{Enabled: false},
-					K8sPodName:                   ResourceAttributeConfig{Enabled: false},
-					K8sPodQosClass:               ResourceAttributeConfig{Enabled: false},
-					K8sPodUID:                    ResourceAttributeConfig{Enabled: false},
-					K8sReplicasetName:            ResourceAttributeConfig{Enabled: false},
-					K8sReplicasetUID:             ResourceAttributeConfig{Enabled: false},
-					K8sReplicationcontrollerName: ResourceAttributeConfig{Enabled: false},
-					K8sReplicationcontrollerUID:  ResourceAttributeConfig{Enabled: false},
-					K8sResourcequotaName:         ResourceAttributeConfig{Enabled: false},
-					K8sResourcequotaUID:          ResourceAttributeConfig{Enabled: false},
-					K8sStatefulsetName:           ResourceAttributeConfig{Enabled: false},
-					K8sStatefulsetUID:            ResourceAttributeConfig{Enabled: false},
-					OpenshiftClusterquotaName:    ResourceAttributeConfig{Enabled: false},
-					OpenshiftClusterquotaUID:     ResourceAttributeConfig{Enabled: false},
-					OsDescription:                ResourceAttributeConfig{Enabled: false},
-					OsVersion:                    ResourceAttributeConfig{Enabled: false},
+					CloudAvailabilityZone:            ResourceAttributeConfig{Enabled: false},
+					CloudRegion:                      ResourceAttributeConfig{Enabled: false},
+					ContainerID:                      ResourceAttributeConfig{Enabled: false},
+					ContainerImageName:               ResourceAttributeConfig{Enabled: false},
+					ContainerImageTag:                ResourceAttributeConfig{Enabled: false},
+					ContainerRuntime:                 ResourceAttributeConfig{Enabled: false},
+					ContainerRuntimeVersion:          ResourceAttributeConfig{Enabled: false},
+					HostType:                         ResourceAttributeConfig{Enabled: false},
+					K8sClusterName:                   ResourceAttributeConfig{Enabled: false},
+					K8sContainerName:                 ResourceAttributeConfig{Enabled: false},
+					K8sContainerType:                 ResourceAttributeConfig{Enabled: false},
+					K8sCronjobName:                   ResourceAttributeConfig{Enabled: false},
+					K8sCronjobUID:                    ResourceAttributeConfig{Enabled: false},
+					K8sDaemonsetName:                 ResourceAttributeConfig{Enabled: false},
+					K8sDaemonsetUID:                  ResourceAttributeConfig{Enabled: false},
+					K8sDeploymentName:                ResourceAttributeConfig{Enabled: false},
+					K8sDeploymentUID:                 ResourceAttributeConfig{Enabled: false},
+					K8sEndpointsName:                 ResourceAttributeConfig{Enabled: false},
+					K8sEndpointsUID:                  ResourceAttributeConfig{Enabled: false},
+					K8sEndpointsliceAddressType:      ResourceAttributeConfig{Enabled: false},
+					K8sEndpointsliceName:             ResourceAttributeConfig{Enabled: false},
+					K8sEndpointsliceUID:              ResourceAttributeConfig{Enabled: false},
+					K8sHpaName:                       ResourceAttributeConfig{Enabled: false},
+					K8sHpaUID:                        ResourceAttributeConfig{Enabled: false},
+					K8sIngressName:                   ResourceAttributeConfig{Enabled: false},
+					K8sIngressUID:                    ResourceAttributeConfig{Enabled: false},
+					K8sJobName:                       ResourceAttributeConfig{Enabled: false},
+					K8sJobUID:                        ResourceAttributeConfig{Enabled: false},
+					K8sKubeletVersion:                ResourceAttributeConfig{Enabled: false},
+					K8sKubeproxyVersion:              ResourceAttributeConfig{Enabled: false},
+					K8sLimitrangeName:                ResourceAttributeConfig{Enabled: false},
+					K8sLimitrangeUID:                 ResourceAttributeConfig{Enabled: false},
+					K8sNamespaceName:                 ResourceAttributeConfig{Enabled: false},
+					K8sNamespaceUID:                  ResourceAttributeConfig{Enabled: false},
+					K8sNodeName:                      ResourceAttributeConfig{Enabled: false},
+					K8sNodeUID:                       ResourceAttributeConfig{Enabled: false},
+					K8sPdbName:                       ResourceAttributeConfig{Enabled: false},
+					K8sPdbUID:                        ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeName:          ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeUID:           ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeclaimName:     ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeclaimUID:      ResourceAttributeConfig{Enabled: false},
+					K8sPodName:                       ResourceAttributeConfig{Enabled: false},
+					K8sPodPriorityClassName:          ResourceAttributeConfig{Enabled: false},
+					K8sPodQosClass:                   ResourceAttributeConfig{Enabled: false},
+					K8sPodUID:                        ResourceAttributeConfig{Enabled: false},
+					K8sPriorityclassName:             ResourceAttributeConfig{Enabled: false},
+					K8sPriorityclassPreemptionPolicy: ResourceAttributeConfig{Enabled: false},
+					K8sPriorityclassUID:              ResourceAttributeConfig{Enabled: false},
+					K8sReplicasetName:                ResourceAttributeConfig{Enabled: false},
+					K8sReplicasetUID:                 ResourceAttributeConfig{Enabled: false},
+					K8sReplicationcontrollerName:     ResourceAttributeConfig{Enabled: false},
+					K8sReplicationcontrollerUID:      ResourceAttributeConfig{Enabled: false},
+					K8sResourcequotaName:             ResourceAttributeConfig{Enabled: false},
+					K8sResourcequotaScope:            ResourceAttributeConfig{Enabled: false},
+					K8sResourcequotaUID:              ResourceAttributeConfig{Enabled: false},
+					K8sServiceName:                   ResourceAttributeConfig{Enabled: false},
+					K8sServiceUID:                    ResourceAttributeConfig{Enabled: false},
+					K8sStatefulsetName:               ResourceAttributeConfig{Enabled: false},
+					K8sStatefulsetUID:                ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassIsDefault:         ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassName:              ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassProvisioner:       ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassReclaimPolicy:     ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassUID:               ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassVolumeBindingMode: ResourceAttributeConfig{Enabled: false},
+					K8sVpaName:                       ResourceAttributeConfig{Enabled: false},
+					K8sVpaTargetKind:                 ResourceAttributeConfig{Enabled: false},
+					K8sVpaTargetName:                 ResourceAttributeConfig{Enabled: false},
+					K8sVpaUID:                        ResourceAttributeConfig{Enabled: false},
+					OpenshiftClusterquotaName:        ResourceAttributeConfig{Enabled: false},
+					OpenshiftClusterquotaUID:         ResourceAttributeConfig{Enabled: false},
+					OpenshiftDeploymentconfigName:    ResourceAttributeConfig{Enabled: false},
+					OpenshiftDeploymentconfigUID:     ResourceAttributeConfig{Enabled: false},
+					OsDescription:                    ResourceAttributeConfig{Enabled: false},
+					OsVersion:                        ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -235,85 +473,163 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				ContainerID:                  ResourceAttributeConfig{Enabled: true},
-				ContainerImageName:           ResourceAttributeConfig{Enabled: true},
-				ContainerImageTag:            ResourceAttributeConfig{Enabled: true},
-				ContainerRuntime:             ResourceAttributeConfig{Enabled: true},
-				ContainerRuntimeVersion:      ResourceAttributeConfig{Enabled: true},
-				K8sContainerName:             ResourceAttributeConfig{Enabled: true},
-				K8sCronjobName:               ResourceAttributeConfig{Enabled: true},
-				K8sCronjobUID:                ResourceAttributeConfig{Enabled: true},
-				K8sDaemonsetName:             ResourceAttributeConfig{Enabled: true},
-				K8sDaemonsetUID:              ResourceAttributeConfig{Enabled: true},
-				K8sDeploymentName:            ResourceAttributeConfig{Enabled: true},
-				K8sDeploymentUID:             ResourceAttributeConfig{Enabled: true},
-				K8sHpaName:                   ResourceAttributeConfig{Enabled: true},
-				K8sHpaUID:                    ResourceAttributeConfig{Enabled: true},
-				K8sJobName:                   ResourceAttributeConfig{Enabled: true},
-				K8sJobUID:                    ResourceAttributeConfig{Enabled: true},
-				K8sKubeletVersion:            ResourceAttributeConfig{Enabled: true},
-				K8sKubeproxyVersion:          ResourceAttributeConfig{Enabled: true},
-				K8sNamespaceName:             ResourceAttributeConfig{Enabled: true},
-				K8sNamespaceUID:              ResourceAttributeConfig{Enabled: true},
-				K8sNodeName:                  ResourceAttributeConfig{Enabled: true},
-				K8sNodeUID:                   ResourceAttributeConfig{Enabled: true},
-				K8sPodName:                   ResourceAttributeConfig{Enabled: true},
-				K8sPodQosClass:               ResourceAttributeConfig{Enabled: true},
-				K8sPodUID:                    ResourceAttributeConfig{Enabled: true},
-				K8sReplicasetName:            ResourceAttributeConfig{Enabled: true},
-				K8sReplicasetUID:             ResourceAttributeConfig{Enabled: true},
-				K8sReplicationcontrollerName: ResourceAttributeConfig{Enabled: true},
-				K8sReplicationcontrollerUID:  ResourceAttributeConfig{Enabled: true},
-				K8sResourcequotaName:         ResourceAttributeConfig{Enabled: true},
-				K8sResourcequotaUID:          ResourceAttributeConfig{Enabled: true},
-				K8sStatefulsetName:           ResourceAttributeConfig{Enabled: true},
-				K8sStatefulsetUID:            ResourceAttributeConfig{Enabled: true},
-				OpenshiftClusterquotaName:    ResourceAttributeConfig{Enabled: true},
-				OpenshiftClusterquotaUID:     ResourceAttributeConfig{Enabled: true},
-				OsDescription:                ResourceAttributeConfig{Enabled: true},
-				OsVersion:                    ResourceAttributeConfig{Enabled: true},
+				CloudAvailabilityZone:            ResourceAttributeConfig{Enabled: true},
+				CloudRegion:                      ResourceAttributeConfig{Enabled: true},
+				ContainerID:                      ResourceAttributeConfig{Enabled: true},
+				ContainerImageName:               ResourceAttributeConfig{Enabled: true},
+				ContainerImageTag:                ResourceAttributeConfig{Enabled: true},
+				ContainerRuntime:                 ResourceAttributeConfig{Enabled: true},
+				ContainerRuntimeVersion:          ResourceAttributeConfig{Enabled: true},
+				HostType:                         ResourceAttributeConfig{Enabled: true},
+				K8sClusterName:                   ResourceAttributeConfig{Enabled: true},
+				K8sContainerName:                 ResourceAttributeConfig{Enabled: true},
+				K8sContainerType:                 ResourceAttributeConfig{Enabled: true},
+				K8sCronjobName:                   ResourceAttributeConfig{Enabled: true},
+				K8sCronjobUID:                    ResourceAttributeConfig{Enabled: true},
+				K8sDaemonsetName:                 ResourceAttributeConfig{Enabled: true},
+				K8sDaemonsetUID:                  ResourceAttributeConfig{Enabled: true},
+				K8sDeploymentName:                ResourceAttributeConfig{Enabled: true},
+				K8sDeploymentUID:                 ResourceAttributeConfig{Enabled: true},
+				K8sEndpointsName:                 ResourceAttributeConfig{Enabled: true},
+				K8sEndpointsUID:                  ResourceAttributeConfig{Enabled: true},
+				K8sEndpointsliceAddressType:      ResourceAttributeConfig{Enabled: true},
+				K8sEndpointsliceName:             ResourceAttributeConfig{Enabled: true},
+				K8sEndpointsliceUID:              ResourceAttributeConfig{Enabled: true},
+				K8sHpaName:                       ResourceAttributeConfig{Enabled: true},
+				K8sHpaUID:                        ResourceAttributeConfig{Enabled: true},
+				K8sIngressName:                   ResourceAttributeConfig{Enabled: true},
+				K8sIngressUID:                    ResourceAttributeConfig{Enabled: true},
+				K8sJobName:                       ResourceAttributeConfig{Enabled: true},
+				K8sJobUID:                        ResourceAttributeConfig{Enabled: true},
+				K8sKubeletVersion:                ResourceAttributeConfig{Enabled: true},
+				K8sKubeproxyVersion:              ResourceAttributeConfig{Enabled: true},
+				K8sLimitrangeName:                ResourceAttributeConfig{Enabled: true},
+				K8sLimitrangeUID:                 ResourceAttributeConfig{Enabled: true},
+				K8sNamespaceName:                 ResourceAttributeConfig{Enabled: true},
+				K8sNamespaceUID:                  ResourceAttributeConfig{Enabled: true},
+				K8sNodeName:                      ResourceAttributeConfig{Enabled: true},
+				K8sNodeUID:                       ResourceAttributeConfig{Enabled: true},
+				K8sPdbName:                       ResourceAttributeConfig{Enabled: true},
+				K8sPdbUID:                        ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeName:          ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeUID:           ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeclaimName:     ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeclaimUID:      ResourceAttributeConfig{Enabled: true},
+				K8sPodName:                       ResourceAttributeConfig{Enabled: true},
+				K8sPodPriorityClassName:          ResourceAttributeConfig{Enabled: true},
+				K8sPodQosClass:                   ResourceAttributeConfig{Enabled: true},
+				K8sPodUID:                        ResourceAttributeConfig{Enabled: true},
+				K8sPriorityclassName:             ResourceAttributeConfig{Enabled: true},
+				K8sPriorityclassPreemptionPolicy: ResourceAttributeConfig{Enabled: true},
+				K8sPriorityclassUID:              ResourceAttributeConfig{Enabled: true},
+				K8sReplicasetName:                ResourceAttributeConfig{Enabled: true},
+				K8sReplicasetUID:                 ResourceAttributeConfig{Enabled: true},
+				K8sReplicationcontrollerName:     ResourceAttributeConfig{Enabled: true},
+				K8sReplicationcontrollerUID:      ResourceAttributeConfig{Enabled: true},
+				K8sResourcequotaName:             ResourceAttributeConfig{Enabled: true},
+				K8sResourcequotaScope:            ResourceAttributeConfig{Enabled: true},
+				K8sResourcequotaUID:              ResourceAttributeConfig{Enabled: true},
+				K8sServiceName:                   ResourceAttributeConfig{Enabled: true},
+				K8sServiceUID:                    ResourceAttributeConfig{Enabled: true},
+				K8sStatefulsetName:               ResourceAttributeConfig{Enabled: true},
+				K8sStatefulsetUID:                ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassIsDefault:         ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassName:              ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassProvisioner:       ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassReclaimPolicy:     ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassUID:               ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassVolumeBindingMode: ResourceAttributeConfig{Enabled: true},
+				K8sVpaName:                       ResourceAttributeConfig{Enabled: true},
+				K8sVpaTargetKind:                 ResourceAttributeConfig{Enabled: true},
+				K8sVpaTargetName:                 ResourceAttributeConfig{Enabled: true},
+				K8sVpaUID:                        ResourceAttributeConfig{Enabled: true},
+				OpenshiftClusterquotaName:        ResourceAttributeConfig{Enabled: true},
+				OpenshiftClusterquotaUID:         ResourceAttributeConfig{Enabled: true},
+				OpenshiftDeploymentconfigName:    ResourceAttributeConfig{Enabled: true},
+				OpenshiftDeploymentconfigUID:     ResourceAttributeConfig{Enabled: true},
+				OsDescription:                    ResourceAttributeConfig{Enabled: true},
+				OsVersion:                        ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				ContainerID:                  ResourceAttributeConfig{Enabled: false},
-				ContainerImageName:           ResourceAttributeConfig{Enabled: false},
-				ContainerImageTag:            ResourceAttributeConfig{Enabled: false},
-				ContainerRuntime:             ResourceAttributeConfig{Enabled: false},
-				ContainerRuntimeVersion:      ResourceAttributeConfig{Enabled: false},
-				K8sContainerName:             ResourceAttributeConfig{Enabled: false},
-				K8sCronjobName:               ResourceAttributeConfig{Enabled: false},
-				K8sCronjobUID:                ResourceAttributeConfig{Enabled: false},
-				K8sDaemonsetName:             ResourceAttributeConfig{Enabled: false},
-				K8sDaemonsetUID:              ResourceAttributeConfig{Enabled: false},
-				K8sDeploymentName:            ResourceAttributeConfig{Enabled: false},
-				K8sDeploymentUID:             ResourceAttributeConfig{Enabled: false},
-				K8sHpaName:                   ResourceAttributeConfig{Enabled: false},
-				K8sHpaUID:                    ResourceAttributeConfig{Enabled: false},
-				K8sJobName:                   ResourceAttributeConfig{Enabled: false},
-				K8sJobUID:                    ResourceAttributeConfig{Enabled: false},
-				K8sKubeletVersion:            ResourceAttributeConfig{Enabled: false},
-				K8sKubeproxyVersion:          ResourceAttributeConfig{Enabled: false},
-				K8sNamespaceName:             ResourceAttributeConfig{Enabled: false},
-				K8sNamespaceUID:              ResourceAttributeConfig{Enabled: false},
-				K8sNodeName:                  ResourceAttributeConfig{Enabled: false},
-				K8sNodeUID:                   ResourceAttributeConfig{Enabled: false},
-				K8sPodName:                   ResourceAttributeConfig{Enabled: false},
-				K8sPodQosClass:               ResourceAttributeConfig{Enabled: false},
-				K8sPodUID:                    ResourceAttributeConfig{Enabled: false},
-				K8sReplicasetName:            ResourceAttributeConfig{Enabled: false},
-				K8sReplicasetUID:             ResourceAttributeConfig{Enabled: false},
-				K8sReplicationcontrollerName: ResourceAttributeConfig{Enabled: false},
-				K8sReplicationcontrollerUID:  ResourceAttributeConfig{Enabled: false},
-				K8sResourcequotaName:         ResourceAttributeConfig{Enabled: false},
-				K8sResourcequotaUID:          ResourceAttributeConfig{Enabled: false},
-				K8sStatefulsetName:           ResourceAttributeConfig{Enabled: false},
-				K8sStatefulsetUID:            ResourceAttributeConfig{Enabled: false},
-				OpenshiftClusterquotaName:    ResourceAttributeConfig{Enabled: false},
-				OpenshiftClusterquotaUID:     ResourceAttributeConfig{Enabled: false},
-				OsDescription:                ResourceAttributeConfig{Enabled: false},
-				OsVersion:                    ResourceAttributeConfig{Enabled: false},
+				CloudAvailabilityZone:            ResourceAttributeConfig{Enabled: false},
+				CloudRegion:                      ResourceAttributeConfig{Enabled: false},
+				ContainerID:                      ResourceAttributeConfig{Enabled: false},
+				ContainerImageName:               ResourceAttributeConfig{Enabled: false},
+				ContainerImageTag:                ResourceAttributeConfig{Enabled: false},
+				ContainerRuntime:                 ResourceAttributeConfig{Enabled: false},
+				ContainerRuntimeVersion:          ResourceAttributeConfig{Enabled: false},
+				HostType:                         ResourceAttributeConfig{Enabled: false},
+				K8sClusterName:                   ResourceAttributeConfig{Enabled: false},
+				K8sContainerName:                 ResourceAttributeConfig{Enabled: false},
+				K8sContainerType:                 ResourceAttributeConfig{Enabled: false},
+				K8sCronjobName:                   ResourceAttributeConfig{Enabled: false},
+				K8sCronjobUID:                    ResourceAttributeConfig{Enabled: false},
+				K8sDaemonsetName:                 ResourceAttributeConfig{Enabled: false},
+				K8sDaemonsetUID:                  ResourceAttributeConfig{Enabled: false},
+				K8sDeploymentName:                ResourceAttributeConfig{Enabled: false},
+				K8sDeploymentUID:                 ResourceAttributeConfig{Enabled: false},
+				K8sEndpointsName:                 ResourceAttributeConfig{Enabled: false},
+				K8sEndpointsUID:                  ResourceAttributeConfig{Enabled: false},
+				K8sEndpointsliceAddressType:      ResourceAttributeConfig{Enabled: false},
+				K8sEndpointsliceName:             ResourceAttributeConfig{Enabled: false},
+				K8sEndpointsliceUID:              ResourceAttributeConfig{Enabled: false},
+				K8sHpaName:                       ResourceAttributeConfig{Enabled: false},
+				K8sHpaUID:                        ResourceAttributeConfig{Enabled: false},
+				K8sIngressName:                   ResourceAttributeConfig{Enabled: false},
+				K8sIngressUID:                    ResourceAttributeConfig{Enabled: false},
+				K8sJobName:                       ResourceAttributeConfig{Enabled: false},
+				K8sJobUID:                        ResourceAttributeConfig{Enabled: false},
+				K8sKubeletVersion:                ResourceAttributeConfig{Enabled: false},
+				K8sKubeproxyVersion:              ResourceAttributeConfig{Enabled: false},
+				K8sLimitrangeName:                ResourceAttributeConfig{Enabled: false},
+				K8sLimitrangeUID:                 ResourceAttributeConfig{Enabled: false},
+				K8sNamespaceName:                 ResourceAttributeConfig{Enabled: false},
+				K8sNamespaceUID:                  ResourceAttributeConfig{Enabled: false},
+				K8sNodeName:                      ResourceAttributeConfig{Enabled: false},
+				K8sNodeUID:                       ResourceAttributeConfig{Enabled: false},
+				K8sPdbName:                       ResourceAttributeConfig{Enabled: false},
+				K8sPdbUID:                        ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeName:          ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeUID:           ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeclaimName:     ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeclaimUID:      ResourceAttributeConfig{Enabled: false},
+				K8sPodName:                       ResourceAttributeConfig{Enabled: false},
+				K8sPodPriorityClassName:          ResourceAttributeConfig{Enabled: false},
+				K8sPodQosClass:                   ResourceAttributeConfig{Enabled: false},
+				K8sPodUID:                        ResourceAttributeConfig{Enabled: false},
+				K8sPriorityclassName:             ResourceAttributeConfig{Enabled: false},
+				K8sPriorityclassPreemptionPolicy: ResourceAttributeConfig{Enabled: false},
+				K8sPriorityclassUID:              ResourceAttributeConfig{Enabled: false},
+				K8sReplicasetName:                ResourceAttributeConfig{Enabled: false},
+				K8sReplicasetUID:                 ResourceAttributeConfig{Enabled: false},
+				K8sReplicationcontrollerName:     ResourceAttributeConfig{Enabled: false},
+				K8sReplicationcontrollerUID:      ResourceAttributeConfig{Enabled: false},
+				K8sResourcequotaName:             ResourceAttributeConfig{Enabled: false},
+				K8sResourcequotaScope:            ResourceAttributeConfig{Enabled: false},
+				K8sResourcequotaUID:              ResourceAttributeConfig{Enabled: false},
+				K8sServiceName:                   ResourceAttributeConfig{Enabled: false},
+				K8sServiceUID:                    ResourceAttributeConfig{Enabled: false},
+				K8sStatefulsetName:               ResourceAttributeConfig{Enabled: false},
+				K8sStatefulsetUID:                ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassIsDefault:         ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassName:              ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassProvisioner:       ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassReclaimPolicy:     ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassUID:               ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassVolumeBindingMode: ResourceAttributeConfig{Enabled: false},
+				K8sVpaName:                       ResourceAttributeConfig{Enabled: false},
+				K8sVpaTargetKind:                 ResourceAttributeConfig{Enabled: false},
+				K8sVpaTargetName:                 ResourceAttributeConfig{Enabled: false},
+				K8sVpaUID:                        ResourceAttributeConfig{Enabled: false},
+				OpenshiftClusterquotaName:        ResourceAttributeConfig{Enabled: false},
+				OpenshiftClusterquotaUID:         ResourceAttributeConfig{Enabled: false},
+				OpenshiftDeploymentconfigName:    ResourceAttributeConfig{Enabled: false},
+				OpenshiftDeploymentconfigUID:     ResourceAttributeConfig{Enabled: false},
+				OsDescription:                    ResourceAttributeConfig{Enabled: false},
+				OsVersion:                        ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
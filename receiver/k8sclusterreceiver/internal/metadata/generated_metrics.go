@@ -12,6 +12,57 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
 )
 
+type metricK8sClusterPodCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.cluster.pod.count metric with initial data.
+func (m *metricK8sClusterPodCount) init() {
+	m.data.SetName("k8s.cluster.pod.count")
+	m.data.SetDescription("Number of pods in the cluster per phase. Recorded without resource attributes, as a cluster wide aggregate of all pods.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sClusterPodCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, phaseAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("phase", phaseAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sClusterPodCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sClusterPodCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sClusterPodCount(cfg MetricConfig) metricK8sClusterPodCount {
+	m := metricK8sClusterPodCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sContainerCPULimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -61,6 +112,55 @@ func newMetricK8sContainerCPULimit(cfg MetricConfig) metricK8sContainerCPULimit
 	return m
 }
 
+type metricK8sContainerCPULimitRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.cpu_limit_ratio metric with initial data.
+func (m *metricK8sContainerCPULimitRatio) init() {
+	m.data.SetName("k8s.container.cpu_limit_ratio")
+	m.data.SetDescription("Ratio of the CPU limit to the CPU request of the container. Only sent if both the limit and a non-zero request are set")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerCPULimitRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerCPULimitRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerCPULimitRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerCPULimitRatio(cfg MetricConfig) metricK8sContainerCPULimitRatio {
+	m := metricK8sContainerCPULimitRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sContainerCPURequest struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -110,6 +210,55 @@ func newMetricK8sContainerCPURequest(cfg MetricConfig) metricK8sContainerCPURequ
 	return m
 }
 
+type metricK8sContainerCPURequestNodeFraction struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.cpu_request_node_fraction metric with initial data.
+func (m *metricK8sContainerCPURequestNodeFraction) init() {
+	m.data.SetName("k8s.container.cpu_request_node_fraction")
+	m.data.SetDescription("CPU request of the container as a fraction of the allocatable CPU of the node the pod is scheduled to. Only sent for pods scheduled to a node in the cache")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerCPURequestNodeFraction) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerCPURequestNodeFraction) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerCPURequestNodeFraction) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerCPURequestNodeFraction(cfg MetricConfig) metricK8sContainerCPURequestNodeFraction {
+	m := metricK8sContainerCPURequestNodeFraction{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sContainerEphemeralstorageLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -208,6 +357,104 @@ func newMetricK8sContainerEphemeralstorageRequest(cfg MetricConfig) metricK8sCon
 	return m
 }
 
+type metricK8sContainerLastExitCode struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.last_exit_code metric with initial data.
+func (m *metricK8sContainerLastExitCode) init() {
+	m.data.SetName("k8s.container.last_exit_code")
+	m.data.SetDescription("Exit code of the last termination of the container, e.g. 137 when it was killed with SIGKILL. Only reported for containers that terminated before")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerLastExitCode) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerLastExitCode) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerLastExitCode) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerLastExitCode(cfg MetricConfig) metricK8sContainerLastExitCode {
+	m := metricK8sContainerLastExitCode{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sContainerLastTerminationReason struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.last_termination_reason metric with initial data.
+func (m *metricK8sContainerLastTerminationReason) init() {
+	m.data.SetName("k8s.container.last_termination_reason")
+	m.data.SetDescription("Reason of the last termination of the container (1 - OOMKilled, 2 - Error, 3 - Completed, 4 - ContainerCannotRun, 5 - DeadlineExceeded, 6 - Unknown)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerLastTerminationReason) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerLastTerminationReason) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerLastTerminationReason) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerLastTerminationReason(cfg MetricConfig) metricK8sContainerLastTerminationReason {
+	m := metricK8sContainerLastTerminationReason{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sContainerMemoryLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -257,39 +504,39 @@ func newMetricK8sContainerMemoryLimit(cfg MetricConfig) metricK8sContainerMemory
 	return m
 }
 
-type metricK8sContainerMemoryRequest struct {
+type metricK8sContainerMemoryLimitRatio struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.container.memory_request metric with initial data.
-func (m *metricK8sContainerMemoryRequest) init() {
-	m.data.SetName("k8s.container.memory_request")
-	m.data.SetDescription("Resource requested for the container. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.23/#resourcerequirements-v1-core for details")
-	m.data.SetUnit("By")
+// init fills k8s.container.memory_limit_ratio metric with initial data.
+func (m *metricK8sContainerMemoryLimitRatio) init() {
+	m.data.SetName("k8s.container.memory_limit_ratio")
+	m.data.SetDescription("Ratio of the memory limit to the memory request of the container. Only sent if both the limit and a non-zero request are set")
+	m.data.SetUnit("1")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sContainerMemoryRequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sContainerMemoryLimitRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetIntValue(val)
+	dp.SetDoubleValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sContainerMemoryRequest) updateCapacity() {
+func (m *metricK8sContainerMemoryLimitRatio) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sContainerMemoryRequest) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sContainerMemoryLimitRatio) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -297,8 +544,8 @@ func (m *metricK8sContainerMemoryRequest) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sContainerMemoryRequest(cfg MetricConfig) metricK8sContainerMemoryRequest {
-	m := metricK8sContainerMemoryRequest{config: cfg}
+func newMetricK8sContainerMemoryLimitRatio(cfg MetricConfig) metricK8sContainerMemoryLimitRatio {
+	m := metricK8sContainerMemoryLimitRatio{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -306,21 +553,21 @@ func newMetricK8sContainerMemoryRequest(cfg MetricConfig) metricK8sContainerMemo
 	return m
 }
 
-type metricK8sContainerReady struct {
+type metricK8sContainerMemoryRequest struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.container.ready metric with initial data.
-func (m *metricK8sContainerReady) init() {
-	m.data.SetName("k8s.container.ready")
-	m.data.SetDescription("Whether a container has passed its readiness probe (0 for no, 1 for yes)")
-	m.data.SetUnit("")
+// init fills k8s.container.memory_request metric with initial data.
+func (m *metricK8sContainerMemoryRequest) init() {
+	m.data.SetName("k8s.container.memory_request")
+	m.data.SetDescription("Resource requested for the container. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.23/#resourcerequirements-v1-core for details")
+	m.data.SetUnit("By")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sContainerReady) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sContainerMemoryRequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -331,14 +578,14 @@ func (m *metricK8sContainerReady) recordDataPoint(start pcommon.Timestamp, ts pc
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sContainerReady) updateCapacity() {
+func (m *metricK8sContainerMemoryRequest) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sContainerReady) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sContainerMemoryRequest) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -346,8 +593,8 @@ func (m *metricK8sContainerReady) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sContainerReady(cfg MetricConfig) metricK8sContainerReady {
-	m := metricK8sContainerReady{config: cfg}
+func newMetricK8sContainerMemoryRequest(cfg MetricConfig) metricK8sContainerMemoryRequest {
+	m := metricK8sContainerMemoryRequest{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -355,39 +602,39 @@ func newMetricK8sContainerReady(cfg MetricConfig) metricK8sContainerReady {
 	return m
 }
 
-type metricK8sContainerRestarts struct {
+type metricK8sContainerMemoryRequestNodeFraction struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.container.restarts metric with initial data.
-func (m *metricK8sContainerRestarts) init() {
-	m.data.SetName("k8s.container.restarts")
-	m.data.SetDescription("How many times the container has restarted in the recent past. This value is pulled directly from the K8s API and the value can go indefinitely high and be reset to 0 at any time depending on how your kubelet is configured to prune dead containers. It is best to not depend too much on the exact value but rather look at it as either == 0, in which case you can conclude there were no restarts in the recent past, or > 0, in which case you can conclude there were restarts in the recent past, and not try and analyze the value beyond that.")
-	m.data.SetUnit("{restart}")
+// init fills k8s.container.memory_request_node_fraction metric with initial data.
+func (m *metricK8sContainerMemoryRequestNodeFraction) init() {
+	m.data.SetName("k8s.container.memory_request_node_fraction")
+	m.data.SetDescription("Memory request of the container as a fraction of the allocatable memory of the node the pod is scheduled to. Only sent for pods scheduled to a node in the cache")
+	m.data.SetUnit("1")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sContainerRestarts) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sContainerMemoryRequestNodeFraction) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetIntValue(val)
+	dp.SetDoubleValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sContainerRestarts) updateCapacity() {
+func (m *metricK8sContainerMemoryRequestNodeFraction) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sContainerRestarts) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sContainerMemoryRequestNodeFraction) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -395,8 +642,3721 @@ func (m *metricK8sContainerRestarts) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sContainerRestarts(cfg MetricConfig) metricK8sContainerRestarts {
-	m := metricK8sContainerRestarts{config: cfg}
+func newMetricK8sContainerMemoryRequestNodeFraction(cfg MetricConfig) metricK8sContainerMemoryRequestNodeFraction {
+	m := metricK8sContainerMemoryRequestNodeFraction{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sContainerReady struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.ready metric with initial data.
+func (m *metricK8sContainerReady) init() {
+	m.data.SetName("k8s.container.ready")
+	m.data.SetDescription("Whether a container has passed its readiness probe (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerReady) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerReady) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerReady) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerReady(cfg MetricConfig) metricK8sContainerReady {
+	m := metricK8sContainerReady{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sContainerRestarts struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.restarts metric with initial data.
+func (m *metricK8sContainerRestarts) init() {
+	m.data.SetName("k8s.container.restarts")
+	m.data.SetDescription("How many times the container has restarted in the recent past. This value is pulled directly from the K8s API and the value can go indefinitely high and be reset to 0 at any time depending on how your kubelet is configured to prune dead containers. It is best to not depend too much on the exact value but rather look at it as either == 0, in which case you can conclude there were no restarts in the recent past, or > 0, in which case you can conclude there were restarts in the recent past, and not try and analyze the value beyond that.")
+	m.data.SetUnit("{restart}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerRestarts) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerRestarts) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerRestarts) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerRestarts(cfg MetricConfig) metricK8sContainerRestarts {
+	m := metricK8sContainerRestarts{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sContainerStarted struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.started metric with initial data.
+func (m *metricK8sContainerStarted) init() {
+	m.data.SetName("k8s.container.started")
+	m.data.SetDescription("Whether a container has passed its startup probe (0 for no, 1 for yes). Only reported once set by the kubelet.")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerStarted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerStarted) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerStarted) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerStarted(cfg MetricConfig) metricK8sContainerStarted {
+	m := metricK8sContainerStarted{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sContainerStorageLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.storage_limit metric with initial data.
+func (m *metricK8sContainerStorageLimit) init() {
+	m.data.SetName("k8s.container.storage_limit")
+	m.data.SetDescription("Maximum resource limit set for the container. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.23/#resourcerequirements-v1-core for details")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerStorageLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerStorageLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerStorageLimit) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerStorageLimit(cfg MetricConfig) metricK8sContainerStorageLimit {
+	m := metricK8sContainerStorageLimit{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sContainerStorageRequest struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.storage_request metric with initial data.
+func (m *metricK8sContainerStorageRequest) init() {
+	m.data.SetName("k8s.container.storage_request")
+	m.data.SetDescription("Resource requested for the container. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.23/#resourcerequirements-v1-core for details")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerStorageRequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerStorageRequest) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerStorageRequest) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerStorageRequest(cfg MetricConfig) metricK8sContainerStorageRequest {
+	m := metricK8sContainerStorageRequest{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sContainerWaiting struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.waiting metric with initial data.
+func (m *metricK8sContainerWaiting) init() {
+	m.data.SetName("k8s.container.waiting")
+	m.data.SetDescription("Whether the container is waiting to run, e.g. in CrashLoopBackOff or ImagePullBackOff, with the reason of waiting. Only reported for waiting containers")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sContainerWaiting) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, reasonAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("reason", reasonAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerWaiting) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerWaiting) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerWaiting(cfg MetricConfig) metricK8sContainerWaiting {
+	m := metricK8sContainerWaiting{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sCronjobActiveJobs struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.cronjob.active_jobs metric with initial data.
+func (m *metricK8sCronjobActiveJobs) init() {
+	m.data.SetName("k8s.cronjob.active_jobs")
+	m.data.SetDescription("The number of actively running jobs for a cronjob")
+	m.data.SetUnit("{job}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sCronjobActiveJobs) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sCronjobActiveJobs) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sCronjobActiveJobs) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sCronjobActiveJobs(cfg MetricConfig) metricK8sCronjobActiveJobs {
+	m := metricK8sCronjobActiveJobs{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sCronjobLastScheduleAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.cronjob.last_schedule_age metric with initial data.
+func (m *metricK8sCronjobLastScheduleAge) init() {
+	m.data.SetName("k8s.cronjob.last_schedule_age")
+	m.data.SetDescription("The time elapsed since the cronjob was last successfully scheduled")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sCronjobLastScheduleAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sCronjobLastScheduleAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sCronjobLastScheduleAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sCronjobLastScheduleAge(cfg MetricConfig) metricK8sCronjobLastScheduleAge {
+	m := metricK8sCronjobLastScheduleAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sCronjobSuspended struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.cronjob.suspended metric with initial data.
+func (m *metricK8sCronjobSuspended) init() {
+	m.data.SetName("k8s.cronjob.suspended")
+	m.data.SetDescription("Whether the cronjob is suspended (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sCronjobSuspended) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sCronjobSuspended) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sCronjobSuspended) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sCronjobSuspended(cfg MetricConfig) metricK8sCronjobSuspended {
+	m := metricK8sCronjobSuspended{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sCustomResourceCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.custom_resource.count metric with initial data.
+func (m *metricK8sCustomResourceCount) init() {
+	m.data.SetName("k8s.custom_resource.count")
+	m.data.SetDescription("Number of custom resources of a kind configured in custom_resources. Recorded without resource attributes, as a cluster wide aggregate.")
+	m.data.SetUnit("{custom_resource}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sCustomResourceCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, versionAttributeValue string, kindAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("group", groupAttributeValue)
+	dp.Attributes().PutStr("version", versionAttributeValue)
+	dp.Attributes().PutStr("kind", kindAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sCustomResourceCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sCustomResourceCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sCustomResourceCount(cfg MetricConfig) metricK8sCustomResourceCount {
+	m := metricK8sCustomResourceCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetCurrentScheduledNodes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.current_scheduled_nodes metric with initial data.
+func (m *metricK8sDaemonsetCurrentScheduledNodes) init() {
+	m.data.SetName("k8s.daemonset.current_scheduled_nodes")
+	m.data.SetDescription("Number of nodes that are running at least 1 daemon pod and are supposed to run the daemon pod")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetCurrentScheduledNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetCurrentScheduledNodes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetCurrentScheduledNodes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetCurrentScheduledNodes(cfg MetricConfig) metricK8sDaemonsetCurrentScheduledNodes {
+	m := metricK8sDaemonsetCurrentScheduledNodes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetDesiredScheduledNodes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.desired_scheduled_nodes metric with initial data.
+func (m *metricK8sDaemonsetDesiredScheduledNodes) init() {
+	m.data.SetName("k8s.daemonset.desired_scheduled_nodes")
+	m.data.SetDescription("Number of nodes that should be running the daemon pod (including nodes currently running the daemon pod)")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetDesiredScheduledNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetDesiredScheduledNodes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetDesiredScheduledNodes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetDesiredScheduledNodes(cfg MetricConfig) metricK8sDaemonsetDesiredScheduledNodes {
+	m := metricK8sDaemonsetDesiredScheduledNodes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetGenerationSkew struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.generation_skew metric with initial data.
+func (m *metricK8sDaemonsetGenerationSkew) init() {
+	m.data.SetName("k8s.daemonset.generation_skew")
+	m.data.SetDescription("Whether the daemon set controller hasn't observed the latest generation of the daemon set yet (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetGenerationSkew) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetGenerationSkew) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetGenerationSkew) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetGenerationSkew(cfg MetricConfig) metricK8sDaemonsetGenerationSkew {
+	m := metricK8sDaemonsetGenerationSkew{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetMisscheduledNodes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.misscheduled_nodes metric with initial data.
+func (m *metricK8sDaemonsetMisscheduledNodes) init() {
+	m.data.SetName("k8s.daemonset.misscheduled_nodes")
+	m.data.SetDescription("Number of nodes that are running the daemon pod, but are not supposed to run the daemon pod")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetMisscheduledNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetMisscheduledNodes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetMisscheduledNodes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetMisscheduledNodes(cfg MetricConfig) metricK8sDaemonsetMisscheduledNodes {
+	m := metricK8sDaemonsetMisscheduledNodes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetReadyNodes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.ready_nodes metric with initial data.
+func (m *metricK8sDaemonsetReadyNodes) init() {
+	m.data.SetName("k8s.daemonset.ready_nodes")
+	m.data.SetDescription("Number of nodes that should be running the daemon pod and have one or more of the daemon pod running and ready")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetReadyNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetReadyNodes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetReadyNodes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetReadyNodes(cfg MetricConfig) metricK8sDaemonsetReadyNodes {
+	m := metricK8sDaemonsetReadyNodes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetReplicasUnavailable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.replicas_unavailable metric with initial data.
+func (m *metricK8sDaemonsetReplicasUnavailable) init() {
+	m.data.SetName("k8s.daemonset.replicas_unavailable")
+	m.data.SetDescription("Number of nodes that should be running the daemon pod and don't have an available daemon pod, i.e. the desired minus the available nodes. Zero while more nodes than desired are available, e.g. during a scale down")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetReplicasUnavailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetReplicasUnavailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetReplicasUnavailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetReplicasUnavailable(cfg MetricConfig) metricK8sDaemonsetReplicasUnavailable {
+	m := metricK8sDaemonsetReplicasUnavailable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetUnavailableNodes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.unavailable_nodes metric with initial data.
+func (m *metricK8sDaemonsetUnavailableNodes) init() {
+	m.data.SetName("k8s.daemonset.unavailable_nodes")
+	m.data.SetDescription("Number of nodes that should be running the daemon pod and have none of the daemon pod running and available")
+	m.data.SetUnit("{node}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetUnavailableNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetUnavailableNodes) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetUnavailableNodes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetUnavailableNodes(cfg MetricConfig) metricK8sDaemonsetUnavailableNodes {
+	m := metricK8sDaemonsetUnavailableNodes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDaemonsetUpdatedRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.daemonset.updated_ratio metric with initial data.
+func (m *metricK8sDaemonsetUpdatedRatio) init() {
+	m.data.SetName("k8s.daemonset.updated_ratio")
+	m.data.SetDescription("Ratio of the nodes running the updated daemon pod to the nodes that should be running the daemon pod. Not sent if no node should be running the daemon pod")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDaemonsetUpdatedRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDaemonsetUpdatedRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDaemonsetUpdatedRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDaemonsetUpdatedRatio(cfg MetricConfig) metricK8sDaemonsetUpdatedRatio {
+	m := metricK8sDaemonsetUpdatedRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDeploymentAvailable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.deployment.available metric with initial data.
+func (m *metricK8sDeploymentAvailable) init() {
+	m.data.SetName("k8s.deployment.available")
+	m.data.SetDescription("Total number of available pods (ready for at least minReadySeconds) targeted by this deployment")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDeploymentAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDeploymentAvailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDeploymentAvailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDeploymentAvailable(cfg MetricConfig) metricK8sDeploymentAvailable {
+	m := metricK8sDeploymentAvailable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDeploymentCollisionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.deployment.collision_count metric with initial data.
+func (m *metricK8sDeploymentCollisionCount) init() {
+	m.data.SetName("k8s.deployment.collision_count")
+	m.data.SetDescription("Number of hash collisions for the deployment, used by the controller to create the name of the newest ReplicaSet. Only reported once set by the controller.")
+	m.data.SetUnit("{collision}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDeploymentCollisionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDeploymentCollisionCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDeploymentCollisionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDeploymentCollisionCount(cfg MetricConfig) metricK8sDeploymentCollisionCount {
+	m := metricK8sDeploymentCollisionCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDeploymentCondition struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.deployment.condition metric with initial data.
+func (m *metricK8sDeploymentCondition) init() {
+	m.data.SetName("k8s.deployment.condition")
+	m.data.SetDescription("The condition of a particular Deployment (1 - True, 0 - False, -1 - Unknown).")
+	m.data.SetUnit("{condition}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sDeploymentCondition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("condition", conditionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDeploymentCondition) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDeploymentCondition) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDeploymentCondition(cfg MetricConfig) metricK8sDeploymentCondition {
+	m := metricK8sDeploymentCondition{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDeploymentDesired struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.deployment.desired metric with initial data.
+func (m *metricK8sDeploymentDesired) init() {
+	m.data.SetName("k8s.deployment.desired")
+	m.data.SetDescription("Number of desired pods in this deployment")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDeploymentDesired) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDeploymentDesired) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDeploymentDesired) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDeploymentDesired(cfg MetricConfig) metricK8sDeploymentDesired {
+	m := metricK8sDeploymentDesired{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDeploymentGenerationSkew struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.deployment.generation_skew metric with initial data.
+func (m *metricK8sDeploymentGenerationSkew) init() {
+	m.data.SetName("k8s.deployment.generation_skew")
+	m.data.SetDescription("Whether the deployment controller hasn't observed the latest generation of the deployment yet (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDeploymentGenerationSkew) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDeploymentGenerationSkew) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDeploymentGenerationSkew) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDeploymentGenerationSkew(cfg MetricConfig) metricK8sDeploymentGenerationSkew {
+	m := metricK8sDeploymentGenerationSkew{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDeploymentPaused struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.deployment.paused metric with initial data.
+func (m *metricK8sDeploymentPaused) init() {
+	m.data.SetName("k8s.deployment.paused")
+	m.data.SetDescription("Whether the deployment is paused (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDeploymentPaused) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDeploymentPaused) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDeploymentPaused) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDeploymentPaused(cfg MetricConfig) metricK8sDeploymentPaused {
+	m := metricK8sDeploymentPaused{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sDeploymentReplicasUnavailable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.deployment.replicas_unavailable metric with initial data.
+func (m *metricK8sDeploymentReplicasUnavailable) init() {
+	m.data.SetName("k8s.deployment.replicas_unavailable")
+	m.data.SetDescription("Number of desired pods in this deployment that are not available, i.e. the desired minus the available pods. Zero while more pods than desired are available, e.g. during a scale down")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sDeploymentReplicasUnavailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sDeploymentReplicasUnavailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sDeploymentReplicasUnavailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sDeploymentReplicasUnavailable(cfg MetricConfig) metricK8sDeploymentReplicasUnavailable {
+	m := metricK8sDeploymentReplicasUnavailable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sEndpointsAddressCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.endpoints.address.count metric with initial data.
+func (m *metricK8sEndpointsAddressCount) init() {
+	m.data.SetName("k8s.endpoints.address.count")
+	m.data.SetDescription("Number of ready addresses across all subsets of the endpoints. Only reported on clusters that don't serve EndpointSlices.")
+	m.data.SetUnit("{address}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sEndpointsAddressCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sEndpointsAddressCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sEndpointsAddressCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sEndpointsAddressCount(cfg MetricConfig) metricK8sEndpointsAddressCount {
+	m := metricK8sEndpointsAddressCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sEndpointsNotReadyAddressCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.endpoints.not_ready_address.count metric with initial data.
+func (m *metricK8sEndpointsNotReadyAddressCount) init() {
+	m.data.SetName("k8s.endpoints.not_ready_address.count")
+	m.data.SetDescription("Number of not ready addresses across all subsets of the endpoints. Only reported on clusters that don't serve EndpointSlices.")
+	m.data.SetUnit("{address}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sEndpointsNotReadyAddressCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sEndpointsNotReadyAddressCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sEndpointsNotReadyAddressCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sEndpointsNotReadyAddressCount(cfg MetricConfig) metricK8sEndpointsNotReadyAddressCount {
+	m := metricK8sEndpointsNotReadyAddressCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sEndpointsliceAddressCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.endpointslice.address.count metric with initial data.
+func (m *metricK8sEndpointsliceAddressCount) init() {
+	m.data.SetName("k8s.endpointslice.address.count")
+	m.data.SetDescription("Number of addresses across all endpoints in the endpoint slice")
+	m.data.SetUnit("{address}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sEndpointsliceAddressCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sEndpointsliceAddressCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sEndpointsliceAddressCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sEndpointsliceAddressCount(cfg MetricConfig) metricK8sEndpointsliceAddressCount {
+	m := metricK8sEndpointsliceAddressCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sEndpointsliceReadyCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.endpointslice.ready.count metric with initial data.
+func (m *metricK8sEndpointsliceReadyCount) init() {
+	m.data.SetName("k8s.endpointslice.ready.count")
+	m.data.SetDescription("Number of endpoints in the endpoint slice that are ready to receive traffic")
+	m.data.SetUnit("{endpoint}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sEndpointsliceReadyCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sEndpointsliceReadyCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sEndpointsliceReadyCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sEndpointsliceReadyCount(cfg MetricConfig) metricK8sEndpointsliceReadyCount {
+	m := metricK8sEndpointsliceReadyCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaCondition struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.condition metric with initial data.
+func (m *metricK8sHpaCondition) init() {
+	m.data.SetName("k8s.hpa.condition")
+	m.data.SetDescription("The condition of a particular HorizontalPodAutoscaler (1 - True, 0 - False, -1 - Unknown).")
+	m.data.SetUnit("{condition}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sHpaCondition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("condition", conditionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaCondition) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaCondition) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaCondition(cfg MetricConfig) metricK8sHpaCondition {
+	m := metricK8sHpaCondition{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaCurrentMetricValue struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.current_metric_value metric with initial data.
+func (m *metricK8sHpaCurrentMetricValue) init() {
+	m.data.SetName("k8s.hpa.current_metric_value")
+	m.data.SetDescription("Current value of a metric tracked by this autoscaler, in the unit of its target (percent for utilization targets).")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sHpaCurrentMetricValue) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("hpa.metric.name", hpaMetricNameAttributeValue)
+	dp.Attributes().PutStr("hpa.metric.type", hpaMetricTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaCurrentMetricValue) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaCurrentMetricValue) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaCurrentMetricValue(cfg MetricConfig) metricK8sHpaCurrentMetricValue {
+	m := metricK8sHpaCurrentMetricValue{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaCurrentReplicas struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.current_replicas metric with initial data.
+func (m *metricK8sHpaCurrentReplicas) init() {
+	m.data.SetName("k8s.hpa.current_replicas")
+	m.data.SetDescription("Current number of pod replicas managed by this autoscaler.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sHpaCurrentReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaCurrentReplicas) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaCurrentReplicas) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaCurrentReplicas(cfg MetricConfig) metricK8sHpaCurrentReplicas {
+	m := metricK8sHpaCurrentReplicas{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaDesiredReplicas struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.desired_replicas metric with initial data.
+func (m *metricK8sHpaDesiredReplicas) init() {
+	m.data.SetName("k8s.hpa.desired_replicas")
+	m.data.SetDescription("Desired number of pod replicas managed by this autoscaler.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sHpaDesiredReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaDesiredReplicas) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaDesiredReplicas) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaDesiredReplicas(cfg MetricConfig) metricK8sHpaDesiredReplicas {
+	m := metricK8sHpaDesiredReplicas{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaExternalCurrentValue struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.external_current_value metric with initial data.
+func (m *metricK8sHpaExternalCurrentValue) init() {
+	m.data.SetName("k8s.hpa.external_current_value")
+	m.data.SetDescription("Current value of an external metric tracked by this autoscaler, in the unit of its target.")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sHpaExternalCurrentValue) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricSelectorAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("hpa.metric.name", hpaMetricNameAttributeValue)
+	dp.Attributes().PutStr("hpa.metric.selector", hpaMetricSelectorAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaExternalCurrentValue) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaExternalCurrentValue) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaExternalCurrentValue(cfg MetricConfig) metricK8sHpaExternalCurrentValue {
+	m := metricK8sHpaExternalCurrentValue{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaExternalTargetValue struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.external_target_value metric with initial data.
+func (m *metricK8sHpaExternalTargetValue) init() {
+	m.data.SetName("k8s.hpa.external_target_value")
+	m.data.SetDescription("Target value of an external metric tracked by this autoscaler.")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sHpaExternalTargetValue) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricSelectorAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("hpa.metric.name", hpaMetricNameAttributeValue)
+	dp.Attributes().PutStr("hpa.metric.selector", hpaMetricSelectorAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaExternalTargetValue) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaExternalTargetValue) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaExternalTargetValue(cfg MetricConfig) metricK8sHpaExternalTargetValue {
+	m := metricK8sHpaExternalTargetValue{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaMaxReplicas struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.max_replicas metric with initial data.
+func (m *metricK8sHpaMaxReplicas) init() {
+	m.data.SetName("k8s.hpa.max_replicas")
+	m.data.SetDescription("Maximum number of replicas to which the autoscaler can scale up.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sHpaMaxReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaMaxReplicas) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaMaxReplicas) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaMaxReplicas(cfg MetricConfig) metricK8sHpaMaxReplicas {
+	m := metricK8sHpaMaxReplicas{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaMinReplicas struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.min_replicas metric with initial data.
+func (m *metricK8sHpaMinReplicas) init() {
+	m.data.SetName("k8s.hpa.min_replicas")
+	m.data.SetDescription("Minimum number of replicas to which the autoscaler can scale up.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sHpaMinReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaMinReplicas) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaMinReplicas) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaMinReplicas(cfg MetricConfig) metricK8sHpaMinReplicas {
+	m := metricK8sHpaMinReplicas{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sHpaTargetMetricValue struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.hpa.target_metric_value metric with initial data.
+func (m *metricK8sHpaTargetMetricValue) init() {
+	m.data.SetName("k8s.hpa.target_metric_value")
+	m.data.SetDescription("Target value of a metric tracked by this autoscaler (percent for utilization targets).")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sHpaTargetMetricValue) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("hpa.metric.name", hpaMetricNameAttributeValue)
+	dp.Attributes().PutStr("hpa.metric.type", hpaMetricTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHpaTargetMetricValue) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHpaTargetMetricValue) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHpaTargetMetricValue(cfg MetricConfig) metricK8sHpaTargetMetricValue {
+	m := metricK8sHpaTargetMetricValue{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sIngressRuleCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.ingress.rule.count metric with initial data.
+func (m *metricK8sIngressRuleCount) init() {
+	m.data.SetName("k8s.ingress.rule.count")
+	m.data.SetDescription("Number of rules of the ingress. Ingresses with only a default backend have no rules")
+	m.data.SetUnit("{rule}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sIngressRuleCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sIngressRuleCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sIngressRuleCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sIngressRuleCount(cfg MetricConfig) metricK8sIngressRuleCount {
+	m := metricK8sIngressRuleCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sIngressTLSCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.ingress.tls.count metric with initial data.
+func (m *metricK8sIngressTLSCount) init() {
+	m.data.SetName("k8s.ingress.tls.count")
+	m.data.SetDescription("Number of TLS configurations of the ingress")
+	m.data.SetUnit("{tls}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sIngressTLSCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sIngressTLSCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sIngressTLSCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sIngressTLSCount(cfg MetricConfig) metricK8sIngressTLSCount {
+	m := metricK8sIngressTLSCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobActivePods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.active_pods metric with initial data.
+func (m *metricK8sJobActivePods) init() {
+	m.data.SetName("k8s.job.active_pods")
+	m.data.SetDescription("The number of actively running pods for a job")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobActivePods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobActivePods) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobActivePods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobActivePods(cfg MetricConfig) metricK8sJobActivePods {
+	m := metricK8sJobActivePods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobBackoffLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.backoff_limit metric with initial data.
+func (m *metricK8sJobBackoffLimit) init() {
+	m.data.SetName("k8s.job.backoff_limit")
+	m.data.SetDescription("The number of retries before the job is marked as failed")
+	m.data.SetUnit("{retry}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobBackoffLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobBackoffLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobBackoffLimit) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobBackoffLimit(cfg MetricConfig) metricK8sJobBackoffLimit {
+	m := metricK8sJobBackoffLimit{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobCompletedIndexesCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.completed_indexes_count metric with initial data.
+func (m *metricK8sJobCompletedIndexesCount) init() {
+	m.data.SetName("k8s.job.completed_indexes_count")
+	m.data.SetDescription("The number of completed indexes of an indexed job")
+	m.data.SetUnit("{index}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobCompletedIndexesCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobCompletedIndexesCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobCompletedIndexesCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobCompletedIndexesCount(cfg MetricConfig) metricK8sJobCompletedIndexesCount {
+	m := metricK8sJobCompletedIndexesCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobDesiredSuccessfulPods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.desired_successful_pods metric with initial data.
+func (m *metricK8sJobDesiredSuccessfulPods) init() {
+	m.data.SetName("k8s.job.desired_successful_pods")
+	m.data.SetDescription("The desired number of successfully finished pods the job should be run with")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobDesiredSuccessfulPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobDesiredSuccessfulPods) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobDesiredSuccessfulPods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobDesiredSuccessfulPods(cfg MetricConfig) metricK8sJobDesiredSuccessfulPods {
+	m := metricK8sJobDesiredSuccessfulPods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.duration metric with initial data.
+func (m *metricK8sJobDuration) init() {
+	m.data.SetName("k8s.job.duration")
+	m.data.SetDescription("The time taken by a completed job to run, from its start time to its completion time")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobDuration(cfg MetricConfig) metricK8sJobDuration {
+	m := metricK8sJobDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobFailedExhausted struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.failed_exhausted metric with initial data.
+func (m *metricK8sJobFailedExhausted) init() {
+	m.data.SetName("k8s.job.failed_exhausted")
+	m.data.SetDescription("Whether the job gave up after exhausting its backoff limit, active deadline or pod failure policy, i.e. it has a Failed or FailureTarget condition (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobFailedExhausted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobFailedExhausted) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobFailedExhausted) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobFailedExhausted(cfg MetricConfig) metricK8sJobFailedExhausted {
+	m := metricK8sJobFailedExhausted{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobFailedPods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.failed_pods metric with initial data.
+func (m *metricK8sJobFailedPods) init() {
+	m.data.SetName("k8s.job.failed_pods")
+	m.data.SetDescription("The number of pods which reached phase Failed for a job")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobFailedPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobFailedPods) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobFailedPods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobFailedPods(cfg MetricConfig) metricK8sJobFailedPods {
+	m := metricK8sJobFailedPods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobMaxParallelPods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.max_parallel_pods metric with initial data.
+func (m *metricK8sJobMaxParallelPods) init() {
+	m.data.SetName("k8s.job.max_parallel_pods")
+	m.data.SetDescription("The max desired number of pods the job should run at any given time")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobMaxParallelPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobMaxParallelPods) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobMaxParallelPods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobMaxParallelPods(cfg MetricConfig) metricK8sJobMaxParallelPods {
+	m := metricK8sJobMaxParallelPods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sJobSuccessfulPods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.job.successful_pods metric with initial data.
+func (m *metricK8sJobSuccessfulPods) init() {
+	m.data.SetName("k8s.job.successful_pods")
+	m.data.SetDescription("The number of pods which reached phase Succeeded for a job")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sJobSuccessfulPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sJobSuccessfulPods) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sJobSuccessfulPods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sJobSuccessfulPods(cfg MetricConfig) metricK8sJobSuccessfulPods {
+	m := metricK8sJobSuccessfulPods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sLimitrangeDefaultCPURequest struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.limitrange.default_cpu_request metric with initial data.
+func (m *metricK8sLimitrangeDefaultCPURequest) init() {
+	m.data.SetName("k8s.limitrange.default_cpu_request")
+	m.data.SetDescription("The default CPU request set on containers by the limit range")
+	m.data.SetUnit("{cpu}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sLimitrangeDefaultCPURequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, limitTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("limit.type", limitTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sLimitrangeDefaultCPURequest) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sLimitrangeDefaultCPURequest) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sLimitrangeDefaultCPURequest(cfg MetricConfig) metricK8sLimitrangeDefaultCPURequest {
+	m := metricK8sLimitrangeDefaultCPURequest{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sLimitrangeDefaultMemoryRequest struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.limitrange.default_memory_request metric with initial data.
+func (m *metricK8sLimitrangeDefaultMemoryRequest) init() {
+	m.data.SetName("k8s.limitrange.default_memory_request")
+	m.data.SetDescription("The default memory request set on containers by the limit range")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sLimitrangeDefaultMemoryRequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, limitTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("limit.type", limitTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sLimitrangeDefaultMemoryRequest) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sLimitrangeDefaultMemoryRequest) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sLimitrangeDefaultMemoryRequest(cfg MetricConfig) metricK8sLimitrangeDefaultMemoryRequest {
+	m := metricK8sLimitrangeDefaultMemoryRequest{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sLimitrangeMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.limitrange.max metric with initial data.
+func (m *metricK8sLimitrangeMax) init() {
+	m.data.SetName("k8s.limitrange.max")
+	m.data.SetDescription("The maximum usage of a particular resource allowed by the limit range. CPU will be sent as millicores")
+	m.data.SetUnit("{resource}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sLimitrangeMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, limitTypeAttributeValue string, resourceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("limit.type", limitTypeAttributeValue)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sLimitrangeMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sLimitrangeMax) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sLimitrangeMax(cfg MetricConfig) metricK8sLimitrangeMax {
+	m := metricK8sLimitrangeMax{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sLimitrangeMin struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.limitrange.min metric with initial data.
+func (m *metricK8sLimitrangeMin) init() {
+	m.data.SetName("k8s.limitrange.min")
+	m.data.SetDescription("The minimum usage of a particular resource required by the limit range. CPU will be sent as millicores")
+	m.data.SetUnit("{resource}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sLimitrangeMin) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, limitTypeAttributeValue string, resourceAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("limit.type", limitTypeAttributeValue)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sLimitrangeMin) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sLimitrangeMin) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sLimitrangeMin(cfg MetricConfig) metricK8sLimitrangeMin {
+	m := metricK8sLimitrangeMin{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNamespacePhase struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.namespace.phase metric with initial data.
+func (m *metricK8sNamespacePhase) init() {
+	m.data.SetName("k8s.namespace.phase")
+	m.data.SetDescription("The current phase of namespaces (1 for active and 0 for terminating)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNamespacePhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNamespacePhase) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNamespacePhase) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNamespacePhase(cfg MetricConfig) metricK8sNamespacePhase {
+	m := metricK8sNamespacePhase{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNamespaceSecretCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.namespace.secret.count metric with initial data.
+func (m *metricK8sNamespaceSecretCount) init() {
+	m.data.SetName("k8s.namespace.secret.count")
+	m.data.SetDescription("The number of secrets of a particular type in the namespace. Requires permissions to list and watch secrets")
+	m.data.SetUnit("{secret}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sNamespaceSecretCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, secretTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("secret.type", secretTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNamespaceSecretCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNamespaceSecretCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNamespaceSecretCount(cfg MetricConfig) metricK8sNamespaceSecretCount {
+	m := metricK8sNamespaceSecretCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNamespaceServiceaccountCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.namespace.serviceaccount.count metric with initial data.
+func (m *metricK8sNamespaceServiceaccountCount) init() {
+	m.data.SetName("k8s.namespace.serviceaccount.count")
+	m.data.SetDescription("The number of service accounts in the namespace. Requires permissions to list and watch service accounts")
+	m.data.SetUnit("{serviceaccount}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNamespaceServiceaccountCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNamespaceServiceaccountCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNamespaceServiceaccountCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNamespaceServiceaccountCount(cfg MetricConfig) metricK8sNamespaceServiceaccountCount {
+	m := metricK8sNamespaceServiceaccountCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodeAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.age metric with initial data.
+func (m *metricK8sNodeAge) init() {
+	m.data.SetName("k8s.node.age")
+	m.data.SetDescription("Time elapsed since the creation of the node, as of the collection")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodeAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeAge(cfg MetricConfig) metricK8sNodeAge {
+	m := metricK8sNodeAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodeCondition struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.condition metric with initial data.
+func (m *metricK8sNodeCondition) init() {
+	m.data.SetName("k8s.node.condition")
+	m.data.SetDescription("The condition of a particular Node (1 - True, 0 - False, -1 - Unknown).")
+	m.data.SetUnit("{condition}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sNodeCondition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("condition", conditionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeCondition) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeCondition) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeCondition(cfg MetricConfig) metricK8sNodeCondition {
+	m := metricK8sNodeCondition{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodeEvictionRisk struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.eviction_risk metric with initial data.
+func (m *metricK8sNodeEvictionRisk) init() {
+	m.data.SetName("k8s.node.eviction_risk")
+	m.data.SetDescription("Whether the node is under memory, disk or PID pressure and may evict pods, i.e. any of the MemoryPressure, DiskPressure or PIDPressure conditions is True (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodeEvictionRisk) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeEvictionRisk) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeEvictionRisk) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeEvictionRisk(cfg MetricConfig) metricK8sNodeEvictionRisk {
+	m := metricK8sNodeEvictionRisk{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodeLeaseRenewAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.lease_renew_age metric with initial data.
+func (m *metricK8sNodeLeaseRenewAge) init() {
+	m.data.SetName("k8s.node.lease_renew_age")
+	m.data.SetDescription("The time since the kubelet last renewed the node lease in the kube-node-lease namespace. Enabling it requires permissions to watch leases.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodeLeaseRenewAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeLeaseRenewAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeLeaseRenewAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeLeaseRenewAge(cfg MetricConfig) metricK8sNodeLeaseRenewAge {
+	m := metricK8sNodeLeaseRenewAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodePodCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.pod_count metric with initial data.
+func (m *metricK8sNodePodCount) init() {
+	m.data.SetName("k8s.node.pod_count")
+	m.data.SetDescription("The number of pods scheduled to the node, from the pods whose node name matches the node")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodePodCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodePodCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodePodCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodePodCount(cfg MetricConfig) metricK8sNodePodCount {
+	m := metricK8sNodePodCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodeTaintCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.taint.count metric with initial data.
+func (m *metricK8sNodeTaintCount) init() {
+	m.data.SetName("k8s.node.taint.count")
+	m.data.SetDescription("The number of taints set on the node.")
+	m.data.SetUnit("{taint}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodeTaintCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeTaintCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeTaintCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeTaintCount(cfg MetricConfig) metricK8sNodeTaintCount {
+	m := metricK8sNodeTaintCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodeUnschedulable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.unschedulable metric with initial data.
+func (m *metricK8sNodeUnschedulable) init() {
+	m.data.SetName("k8s.node.unschedulable")
+	m.data.SetDescription("Whether the node is marked as unschedulable, e.g. when it's cordoned (0 for no, 1 for yes)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodeUnschedulable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeUnschedulable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeUnschedulable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeUnschedulable(cfg MetricConfig) metricK8sNodeUnschedulable {
+	m := metricK8sNodeUnschedulable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPdbCurrentHealthy struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pdb.current_healthy metric with initial data.
+func (m *metricK8sPdbCurrentHealthy) init() {
+	m.data.SetName("k8s.pdb.current_healthy")
+	m.data.SetDescription("Current number of healthy pods selected by this pod disruption budget.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPdbCurrentHealthy) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPdbCurrentHealthy) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPdbCurrentHealthy) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPdbCurrentHealthy(cfg MetricConfig) metricK8sPdbCurrentHealthy {
+	m := metricK8sPdbCurrentHealthy{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPdbDesiredHealthy struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pdb.desired_healthy metric with initial data.
+func (m *metricK8sPdbDesiredHealthy) init() {
+	m.data.SetName("k8s.pdb.desired_healthy")
+	m.data.SetDescription("Minimum desired number of healthy pods selected by this pod disruption budget.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPdbDesiredHealthy) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPdbDesiredHealthy) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPdbDesiredHealthy) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPdbDesiredHealthy(cfg MetricConfig) metricK8sPdbDesiredHealthy {
+	m := metricK8sPdbDesiredHealthy{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPdbDisruptionsAllowed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pdb.disruptions_allowed metric with initial data.
+func (m *metricK8sPdbDisruptionsAllowed) init() {
+	m.data.SetName("k8s.pdb.disruptions_allowed")
+	m.data.SetDescription("Number of pod disruptions that are currently allowed by this pod disruption budget.")
+	m.data.SetUnit("{disruption}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPdbDisruptionsAllowed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPdbDisruptionsAllowed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPdbDisruptionsAllowed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPdbDisruptionsAllowed(cfg MetricConfig) metricK8sPdbDisruptionsAllowed {
+	m := metricK8sPdbDisruptionsAllowed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPdbExpectedPods struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pdb.expected_pods metric with initial data.
+func (m *metricK8sPdbExpectedPods) init() {
+	m.data.SetName("k8s.pdb.expected_pods")
+	m.data.SetDescription("Total number of pods counted by this pod disruption budget.")
+	m.data.SetUnit("{pod}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPdbExpectedPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPdbExpectedPods) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPdbExpectedPods) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPdbExpectedPods(cfg MetricConfig) metricK8sPdbExpectedPods {
+	m := metricK8sPdbExpectedPods{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolume.capacity metric with initial data.
+func (m *metricK8sPersistentvolumeCapacity) init() {
+	m.data.SetName("k8s.persistentvolume.capacity")
+	m.data.SetDescription("The storage capacity of the persistent volume (the `spec.capacity.storage` field)")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeCapacity(cfg MetricConfig) metricK8sPersistentvolumeCapacity {
+	m := metricK8sPersistentvolumeCapacity{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumePhase struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolume.phase metric with initial data.
+func (m *metricK8sPersistentvolumePhase) init() {
+	m.data.SetName("k8s.persistentvolume.phase")
+	m.data.SetDescription("Current phase of the persistent volume (1 - Pending, 2 - Available, 3 - Bound, 4 - Released, 5 - Failed)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumePhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumePhase) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumePhase) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumePhase(cfg MetricConfig) metricK8sPersistentvolumePhase {
+	m := metricK8sPersistentvolumePhase{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimActualCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.actual_capacity metric with initial data.
+func (m *metricK8sPersistentvolumeclaimActualCapacity) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.actual_capacity")
+	m.data.SetDescription("The actual storage capacity of the volume bound to the persistent volume claim (the `status.capacity.storage` field), which may exceed the requested storage once the volume is expanded. Not sent before the claim is bound")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimActualCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimActualCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimActualCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimActualCapacity(cfg MetricConfig) metricK8sPersistentvolumeclaimActualCapacity {
+	m := metricK8sPersistentvolumeclaimActualCapacity{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimPhase struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.phase metric with initial data.
+func (m *metricK8sPersistentvolumeclaimPhase) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.phase")
+	m.data.SetDescription("Current phase of the persistent volume claim (1 - Pending, 2 - Bound, 3 - Lost)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimPhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimPhase) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimPhase) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimPhase(cfg MetricConfig) metricK8sPersistentvolumeclaimPhase {
+	m := metricK8sPersistentvolumeclaimPhase{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimRequestedStorage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.requested_storage metric with initial data.
+func (m *metricK8sPersistentvolumeclaimRequestedStorage) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.requested_storage")
+	m.data.SetDescription("The amount of storage requested by the persistent volume claim (the `spec.resources.requests.storage` field)")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimRequestedStorage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimRequestedStorage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimRequestedStorage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimRequestedStorage(cfg MetricConfig) metricK8sPersistentvolumeclaimRequestedStorage {
+	m := metricK8sPersistentvolumeclaimRequestedStorage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPodAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.age metric with initial data.
+func (m *metricK8sPodAge) init() {
+	m.data.SetName("k8s.pod.age")
+	m.data.SetDescription("Time elapsed since the creation of the pod, as of the collection")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodAge(cfg MetricConfig) metricK8sPodAge {
+	m := metricK8sPodAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPodCondition struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.condition metric with initial data.
+func (m *metricK8sPodCondition) init() {
+	m.data.SetName("k8s.pod.condition")
+	m.data.SetDescription("The condition of a particular Pod (1 - True, 0 - False, -1 - Unknown). Only conditions present in the pod status are reported.")
+	m.data.SetUnit("{condition}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sPodCondition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("condition", conditionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodCondition) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodCondition) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodCondition(cfg MetricConfig) metricK8sPodCondition {
+	m := metricK8sPodCondition{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPodOrphaned struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.orphaned metric with initial data.
+func (m *metricK8sPodOrphaned) init() {
+	m.data.SetName("k8s.pod.orphaned")
+	m.data.SetDescription("Whether the pod has no controller owner (0 for no, 1 for yes). Not reported for mirror pods of static pods.")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodOrphaned) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodOrphaned) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodOrphaned) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodOrphaned(cfg MetricConfig) metricK8sPodOrphaned {
+	m := metricK8sPodOrphaned{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -404,21 +4364,21 @@ func newMetricK8sContainerRestarts(cfg MetricConfig) metricK8sContainerRestarts
 	return m
 }
 
-type metricK8sContainerStorageLimit struct {
+type metricK8sPodPhase struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.container.storage_limit metric with initial data.
-func (m *metricK8sContainerStorageLimit) init() {
-	m.data.SetName("k8s.container.storage_limit")
-	m.data.SetDescription("Maximum resource limit set for the container. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.23/#resourcerequirements-v1-core for details")
-	m.data.SetUnit("By")
+// init fills k8s.pod.phase metric with initial data.
+func (m *metricK8sPodPhase) init() {
+	m.data.SetName("k8s.pod.phase")
+	m.data.SetDescription("Current phase of the pod (1 - Pending, 2 - Running, 3 - Succeeded, 4 - Failed, 5 - Unknown)")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sContainerStorageLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sPodPhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -429,14 +4389,14 @@ func (m *metricK8sContainerStorageLimit) recordDataPoint(start pcommon.Timestamp
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sContainerStorageLimit) updateCapacity() {
+func (m *metricK8sPodPhase) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sContainerStorageLimit) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sPodPhase) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -444,8 +4404,8 @@ func (m *metricK8sContainerStorageLimit) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sContainerStorageLimit(cfg MetricConfig) metricK8sContainerStorageLimit {
-	m := metricK8sContainerStorageLimit{config: cfg}
+func newMetricK8sPodPhase(cfg MetricConfig) metricK8sPodPhase {
+	m := metricK8sPodPhase{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -453,21 +4413,21 @@ func newMetricK8sContainerStorageLimit(cfg MetricConfig) metricK8sContainerStora
 	return m
 }
 
-type metricK8sContainerStorageRequest struct {
+type metricK8sPodPriority struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.container.storage_request metric with initial data.
-func (m *metricK8sContainerStorageRequest) init() {
-	m.data.SetName("k8s.container.storage_request")
-	m.data.SetDescription("Resource requested for the container. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.23/#resourcerequirements-v1-core for details")
-	m.data.SetUnit("By")
+// init fills k8s.pod.priority metric with initial data.
+func (m *metricK8sPodPriority) init() {
+	m.data.SetName("k8s.pod.priority")
+	m.data.SetDescription("The priority of the pod, resolved from its priority class on admission. Not reported for pods without a resolved priority")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sContainerStorageRequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sPodPriority) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -478,14 +4438,14 @@ func (m *metricK8sContainerStorageRequest) recordDataPoint(start pcommon.Timesta
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sContainerStorageRequest) updateCapacity() {
+func (m *metricK8sPodPriority) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sContainerStorageRequest) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sPodPriority) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -493,8 +4453,8 @@ func (m *metricK8sContainerStorageRequest) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sContainerStorageRequest(cfg MetricConfig) metricK8sContainerStorageRequest {
-	m := metricK8sContainerStorageRequest{config: cfg}
+func newMetricK8sPodPriority(cfg MetricConfig) metricK8sPodPriority {
+	m := metricK8sPodPriority{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -502,39 +4462,39 @@ func newMetricK8sContainerStorageRequest(cfg MetricConfig) metricK8sContainerSto
 	return m
 }
 
-type metricK8sCronjobActiveJobs struct {
+type metricK8sPodSchedulingLatency struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.cronjob.active_jobs metric with initial data.
-func (m *metricK8sCronjobActiveJobs) init() {
-	m.data.SetName("k8s.cronjob.active_jobs")
-	m.data.SetDescription("The number of actively running jobs for a cronjob")
-	m.data.SetUnit("{job}")
+// init fills k8s.pod.scheduling_latency metric with initial data.
+func (m *metricK8sPodSchedulingLatency) init() {
+	m.data.SetName("k8s.pod.scheduling_latency")
+	m.data.SetDescription("Time between the creation of the pod and it being scheduled to a node. Only reported for pods that are scheduled and started.")
+	m.data.SetUnit("s")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sCronjobActiveJobs) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sPodSchedulingLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetIntValue(val)
+	dp.SetDoubleValue(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sCronjobActiveJobs) updateCapacity() {
+func (m *metricK8sPodSchedulingLatency) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sCronjobActiveJobs) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sPodSchedulingLatency) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -542,8 +4502,8 @@ func (m *metricK8sCronjobActiveJobs) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sCronjobActiveJobs(cfg MetricConfig) metricK8sCronjobActiveJobs {
-	m := metricK8sCronjobActiveJobs{config: cfg}
+func newMetricK8sPodSchedulingLatency(cfg MetricConfig) metricK8sPodSchedulingLatency {
+	m := metricK8sPodSchedulingLatency{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -551,21 +4511,21 @@ func newMetricK8sCronjobActiveJobs(cfg MetricConfig) metricK8sCronjobActiveJobs
 	return m
 }
 
-type metricK8sDaemonsetCurrentScheduledNodes struct {
+type metricK8sPodStatusReason struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.daemonset.current_scheduled_nodes metric with initial data.
-func (m *metricK8sDaemonsetCurrentScheduledNodes) init() {
-	m.data.SetName("k8s.daemonset.current_scheduled_nodes")
-	m.data.SetDescription("Number of nodes that are running at least 1 daemon pod and are supposed to run the daemon pod")
-	m.data.SetUnit("{node}")
+// init fills k8s.pod.status_reason metric with initial data.
+func (m *metricK8sPodStatusReason) init() {
+	m.data.SetName("k8s.pod.status_reason")
+	m.data.SetDescription("Current status reason of the pod (1 - Evicted, 2 - NodeAffinity, 3 - NodeLost, 4 - Shutdown, 5 - UnexpectedAdmissionError, 6 - Unknown)")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sDaemonsetCurrentScheduledNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sPodStatusReason) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -576,14 +4536,14 @@ func (m *metricK8sDaemonsetCurrentScheduledNodes) recordDataPoint(start pcommon.
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sDaemonsetCurrentScheduledNodes) updateCapacity() {
+func (m *metricK8sPodStatusReason) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sDaemonsetCurrentScheduledNodes) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sPodStatusReason) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -591,8 +4551,8 @@ func (m *metricK8sDaemonsetCurrentScheduledNodes) emit(metrics pmetric.MetricSli
 	}
 }
 
-func newMetricK8sDaemonsetCurrentScheduledNodes(cfg MetricConfig) metricK8sDaemonsetCurrentScheduledNodes {
-	m := metricK8sDaemonsetCurrentScheduledNodes{config: cfg}
+func newMetricK8sPodStatusReason(cfg MetricConfig) metricK8sPodStatusReason {
+	m := metricK8sPodStatusReason{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -600,21 +4560,22 @@ func newMetricK8sDaemonsetCurrentScheduledNodes(cfg MetricConfig) metricK8sDaemo
 	return m
 }
 
-type metricK8sDaemonsetDesiredScheduledNodes struct {
+type metricK8sPodUnschedulable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.daemonset.desired_scheduled_nodes metric with initial data.
-func (m *metricK8sDaemonsetDesiredScheduledNodes) init() {
-	m.data.SetName("k8s.daemonset.desired_scheduled_nodes")
-	m.data.SetDescription("Number of nodes that should be running the daemon pod (including nodes currently running the daemon pod)")
-	m.data.SetUnit("{node}")
+// init fills k8s.pod.unschedulable metric with initial data.
+func (m *metricK8sPodUnschedulable) init() {
+	m.data.SetName("k8s.pod.unschedulable")
+	m.data.SetDescription("Whether the pod is pending because it can't be scheduled (1 for yes). Only reported for pending pods with a false PodScheduled condition.")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sDaemonsetDesiredScheduledNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sPodUnschedulable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, reasonAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -622,17 +4583,18 @@ func (m *metricK8sDaemonsetDesiredScheduledNodes) recordDataPoint(start pcommon.
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("reason", reasonAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sDaemonsetDesiredScheduledNodes) updateCapacity() {
+func (m *metricK8sPodUnschedulable) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sDaemonsetDesiredScheduledNodes) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sPodUnschedulable) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -640,8 +4602,8 @@ func (m *metricK8sDaemonsetDesiredScheduledNodes) emit(metrics pmetric.MetricSli
 	}
 }
 
-func newMetricK8sDaemonsetDesiredScheduledNodes(cfg MetricConfig) metricK8sDaemonsetDesiredScheduledNodes {
-	m := metricK8sDaemonsetDesiredScheduledNodes{config: cfg}
+func newMetricK8sPodUnschedulable(cfg MetricConfig) metricK8sPodUnschedulable {
+	m := metricK8sPodUnschedulable{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -649,21 +4611,21 @@ func newMetricK8sDaemonsetDesiredScheduledNodes(cfg MetricConfig) metricK8sDaemo
 	return m
 }
 
-type metricK8sDaemonsetMisscheduledNodes struct {
+type metricK8sPriorityclassGlobalDefault struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.daemonset.misscheduled_nodes metric with initial data.
-func (m *metricK8sDaemonsetMisscheduledNodes) init() {
-	m.data.SetName("k8s.daemonset.misscheduled_nodes")
-	m.data.SetDescription("Number of nodes that are running the daemon pod, but are not supposed to run the daemon pod")
-	m.data.SetUnit("{node}")
+// init fills k8s.priorityclass.global_default metric with initial data.
+func (m *metricK8sPriorityclassGlobalDefault) init() {
+	m.data.SetName("k8s.priorityclass.global_default")
+	m.data.SetDescription("Whether the priority class is the default for pods without a priority class (0 for no, 1 for yes)")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sDaemonsetMisscheduledNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sPriorityclassGlobalDefault) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -674,14 +4636,14 @@ func (m *metricK8sDaemonsetMisscheduledNodes) recordDataPoint(start pcommon.Time
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sDaemonsetMisscheduledNodes) updateCapacity() {
+func (m *metricK8sPriorityclassGlobalDefault) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sDaemonsetMisscheduledNodes) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sPriorityclassGlobalDefault) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -689,8 +4651,8 @@ func (m *metricK8sDaemonsetMisscheduledNodes) emit(metrics pmetric.MetricSlice)
 	}
 }
 
-func newMetricK8sDaemonsetMisscheduledNodes(cfg MetricConfig) metricK8sDaemonsetMisscheduledNodes {
-	m := metricK8sDaemonsetMisscheduledNodes{config: cfg}
+func newMetricK8sPriorityclassGlobalDefault(cfg MetricConfig) metricK8sPriorityclassGlobalDefault {
+	m := metricK8sPriorityclassGlobalDefault{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -698,21 +4660,21 @@ func newMetricK8sDaemonsetMisscheduledNodes(cfg MetricConfig) metricK8sDaemonset
 	return m
 }
 
-type metricK8sDaemonsetReadyNodes struct {
+type metricK8sPriorityclassValue struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.daemonset.ready_nodes metric with initial data.
-func (m *metricK8sDaemonsetReadyNodes) init() {
-	m.data.SetName("k8s.daemonset.ready_nodes")
-	m.data.SetDescription("Number of nodes that should be running the daemon pod and have one or more of the daemon pod running and ready")
-	m.data.SetUnit("{node}")
+// init fills k8s.priorityclass.value metric with initial data.
+func (m *metricK8sPriorityclassValue) init() {
+	m.data.SetName("k8s.priorityclass.value")
+	m.data.SetDescription("The priority value of the priority class, which pods using the class receive")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sDaemonsetReadyNodes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sPriorityclassValue) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -723,14 +4685,14 @@ func (m *metricK8sDaemonsetReadyNodes) recordDataPoint(start pcommon.Timestamp,
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sDaemonsetReadyNodes) updateCapacity() {
+func (m *metricK8sPriorityclassValue) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sDaemonsetReadyNodes) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sPriorityclassValue) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -738,8 +4700,8 @@ func (m *metricK8sDaemonsetReadyNodes) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sDaemonsetReadyNodes(cfg MetricConfig) metricK8sDaemonsetReadyNodes {
-	m := metricK8sDaemonsetReadyNodes{config: cfg}
+func newMetricK8sPriorityclassValue(cfg MetricConfig) metricK8sPriorityclassValue {
+	m := metricK8sPriorityclassValue{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -747,21 +4709,21 @@ func newMetricK8sDaemonsetReadyNodes(cfg MetricConfig) metricK8sDaemonsetReadyNo
 	return m
 }
 
-type metricK8sDeploymentAvailable struct {
+type metricK8sReplicasetAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.deployment.available metric with initial data.
-func (m *metricK8sDeploymentAvailable) init() {
-	m.data.SetName("k8s.deployment.available")
-	m.data.SetDescription("Total number of available pods (ready for at least minReadySeconds) targeted by this deployment")
+// init fills k8s.replicaset.available metric with initial data.
+func (m *metricK8sReplicasetAvailable) init() {
+	m.data.SetName("k8s.replicaset.available")
+	m.data.SetDescription("Total number of available pods (ready for at least minReadySeconds) targeted by this replicaset")
 	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sDeploymentAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sReplicasetAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -772,14 +4734,14 @@ func (m *metricK8sDeploymentAvailable) recordDataPoint(start pcommon.Timestamp,
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sDeploymentAvailable) updateCapacity() {
+func (m *metricK8sReplicasetAvailable) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sDeploymentAvailable) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sReplicasetAvailable) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -787,8 +4749,8 @@ func (m *metricK8sDeploymentAvailable) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sDeploymentAvailable(cfg MetricConfig) metricK8sDeploymentAvailable {
-	m := metricK8sDeploymentAvailable{config: cfg}
+func newMetricK8sReplicasetAvailable(cfg MetricConfig) metricK8sReplicasetAvailable {
+	m := metricK8sReplicasetAvailable{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -796,21 +4758,21 @@ func newMetricK8sDeploymentAvailable(cfg MetricConfig) metricK8sDeploymentAvaila
 	return m
 }
 
-type metricK8sDeploymentDesired struct {
+type metricK8sReplicasetDesired struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.deployment.desired metric with initial data.
-func (m *metricK8sDeploymentDesired) init() {
-	m.data.SetName("k8s.deployment.desired")
-	m.data.SetDescription("Number of desired pods in this deployment")
+// init fills k8s.replicaset.desired metric with initial data.
+func (m *metricK8sReplicasetDesired) init() {
+	m.data.SetName("k8s.replicaset.desired")
+	m.data.SetDescription("Number of desired pods in this replicaset")
 	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sDeploymentDesired) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sReplicasetDesired) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -821,14 +4783,14 @@ func (m *metricK8sDeploymentDesired) recordDataPoint(start pcommon.Timestamp, ts
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sDeploymentDesired) updateCapacity() {
+func (m *metricK8sReplicasetDesired) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sDeploymentDesired) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sReplicasetDesired) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -836,8 +4798,8 @@ func (m *metricK8sDeploymentDesired) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sDeploymentDesired(cfg MetricConfig) metricK8sDeploymentDesired {
-	m := metricK8sDeploymentDesired{config: cfg}
+func newMetricK8sReplicasetDesired(cfg MetricConfig) metricK8sReplicasetDesired {
+	m := metricK8sReplicasetDesired{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -845,21 +4807,21 @@ func newMetricK8sDeploymentDesired(cfg MetricConfig) metricK8sDeploymentDesired
 	return m
 }
 
-type metricK8sHpaCurrentReplicas struct {
+type metricK8sReplicasetFullyLabeledReplicas struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.hpa.current_replicas metric with initial data.
-func (m *metricK8sHpaCurrentReplicas) init() {
-	m.data.SetName("k8s.hpa.current_replicas")
-	m.data.SetDescription("Current number of pod replicas managed by this autoscaler.")
+// init fills k8s.replicaset.fully_labeled_replicas metric with initial data.
+func (m *metricK8sReplicasetFullyLabeledReplicas) init() {
+	m.data.SetName("k8s.replicaset.fully_labeled_replicas")
+	m.data.SetDescription("Number of pods of this replicaset whose labels match the labels of its pod template. Fewer than the pods of the replicaset when adopted pods are mislabeled")
 	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sHpaCurrentReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sReplicasetFullyLabeledReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -870,14 +4832,14 @@ func (m *metricK8sHpaCurrentReplicas) recordDataPoint(start pcommon.Timestamp, t
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sHpaCurrentReplicas) updateCapacity() {
+func (m *metricK8sReplicasetFullyLabeledReplicas) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sHpaCurrentReplicas) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sReplicasetFullyLabeledReplicas) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -885,8 +4847,8 @@ func (m *metricK8sHpaCurrentReplicas) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sHpaCurrentReplicas(cfg MetricConfig) metricK8sHpaCurrentReplicas {
-	m := metricK8sHpaCurrentReplicas{config: cfg}
+func newMetricK8sReplicasetFullyLabeledReplicas(cfg MetricConfig) metricK8sReplicasetFullyLabeledReplicas {
+	m := metricK8sReplicasetFullyLabeledReplicas{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -894,21 +4856,21 @@ func newMetricK8sHpaCurrentReplicas(cfg MetricConfig) metricK8sHpaCurrentReplica
 	return m
 }
 
-type metricK8sHpaDesiredReplicas struct {
+type metricK8sReplicationControllerAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.hpa.desired_replicas metric with initial data.
-func (m *metricK8sHpaDesiredReplicas) init() {
-	m.data.SetName("k8s.hpa.desired_replicas")
-	m.data.SetDescription("Desired number of pod replicas managed by this autoscaler.")
+// init fills k8s.replication_controller.available metric with initial data.
+func (m *metricK8sReplicationControllerAvailable) init() {
+	m.data.SetName("k8s.replication_controller.available")
+	m.data.SetDescription("Total number of available pods (ready for at least minReadySeconds) targeted by this replication_controller")
 	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sHpaDesiredReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sReplicationControllerAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -919,14 +4881,14 @@ func (m *metricK8sHpaDesiredReplicas) recordDataPoint(start pcommon.Timestamp, t
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sHpaDesiredReplicas) updateCapacity() {
+func (m *metricK8sReplicationControllerAvailable) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sHpaDesiredReplicas) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sReplicationControllerAvailable) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -934,8 +4896,8 @@ func (m *metricK8sHpaDesiredReplicas) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sHpaDesiredReplicas(cfg MetricConfig) metricK8sHpaDesiredReplicas {
-	m := metricK8sHpaDesiredReplicas{config: cfg}
+func newMetricK8sReplicationControllerAvailable(cfg MetricConfig) metricK8sReplicationControllerAvailable {
+	m := metricK8sReplicationControllerAvailable{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -943,21 +4905,21 @@ func newMetricK8sHpaDesiredReplicas(cfg MetricConfig) metricK8sHpaDesiredReplica
 	return m
 }
 
-type metricK8sHpaMaxReplicas struct {
+type metricK8sReplicationControllerDesired struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.hpa.max_replicas metric with initial data.
-func (m *metricK8sHpaMaxReplicas) init() {
-	m.data.SetName("k8s.hpa.max_replicas")
-	m.data.SetDescription("Maximum number of replicas to which the autoscaler can scale up.")
+// init fills k8s.replication_controller.desired metric with initial data.
+func (m *metricK8sReplicationControllerDesired) init() {
+	m.data.SetName("k8s.replication_controller.desired")
+	m.data.SetDescription("Number of desired pods in this replication_controller")
 	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sHpaMaxReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sReplicationControllerDesired) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -968,14 +4930,14 @@ func (m *metricK8sHpaMaxReplicas) recordDataPoint(start pcommon.Timestamp, ts pc
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sHpaMaxReplicas) updateCapacity() {
+func (m *metricK8sReplicationControllerDesired) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sHpaMaxReplicas) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sReplicationControllerDesired) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -983,8 +4945,8 @@ func (m *metricK8sHpaMaxReplicas) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sHpaMaxReplicas(cfg MetricConfig) metricK8sHpaMaxReplicas {
-	m := metricK8sHpaMaxReplicas{config: cfg}
+func newMetricK8sReplicationControllerDesired(cfg MetricConfig) metricK8sReplicationControllerDesired {
+	m := metricK8sReplicationControllerDesired{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -992,21 +4954,22 @@ func newMetricK8sHpaMaxReplicas(cfg MetricConfig) metricK8sHpaMaxReplicas {
 	return m
 }
 
-type metricK8sHpaMinReplicas struct {
+type metricK8sResourceQuotaHardLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.hpa.min_replicas metric with initial data.
-func (m *metricK8sHpaMinReplicas) init() {
-	m.data.SetName("k8s.hpa.min_replicas")
-	m.data.SetDescription("Minimum number of replicas to which the autoscaler can scale up.")
-	m.data.SetUnit("{pod}")
+// init fills k8s.resource_quota.hard_limit metric with initial data.
+func (m *metricK8sResourceQuotaHardLimit) init() {
+	m.data.SetName("k8s.resource_quota.hard_limit")
+	m.data.SetDescription("The upper limit for a particular resource in a specific namespace. Will only be sent if a quota is specified. CPU requests/limits will be sent as millicores")
+	m.data.SetUnit("{resource}")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sHpaMinReplicas) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sResourceQuotaHardLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1014,17 +4977,18 @@ func (m *metricK8sHpaMinReplicas) recordDataPoint(start pcommon.Timestamp, ts pc
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sHpaMinReplicas) updateCapacity() {
+func (m *metricK8sResourceQuotaHardLimit) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sHpaMinReplicas) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sResourceQuotaHardLimit) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1032,8 +4996,8 @@ func (m *metricK8sHpaMinReplicas) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sHpaMinReplicas(cfg MetricConfig) metricK8sHpaMinReplicas {
-	m := metricK8sHpaMinReplicas{config: cfg}
+func newMetricK8sResourceQuotaHardLimit(cfg MetricConfig) metricK8sResourceQuotaHardLimit {
+	m := metricK8sResourceQuotaHardLimit{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1041,21 +5005,22 @@ func newMetricK8sHpaMinReplicas(cfg MetricConfig) metricK8sHpaMinReplicas {
 	return m
 }
 
-type metricK8sJobActivePods struct {
+type metricK8sResourceQuotaUsed struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.job.active_pods metric with initial data.
-func (m *metricK8sJobActivePods) init() {
-	m.data.SetName("k8s.job.active_pods")
-	m.data.SetDescription("The number of actively running pods for a job")
-	m.data.SetUnit("{pod}")
+// init fills k8s.resource_quota.used metric with initial data.
+func (m *metricK8sResourceQuotaUsed) init() {
+	m.data.SetName("k8s.resource_quota.used")
+	m.data.SetDescription("The usage for a particular resource in a specific namespace. Will only be sent if a quota is specified. CPU requests/limits will be sent as millicores")
+	m.data.SetUnit("{resource}")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sJobActivePods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sResourceQuotaUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1063,17 +5028,18 @@ func (m *metricK8sJobActivePods) recordDataPoint(start pcommon.Timestamp, ts pco
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sJobActivePods) updateCapacity() {
+func (m *metricK8sResourceQuotaUsed) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sJobActivePods) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sResourceQuotaUsed) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1081,8 +5047,8 @@ func (m *metricK8sJobActivePods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sJobActivePods(cfg MetricConfig) metricK8sJobActivePods {
-	m := metricK8sJobActivePods{config: cfg}
+func newMetricK8sResourceQuotaUsed(cfg MetricConfig) metricK8sResourceQuotaUsed {
+	m := metricK8sResourceQuotaUsed{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1090,39 +5056,41 @@ func newMetricK8sJobActivePods(cfg MetricConfig) metricK8sJobActivePods {
 	return m
 }
 
-type metricK8sJobDesiredSuccessfulPods struct {
+type metricK8sResourceQuotaUtilization struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.job.desired_successful_pods metric with initial data.
-func (m *metricK8sJobDesiredSuccessfulPods) init() {
-	m.data.SetName("k8s.job.desired_successful_pods")
-	m.data.SetDescription("The desired number of successfully finished pods the job should be run with")
-	m.data.SetUnit("{pod}")
+// init fills k8s.resource_quota.utilization metric with initial data.
+func (m *metricK8sResourceQuotaUtilization) init() {
+	m.data.SetName("k8s.resource_quota.utilization")
+	m.data.SetDescription("The ratio of the usage to the upper limit for a particular resource in a specific namespace. Will not be sent for resources with a zero limit.")
+	m.data.SetUnit("1")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sJobDesiredSuccessfulPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sResourceQuotaUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, resourceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetIntValue(val)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sJobDesiredSuccessfulPods) updateCapacity() {
+func (m *metricK8sResourceQuotaUtilization) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sJobDesiredSuccessfulPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sResourceQuotaUtilization) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1130,8 +5098,8 @@ func (m *metricK8sJobDesiredSuccessfulPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sJobDesiredSuccessfulPods(cfg MetricConfig) metricK8sJobDesiredSuccessfulPods {
-	m := metricK8sJobDesiredSuccessfulPods{config: cfg}
+func newMetricK8sResourceQuotaUtilization(cfg MetricConfig) metricK8sResourceQuotaUtilization {
+	m := metricK8sResourceQuotaUtilization{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1139,21 +5107,21 @@ func newMetricK8sJobDesiredSuccessfulPods(cfg MetricConfig) metricK8sJobDesiredS
 	return m
 }
 
-type metricK8sJobFailedPods struct {
+type metricK8sServicePortCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.job.failed_pods metric with initial data.
-func (m *metricK8sJobFailedPods) init() {
-	m.data.SetName("k8s.job.failed_pods")
-	m.data.SetDescription("The number of pods which reached phase Failed for a job")
-	m.data.SetUnit("{pod}")
+// init fills k8s.service.port.count metric with initial data.
+func (m *metricK8sServicePortCount) init() {
+	m.data.SetName("k8s.service.port.count")
+	m.data.SetDescription("Number of ports exposed by the service")
+	m.data.SetUnit("{port}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sJobFailedPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sServicePortCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1164,14 +5132,14 @@ func (m *metricK8sJobFailedPods) recordDataPoint(start pcommon.Timestamp, ts pco
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sJobFailedPods) updateCapacity() {
+func (m *metricK8sServicePortCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sJobFailedPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sServicePortCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1179,8 +5147,8 @@ func (m *metricK8sJobFailedPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sJobFailedPods(cfg MetricConfig) metricK8sJobFailedPods {
-	m := metricK8sJobFailedPods{config: cfg}
+func newMetricK8sServicePortCount(cfg MetricConfig) metricK8sServicePortCount {
+	m := metricK8sServicePortCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1188,21 +5156,21 @@ func newMetricK8sJobFailedPods(cfg MetricConfig) metricK8sJobFailedPods {
 	return m
 }
 
-type metricK8sJobMaxParallelPods struct {
+type metricK8sServiceType struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.job.max_parallel_pods metric with initial data.
-func (m *metricK8sJobMaxParallelPods) init() {
-	m.data.SetName("k8s.job.max_parallel_pods")
-	m.data.SetDescription("The max desired number of pods the job should run at any given time")
-	m.data.SetUnit("{pod}")
+// init fills k8s.service.type metric with initial data.
+func (m *metricK8sServiceType) init() {
+	m.data.SetName("k8s.service.type")
+	m.data.SetDescription("Type of the service (1 - ClusterIP, 2 - NodePort, 3 - LoadBalancer, 4 - ExternalName)")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sJobMaxParallelPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sServiceType) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1213,14 +5181,14 @@ func (m *metricK8sJobMaxParallelPods) recordDataPoint(start pcommon.Timestamp, t
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sJobMaxParallelPods) updateCapacity() {
+func (m *metricK8sServiceType) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sJobMaxParallelPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sServiceType) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1228,8 +5196,8 @@ func (m *metricK8sJobMaxParallelPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sJobMaxParallelPods(cfg MetricConfig) metricK8sJobMaxParallelPods {
-	m := metricK8sJobMaxParallelPods{config: cfg}
+func newMetricK8sServiceType(cfg MetricConfig) metricK8sServiceType {
+	m := metricK8sServiceType{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1237,21 +5205,21 @@ func newMetricK8sJobMaxParallelPods(cfg MetricConfig) metricK8sJobMaxParallelPod
 	return m
 }
 
-type metricK8sJobSuccessfulPods struct {
+type metricK8sStatefulsetCollisionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.job.successful_pods metric with initial data.
-func (m *metricK8sJobSuccessfulPods) init() {
-	m.data.SetName("k8s.job.successful_pods")
-	m.data.SetDescription("The number of pods which reached phase Succeeded for a job")
-	m.data.SetUnit("{pod}")
+// init fills k8s.statefulset.collision_count metric with initial data.
+func (m *metricK8sStatefulsetCollisionCount) init() {
+	m.data.SetName("k8s.statefulset.collision_count")
+	m.data.SetDescription("Number of hash collisions for the stateful set, used by the controller to create the name of the newest ControllerRevision. Only reported once set by the controller.")
+	m.data.SetUnit("{collision}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sJobSuccessfulPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStatefulsetCollisionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1262,14 +5230,14 @@ func (m *metricK8sJobSuccessfulPods) recordDataPoint(start pcommon.Timestamp, ts
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sJobSuccessfulPods) updateCapacity() {
+func (m *metricK8sStatefulsetCollisionCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sJobSuccessfulPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetCollisionCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1277,8 +5245,8 @@ func (m *metricK8sJobSuccessfulPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sJobSuccessfulPods(cfg MetricConfig) metricK8sJobSuccessfulPods {
-	m := metricK8sJobSuccessfulPods{config: cfg}
+func newMetricK8sStatefulsetCollisionCount(cfg MetricConfig) metricK8sStatefulsetCollisionCount {
+	m := metricK8sStatefulsetCollisionCount{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1286,21 +5254,21 @@ func newMetricK8sJobSuccessfulPods(cfg MetricConfig) metricK8sJobSuccessfulPods
 	return m
 }
 
-type metricK8sNamespacePhase struct {
+type metricK8sStatefulsetCurrentPods struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.namespace.phase metric with initial data.
-func (m *metricK8sNamespacePhase) init() {
-	m.data.SetName("k8s.namespace.phase")
-	m.data.SetDescription("The current phase of namespaces (1 for active and 0 for terminating)")
-	m.data.SetUnit("")
+// init fills k8s.statefulset.current_pods metric with initial data.
+func (m *metricK8sStatefulsetCurrentPods) init() {
+	m.data.SetName("k8s.statefulset.current_pods")
+	m.data.SetDescription("The number of pods created by the StatefulSet controller from the StatefulSet version")
+	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sNamespacePhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStatefulsetCurrentPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1311,14 +5279,14 @@ func (m *metricK8sNamespacePhase) recordDataPoint(start pcommon.Timestamp, ts pc
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sNamespacePhase) updateCapacity() {
+func (m *metricK8sStatefulsetCurrentPods) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sNamespacePhase) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetCurrentPods) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1326,8 +5294,8 @@ func (m *metricK8sNamespacePhase) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sNamespacePhase(cfg MetricConfig) metricK8sNamespacePhase {
-	m := metricK8sNamespacePhase{config: cfg}
+func newMetricK8sStatefulsetCurrentPods(cfg MetricConfig) metricK8sStatefulsetCurrentPods {
+	m := metricK8sStatefulsetCurrentPods{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1335,22 +5303,21 @@ func newMetricK8sNamespacePhase(cfg MetricConfig) metricK8sNamespacePhase {
 	return m
 }
 
-type metricK8sNodeCondition struct {
+type metricK8sStatefulsetDesiredPods struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.node.condition metric with initial data.
-func (m *metricK8sNodeCondition) init() {
-	m.data.SetName("k8s.node.condition")
-	m.data.SetDescription("The condition of a particular Node.")
-	m.data.SetUnit("{condition}")
+// init fills k8s.statefulset.desired_pods metric with initial data.
+func (m *metricK8sStatefulsetDesiredPods) init() {
+	m.data.SetName("k8s.statefulset.desired_pods")
+	m.data.SetDescription("Number of desired pods in the stateful set (the `spec.replicas` field)")
+	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sNodeCondition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+func (m *metricK8sStatefulsetDesiredPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1358,18 +5325,17 @@ func (m *metricK8sNodeCondition) recordDataPoint(start pcommon.Timestamp, ts pco
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("condition", conditionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sNodeCondition) updateCapacity() {
+func (m *metricK8sStatefulsetDesiredPods) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sNodeCondition) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetDesiredPods) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1377,8 +5343,8 @@ func (m *metricK8sNodeCondition) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sNodeCondition(cfg MetricConfig) metricK8sNodeCondition {
-	m := metricK8sNodeCondition{config: cfg}
+func newMetricK8sStatefulsetDesiredPods(cfg MetricConfig) metricK8sStatefulsetDesiredPods {
+	m := metricK8sStatefulsetDesiredPods{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1386,21 +5352,21 @@ func newMetricK8sNodeCondition(cfg MetricConfig) metricK8sNodeCondition {
 	return m
 }
 
-type metricK8sPodPhase struct {
+type metricK8sStatefulsetGenerationSkew struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.pod.phase metric with initial data.
-func (m *metricK8sPodPhase) init() {
-	m.data.SetName("k8s.pod.phase")
-	m.data.SetDescription("Current phase of the pod (1 - Pending, 2 - Running, 3 - Succeeded, 4 - Failed, 5 - Unknown)")
+// init fills k8s.statefulset.generation_skew metric with initial data.
+func (m *metricK8sStatefulsetGenerationSkew) init() {
+	m.data.SetName("k8s.statefulset.generation_skew")
+	m.data.SetDescription("Whether the stateful set controller hasn't observed the latest generation of the stateful set yet (0 for no, 1 for yes)")
 	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sPodPhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStatefulsetGenerationSkew) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1411,14 +5377,14 @@ func (m *metricK8sPodPhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sPodPhase) updateCapacity() {
+func (m *metricK8sStatefulsetGenerationSkew) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sPodPhase) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetGenerationSkew) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1426,8 +5392,8 @@ func (m *metricK8sPodPhase) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sPodPhase(cfg MetricConfig) metricK8sPodPhase {
-	m := metricK8sPodPhase{config: cfg}
+func newMetricK8sStatefulsetGenerationSkew(cfg MetricConfig) metricK8sStatefulsetGenerationSkew {
+	m := metricK8sStatefulsetGenerationSkew{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1435,21 +5401,21 @@ func newMetricK8sPodPhase(cfg MetricConfig) metricK8sPodPhase {
 	return m
 }
 
-type metricK8sPodStatusReason struct {
+type metricK8sStatefulsetReadyPods struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.pod.status_reason metric with initial data.
-func (m *metricK8sPodStatusReason) init() {
-	m.data.SetName("k8s.pod.status_reason")
-	m.data.SetDescription("Current status reason of the pod (1 - Evicted, 2 - NodeAffinity, 3 - NodeLost, 4 - Shutdown, 5 - UnexpectedAdmissionError, 6 - Unknown)")
-	m.data.SetUnit("")
+// init fills k8s.statefulset.ready_pods metric with initial data.
+func (m *metricK8sStatefulsetReadyPods) init() {
+	m.data.SetName("k8s.statefulset.ready_pods")
+	m.data.SetDescription("Number of pods created by the stateful set that have the `Ready` condition")
+	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sPodStatusReason) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStatefulsetReadyPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1460,14 +5426,14 @@ func (m *metricK8sPodStatusReason) recordDataPoint(start pcommon.Timestamp, ts p
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sPodStatusReason) updateCapacity() {
+func (m *metricK8sStatefulsetReadyPods) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sPodStatusReason) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetReadyPods) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1475,8 +5441,8 @@ func (m *metricK8sPodStatusReason) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sPodStatusReason(cfg MetricConfig) metricK8sPodStatusReason {
-	m := metricK8sPodStatusReason{config: cfg}
+func newMetricK8sStatefulsetReadyPods(cfg MetricConfig) metricK8sStatefulsetReadyPods {
+	m := metricK8sStatefulsetReadyPods{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1484,21 +5450,21 @@ func newMetricK8sPodStatusReason(cfg MetricConfig) metricK8sPodStatusReason {
 	return m
 }
 
-type metricK8sReplicasetAvailable struct {
+type metricK8sStatefulsetRevisionMismatch struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.replicaset.available metric with initial data.
-func (m *metricK8sReplicasetAvailable) init() {
-	m.data.SetName("k8s.replicaset.available")
-	m.data.SetDescription("Total number of available pods (ready for at least minReadySeconds) targeted by this replicaset")
-	m.data.SetUnit("{pod}")
+// init fills k8s.statefulset.revision_mismatch metric with initial data.
+func (m *metricK8sStatefulsetRevisionMismatch) init() {
+	m.data.SetName("k8s.statefulset.revision_mismatch")
+	m.data.SetDescription("Whether the current revision of the stateful set differs from its update revision, i.e. a rollout is in progress (0 for no, 1 for yes)")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sReplicasetAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStatefulsetRevisionMismatch) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1509,14 +5475,14 @@ func (m *metricK8sReplicasetAvailable) recordDataPoint(start pcommon.Timestamp,
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sReplicasetAvailable) updateCapacity() {
+func (m *metricK8sStatefulsetRevisionMismatch) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sReplicasetAvailable) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetRevisionMismatch) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1524,8 +5490,8 @@ func (m *metricK8sReplicasetAvailable) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sReplicasetAvailable(cfg MetricConfig) metricK8sReplicasetAvailable {
-	m := metricK8sReplicasetAvailable{config: cfg}
+func newMetricK8sStatefulsetRevisionMismatch(cfg MetricConfig) metricK8sStatefulsetRevisionMismatch {
+	m := metricK8sStatefulsetRevisionMismatch{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1533,21 +5499,21 @@ func newMetricK8sReplicasetAvailable(cfg MetricConfig) metricK8sReplicasetAvaila
 	return m
 }
 
-type metricK8sReplicasetDesired struct {
+type metricK8sStatefulsetUpdatePartition struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.replicaset.desired metric with initial data.
-func (m *metricK8sReplicasetDesired) init() {
-	m.data.SetName("k8s.replicaset.desired")
-	m.data.SetDescription("Number of desired pods in this replicaset")
+// init fills k8s.statefulset.update_partition metric with initial data.
+func (m *metricK8sStatefulsetUpdatePartition) init() {
+	m.data.SetName("k8s.statefulset.update_partition")
+	m.data.SetDescription("The ordinal at which the stateful set is partitioned for rolling updates, pods with a lower ordinal are not updated. Only reported for the RollingUpdate strategy with a partition set.")
 	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sReplicasetDesired) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStatefulsetUpdatePartition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1558,14 +5524,14 @@ func (m *metricK8sReplicasetDesired) recordDataPoint(start pcommon.Timestamp, ts
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sReplicasetDesired) updateCapacity() {
+func (m *metricK8sStatefulsetUpdatePartition) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sReplicasetDesired) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetUpdatePartition) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1573,8 +5539,8 @@ func (m *metricK8sReplicasetDesired) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sReplicasetDesired(cfg MetricConfig) metricK8sReplicasetDesired {
-	m := metricK8sReplicasetDesired{config: cfg}
+func newMetricK8sStatefulsetUpdatePartition(cfg MetricConfig) metricK8sStatefulsetUpdatePartition {
+	m := metricK8sStatefulsetUpdatePartition{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1582,21 +5548,21 @@ func newMetricK8sReplicasetDesired(cfg MetricConfig) metricK8sReplicasetDesired
 	return m
 }
 
-type metricK8sReplicationControllerAvailable struct {
+type metricK8sStatefulsetUpdatedPods struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.replication_controller.available metric with initial data.
-func (m *metricK8sReplicationControllerAvailable) init() {
-	m.data.SetName("k8s.replication_controller.available")
-	m.data.SetDescription("Total number of available pods (ready for at least minReadySeconds) targeted by this replication_controller")
+// init fills k8s.statefulset.updated_pods metric with initial data.
+func (m *metricK8sStatefulsetUpdatedPods) init() {
+	m.data.SetName("k8s.statefulset.updated_pods")
+	m.data.SetDescription("Number of pods created by the StatefulSet controller from the StatefulSet version")
 	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sReplicationControllerAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStatefulsetUpdatedPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1607,14 +5573,14 @@ func (m *metricK8sReplicationControllerAvailable) recordDataPoint(start pcommon.
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sReplicationControllerAvailable) updateCapacity() {
+func (m *metricK8sStatefulsetUpdatedPods) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sReplicationControllerAvailable) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStatefulsetUpdatedPods) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1622,8 +5588,8 @@ func (m *metricK8sReplicationControllerAvailable) emit(metrics pmetric.MetricSli
 	}
 }
 
-func newMetricK8sReplicationControllerAvailable(cfg MetricConfig) metricK8sReplicationControllerAvailable {
-	m := metricK8sReplicationControllerAvailable{config: cfg}
+func newMetricK8sStatefulsetUpdatedPods(cfg MetricConfig) metricK8sStatefulsetUpdatedPods {
+	m := metricK8sStatefulsetUpdatedPods{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1631,21 +5597,21 @@ func newMetricK8sReplicationControllerAvailable(cfg MetricConfig) metricK8sRepli
 	return m
 }
 
-type metricK8sReplicationControllerDesired struct {
+type metricK8sStorageclassInfo struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.replication_controller.desired metric with initial data.
-func (m *metricK8sReplicationControllerDesired) init() {
-	m.data.SetName("k8s.replication_controller.desired")
-	m.data.SetDescription("Number of desired pods in this replication_controller")
-	m.data.SetUnit("{pod}")
+// init fills k8s.storageclass.info metric with initial data.
+func (m *metricK8sStorageclassInfo) init() {
+	m.data.SetName("k8s.storageclass.info")
+	m.data.SetDescription("Information about the storage class, always 1. The storage class is described by its resource attributes")
+	m.data.SetUnit("")
 	m.data.SetEmptyGauge()
 }
 
-func (m *metricK8sReplicationControllerDesired) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricK8sStorageclassInfo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1656,14 +5622,14 @@ func (m *metricK8sReplicationControllerDesired) recordDataPoint(start pcommon.Ti
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sReplicationControllerDesired) updateCapacity() {
+func (m *metricK8sStorageclassInfo) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sReplicationControllerDesired) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sStorageclassInfo) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1671,8 +5637,8 @@ func (m *metricK8sReplicationControllerDesired) emit(metrics pmetric.MetricSlice
 	}
 }
 
-func newMetricK8sReplicationControllerDesired(cfg MetricConfig) metricK8sReplicationControllerDesired {
-	m := metricK8sReplicationControllerDesired{config: cfg}
+func newMetricK8sStorageclassInfo(cfg MetricConfig) metricK8sStorageclassInfo {
+	m := metricK8sStorageclassInfo{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1680,41 +5646,41 @@ func newMetricK8sReplicationControllerDesired(cfg MetricConfig) metricK8sReplica
 	return m
 }
 
-type metricK8sResourceQuotaHardLimit struct {
+type metricK8sVpaTargetCPU struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.resource_quota.hard_limit metric with initial data.
-func (m *metricK8sResourceQuotaHardLimit) init() {
-	m.data.SetName("k8s.resource_quota.hard_limit")
-	m.data.SetDescription("The upper limit for a particular resource in a specific namespace. Will only be sent if a quota is specified. CPU requests/limits will be sent as millicores")
-	m.data.SetUnit("{resource}")
+// init fills k8s.vpa.target_cpu metric with initial data.
+func (m *metricK8sVpaTargetCPU) init() {
+	m.data.SetName("k8s.vpa.target_cpu")
+	m.data.SetDescription("CPU recommended by the vertical pod autoscaler for a container. Only reported once the autoscaler provides a recommendation.")
+	m.data.SetUnit("{cpu}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sResourceQuotaHardLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
+func (m *metricK8sVpaTargetCPU) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, vpaContainerNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetIntValue(val)
-	dp.Attributes().PutStr("resource", resourceAttributeValue)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("vpa.container.name", vpaContainerNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sResourceQuotaHardLimit) updateCapacity() {
+func (m *metricK8sVpaTargetCPU) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sResourceQuotaHardLimit) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sVpaTargetCPU) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1722,8 +5688,8 @@ func (m *metricK8sResourceQuotaHardLimit) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sResourceQuotaHardLimit(cfg MetricConfig) metricK8sResourceQuotaHardLimit {
-	m := metricK8sResourceQuotaHardLimit{config: cfg}
+func newMetricK8sVpaTargetCPU(cfg MetricConfig) metricK8sVpaTargetCPU {
+	m := metricK8sVpaTargetCPU{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1731,22 +5697,22 @@ func newMetricK8sResourceQuotaHardLimit(cfg MetricConfig) metricK8sResourceQuota
 	return m
 }
 
-type metricK8sResourceQuotaUsed struct {
+type metricK8sVpaTargetMemory struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.resource_quota.used metric with initial data.
-func (m *metricK8sResourceQuotaUsed) init() {
-	m.data.SetName("k8s.resource_quota.used")
-	m.data.SetDescription("The usage for a particular resource in a specific namespace. Will only be sent if a quota is specified. CPU requests/limits will be sent as millicores")
-	m.data.SetUnit("{resource}")
+// init fills k8s.vpa.target_memory metric with initial data.
+func (m *metricK8sVpaTargetMemory) init() {
+	m.data.SetName("k8s.vpa.target_memory")
+	m.data.SetDescription("Memory recommended by the vertical pod autoscaler for a container. Only reported once the autoscaler provides a recommendation.")
+	m.data.SetUnit("By")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sResourceQuotaUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
+func (m *metricK8sVpaTargetMemory) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vpaContainerNameAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1754,18 +5720,18 @@ func (m *metricK8sResourceQuotaUsed) recordDataPoint(start pcommon.Timestamp, ts
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("resource", resourceAttributeValue)
+	dp.Attributes().PutStr("vpa.container.name", vpaContainerNameAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sResourceQuotaUsed) updateCapacity() {
+func (m *metricK8sVpaTargetMemory) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sResourceQuotaUsed) emit(metrics pmetric.MetricSlice) {
+func (m *metricK8sVpaTargetMemory) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1773,8 +5739,8 @@ func (m *metricK8sResourceQuotaUsed) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sResourceQuotaUsed(cfg MetricConfig) metricK8sResourceQuotaUsed {
-	m := metricK8sResourceQuotaUsed{config: cfg}
+func newMetricK8sVpaTargetMemory(cfg MetricConfig) metricK8sVpaTargetMemory {
+	m := metricK8sVpaTargetMemory{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1782,21 +5748,22 @@ func newMetricK8sResourceQuotaUsed(cfg MetricConfig) metricK8sResourceQuotaUsed
 	return m
 }
 
-type metricK8sStatefulsetCurrentPods struct {
+type metricOpenshiftAppliedclusterquotaLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.statefulset.current_pods metric with initial data.
-func (m *metricK8sStatefulsetCurrentPods) init() {
-	m.data.SetName("k8s.statefulset.current_pods")
-	m.data.SetDescription("The number of pods created by the StatefulSet controller from the StatefulSet version")
-	m.data.SetUnit("{pod}")
+// init fills openshift.appliedclusterquota.limit metric with initial data.
+func (m *metricOpenshiftAppliedclusterquotaLimit) init() {
+	m.data.SetName("openshift.appliedclusterquota.limit")
+	m.data.SetDescription("The upper limit for a particular resource in a specific namespace.")
+	m.data.SetUnit("{resource}")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sStatefulsetCurrentPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricOpenshiftAppliedclusterquotaLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, k8sNamespaceNameAttributeValue string, resourceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1804,17 +5771,19 @@ func (m *metricK8sStatefulsetCurrentPods) recordDataPoint(start pcommon.Timestam
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("k8s.namespace.name", k8sNamespaceNameAttributeValue)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sStatefulsetCurrentPods) updateCapacity() {
+func (m *metricOpenshiftAppliedclusterquotaLimit) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sStatefulsetCurrentPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftAppliedclusterquotaLimit) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1822,8 +5791,8 @@ func (m *metricK8sStatefulsetCurrentPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sStatefulsetCurrentPods(cfg MetricConfig) metricK8sStatefulsetCurrentPods {
-	m := metricK8sStatefulsetCurrentPods{config: cfg}
+func newMetricOpenshiftAppliedclusterquotaLimit(cfg MetricConfig) metricOpenshiftAppliedclusterquotaLimit {
+	m := metricOpenshiftAppliedclusterquotaLimit{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1831,21 +5800,22 @@ func newMetricK8sStatefulsetCurrentPods(cfg MetricConfig) metricK8sStatefulsetCu
 	return m
 }
 
-type metricK8sStatefulsetDesiredPods struct {
+type metricOpenshiftAppliedclusterquotaUsed struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.statefulset.desired_pods metric with initial data.
-func (m *metricK8sStatefulsetDesiredPods) init() {
-	m.data.SetName("k8s.statefulset.desired_pods")
-	m.data.SetDescription("Number of desired pods in the stateful set (the `spec.replicas` field)")
-	m.data.SetUnit("{pod}")
+// init fills openshift.appliedclusterquota.used metric with initial data.
+func (m *metricOpenshiftAppliedclusterquotaUsed) init() {
+	m.data.SetName("openshift.appliedclusterquota.used")
+	m.data.SetDescription("The usage for a particular resource in a specific namespace.")
+	m.data.SetUnit("{resource}")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sStatefulsetDesiredPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricOpenshiftAppliedclusterquotaUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, k8sNamespaceNameAttributeValue string, resourceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1853,17 +5823,19 @@ func (m *metricK8sStatefulsetDesiredPods) recordDataPoint(start pcommon.Timestam
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("k8s.namespace.name", k8sNamespaceNameAttributeValue)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sStatefulsetDesiredPods) updateCapacity() {
+func (m *metricOpenshiftAppliedclusterquotaUsed) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sStatefulsetDesiredPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftAppliedclusterquotaUsed) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1871,8 +5843,8 @@ func (m *metricK8sStatefulsetDesiredPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sStatefulsetDesiredPods(cfg MetricConfig) metricK8sStatefulsetDesiredPods {
-	m := metricK8sStatefulsetDesiredPods{config: cfg}
+func newMetricOpenshiftAppliedclusterquotaUsed(cfg MetricConfig) metricOpenshiftAppliedclusterquotaUsed {
+	m := metricOpenshiftAppliedclusterquotaUsed{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1880,21 +5852,22 @@ func newMetricK8sStatefulsetDesiredPods(cfg MetricConfig) metricK8sStatefulsetDe
 	return m
 }
 
-type metricK8sStatefulsetReadyPods struct {
+type metricOpenshiftClusterquotaLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.statefulset.ready_pods metric with initial data.
-func (m *metricK8sStatefulsetReadyPods) init() {
-	m.data.SetName("k8s.statefulset.ready_pods")
-	m.data.SetDescription("Number of pods created by the stateful set that have the `Ready` condition")
-	m.data.SetUnit("{pod}")
+// init fills openshift.clusterquota.limit metric with initial data.
+func (m *metricOpenshiftClusterquotaLimit) init() {
+	m.data.SetName("openshift.clusterquota.limit")
+	m.data.SetDescription("The configured upper limit for a particular resource.")
+	m.data.SetUnit("{resource}")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sStatefulsetReadyPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricOpenshiftClusterquotaLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1902,17 +5875,18 @@ func (m *metricK8sStatefulsetReadyPods) recordDataPoint(start pcommon.Timestamp,
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sStatefulsetReadyPods) updateCapacity() {
+func (m *metricOpenshiftClusterquotaLimit) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sStatefulsetReadyPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftClusterquotaLimit) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1920,8 +5894,8 @@ func (m *metricK8sStatefulsetReadyPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sStatefulsetReadyPods(cfg MetricConfig) metricK8sStatefulsetReadyPods {
-	m := metricK8sStatefulsetReadyPods{config: cfg}
+func newMetricOpenshiftClusterquotaLimit(cfg MetricConfig) metricOpenshiftClusterquotaLimit {
+	m := metricOpenshiftClusterquotaLimit{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1929,21 +5903,22 @@ func newMetricK8sStatefulsetReadyPods(cfg MetricConfig) metricK8sStatefulsetRead
 	return m
 }
 
-type metricK8sStatefulsetUpdatedPods struct {
+type metricOpenshiftClusterquotaUsed struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills k8s.statefulset.updated_pods metric with initial data.
-func (m *metricK8sStatefulsetUpdatedPods) init() {
-	m.data.SetName("k8s.statefulset.updated_pods")
-	m.data.SetDescription("Number of pods created by the StatefulSet controller from the StatefulSet version")
-	m.data.SetUnit("{pod}")
+// init fills openshift.clusterquota.used metric with initial data.
+func (m *metricOpenshiftClusterquotaUsed) init() {
+	m.data.SetName("openshift.clusterquota.used")
+	m.data.SetDescription("The usage for a particular resource with a configured limit.")
+	m.data.SetUnit("{resource}")
 	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricK8sStatefulsetUpdatedPods) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricOpenshiftClusterquotaUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -1951,17 +5926,18 @@ func (m *metricK8sStatefulsetUpdatedPods) recordDataPoint(start pcommon.Timestam
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricK8sStatefulsetUpdatedPods) updateCapacity() {
+func (m *metricOpenshiftClusterquotaUsed) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricK8sStatefulsetUpdatedPods) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftClusterquotaUsed) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1969,8 +5945,8 @@ func (m *metricK8sStatefulsetUpdatedPods) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricK8sStatefulsetUpdatedPods(cfg MetricConfig) metricK8sStatefulsetUpdatedPods {
-	m := metricK8sStatefulsetUpdatedPods{config: cfg}
+func newMetricOpenshiftClusterquotaUsed(cfg MetricConfig) metricOpenshiftClusterquotaUsed {
+	m := metricOpenshiftClusterquotaUsed{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -1978,22 +5954,21 @@ func newMetricK8sStatefulsetUpdatedPods(cfg MetricConfig) metricK8sStatefulsetUp
 	return m
 }
 
-type metricOpenshiftAppliedclusterquotaLimit struct {
+type metricOpenshiftDeploymentconfigAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills openshift.appliedclusterquota.limit metric with initial data.
-func (m *metricOpenshiftAppliedclusterquotaLimit) init() {
-	m.data.SetName("openshift.appliedclusterquota.limit")
-	m.data.SetDescription("The upper limit for a particular resource in a specific namespace.")
-	m.data.SetUnit("{resource}")
+// init fills openshift.deploymentconfig.available metric with initial data.
+func (m *metricOpenshiftDeploymentconfigAvailable) init() {
+	m.data.SetName("openshift.deploymentconfig.available")
+	m.data.SetDescription("Total number of available pods (ready for at least minReadySeconds) targeted by this deployment config")
+	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricOpenshiftAppliedclusterquotaLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, k8sNamespaceNameAttributeValue string, resourceAttributeValue string) {
+func (m *metricOpenshiftDeploymentconfigAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -2001,19 +5976,17 @@ func (m *metricOpenshiftAppliedclusterquotaLimit) recordDataPoint(start pcommon.
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("k8s.namespace.name", k8sNamespaceNameAttributeValue)
-	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricOpenshiftAppliedclusterquotaLimit) updateCapacity() {
+func (m *metricOpenshiftDeploymentconfigAvailable) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricOpenshiftAppliedclusterquotaLimit) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftDeploymentconfigAvailable) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -2021,8 +5994,8 @@ func (m *metricOpenshiftAppliedclusterquotaLimit) emit(metrics pmetric.MetricSli
 	}
 }
 
-func newMetricOpenshiftAppliedclusterquotaLimit(cfg MetricConfig) metricOpenshiftAppliedclusterquotaLimit {
-	m := metricOpenshiftAppliedclusterquotaLimit{config: cfg}
+func newMetricOpenshiftDeploymentconfigAvailable(cfg MetricConfig) metricOpenshiftDeploymentconfigAvailable {
+	m := metricOpenshiftDeploymentconfigAvailable{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -2030,22 +6003,21 @@ func newMetricOpenshiftAppliedclusterquotaLimit(cfg MetricConfig) metricOpenshif
 	return m
 }
 
-type metricOpenshiftAppliedclusterquotaUsed struct {
+type metricOpenshiftDeploymentconfigDesired struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills openshift.appliedclusterquota.used metric with initial data.
-func (m *metricOpenshiftAppliedclusterquotaUsed) init() {
-	m.data.SetName("openshift.appliedclusterquota.used")
-	m.data.SetDescription("The usage for a particular resource in a specific namespace.")
-	m.data.SetUnit("{resource}")
+// init fills openshift.deploymentconfig.desired metric with initial data.
+func (m *metricOpenshiftDeploymentconfigDesired) init() {
+	m.data.SetName("openshift.deploymentconfig.desired")
+	m.data.SetDescription("Number of desired pods in this deployment config")
+	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricOpenshiftAppliedclusterquotaUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, k8sNamespaceNameAttributeValue string, resourceAttributeValue string) {
+func (m *metricOpenshiftDeploymentconfigDesired) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -2053,19 +6025,17 @@ func (m *metricOpenshiftAppliedclusterquotaUsed) recordDataPoint(start pcommon.T
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("k8s.namespace.name", k8sNamespaceNameAttributeValue)
-	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricOpenshiftAppliedclusterquotaUsed) updateCapacity() {
+func (m *metricOpenshiftDeploymentconfigDesired) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricOpenshiftAppliedclusterquotaUsed) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftDeploymentconfigDesired) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -2073,8 +6043,8 @@ func (m *metricOpenshiftAppliedclusterquotaUsed) emit(metrics pmetric.MetricSlic
 	}
 }
 
-func newMetricOpenshiftAppliedclusterquotaUsed(cfg MetricConfig) metricOpenshiftAppliedclusterquotaUsed {
-	m := metricOpenshiftAppliedclusterquotaUsed{config: cfg}
+func newMetricOpenshiftDeploymentconfigDesired(cfg MetricConfig) metricOpenshiftDeploymentconfigDesired {
+	m := metricOpenshiftDeploymentconfigDesired{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -2082,22 +6052,21 @@ func newMetricOpenshiftAppliedclusterquotaUsed(cfg MetricConfig) metricOpenshift
 	return m
 }
 
-type metricOpenshiftClusterquotaLimit struct {
+type metricOpenshiftDeploymentconfigReady struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills openshift.clusterquota.limit metric with initial data.
-func (m *metricOpenshiftClusterquotaLimit) init() {
-	m.data.SetName("openshift.clusterquota.limit")
-	m.data.SetDescription("The configured upper limit for a particular resource.")
-	m.data.SetUnit("{resource}")
+// init fills openshift.deploymentconfig.ready metric with initial data.
+func (m *metricOpenshiftDeploymentconfigReady) init() {
+	m.data.SetName("openshift.deploymentconfig.ready")
+	m.data.SetDescription("Total number of ready pods targeted by this deployment config")
+	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricOpenshiftClusterquotaLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
+func (m *metricOpenshiftDeploymentconfigReady) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -2105,18 +6074,17 @@ func (m *metricOpenshiftClusterquotaLimit) recordDataPoint(start pcommon.Timesta
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricOpenshiftClusterquotaLimit) updateCapacity() {
+func (m *metricOpenshiftDeploymentconfigReady) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricOpenshiftClusterquotaLimit) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftDeploymentconfigReady) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -2124,8 +6092,8 @@ func (m *metricOpenshiftClusterquotaLimit) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricOpenshiftClusterquotaLimit(cfg MetricConfig) metricOpenshiftClusterquotaLimit {
-	m := metricOpenshiftClusterquotaLimit{config: cfg}
+func newMetricOpenshiftDeploymentconfigReady(cfg MetricConfig) metricOpenshiftDeploymentconfigReady {
+	m := metricOpenshiftDeploymentconfigReady{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -2133,22 +6101,21 @@ func newMetricOpenshiftClusterquotaLimit(cfg MetricConfig) metricOpenshiftCluste
 	return m
 }
 
-type metricOpenshiftClusterquotaUsed struct {
+type metricOpenshiftDeploymentconfigUpdated struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills openshift.clusterquota.used metric with initial data.
-func (m *metricOpenshiftClusterquotaUsed) init() {
-	m.data.SetName("openshift.clusterquota.used")
-	m.data.SetDescription("The usage for a particular resource with a configured limit.")
-	m.data.SetUnit("{resource}")
+// init fills openshift.deploymentconfig.updated metric with initial data.
+func (m *metricOpenshiftDeploymentconfigUpdated) init() {
+	m.data.SetName("openshift.deploymentconfig.updated")
+	m.data.SetDescription("Total number of non-terminated pods targeted by this deployment config that have the desired template spec")
+	m.data.SetUnit("{pod}")
 	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricOpenshiftClusterquotaUsed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string) {
+func (m *metricOpenshiftDeploymentconfigUpdated) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
 	if !m.config.Enabled {
 		return
 	}
@@ -2156,18 +6123,17 @@ func (m *metricOpenshiftClusterquotaUsed) recordDataPoint(start pcommon.Timestam
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("resource", resourceAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricOpenshiftClusterquotaUsed) updateCapacity() {
+func (m *metricOpenshiftDeploymentconfigUpdated) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricOpenshiftClusterquotaUsed) emit(metrics pmetric.MetricSlice) {
+func (m *metricOpenshiftDeploymentconfigUpdated) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -2175,8 +6141,8 @@ func (m *metricOpenshiftClusterquotaUsed) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricOpenshiftClusterquotaUsed(cfg MetricConfig) metricOpenshiftClusterquotaUsed {
-	m := metricOpenshiftClusterquotaUsed{config: cfg}
+func newMetricOpenshiftDeploymentconfigUpdated(cfg MetricConfig) metricOpenshiftDeploymentconfigUpdated {
+	m := metricOpenshiftDeploymentconfigUpdated{config: cfg}
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -2187,55 +6153,135 @@ func newMetricOpenshiftClusterquotaUsed(cfg MetricConfig) metricOpenshiftCluster
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                                    MetricsBuilderConfig // config of the metrics builder.
-	startTime                                 pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                           int                  // maximum observed number of metrics per resource.
-	metricsBuffer                             pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                                 component.BuildInfo  // contains version information.
-	metricK8sContainerCPULimit                metricK8sContainerCPULimit
-	metricK8sContainerCPURequest              metricK8sContainerCPURequest
-	metricK8sContainerEphemeralstorageLimit   metricK8sContainerEphemeralstorageLimit
-	metricK8sContainerEphemeralstorageRequest metricK8sContainerEphemeralstorageRequest
-	metricK8sContainerMemoryLimit             metricK8sContainerMemoryLimit
-	metricK8sContainerMemoryRequest           metricK8sContainerMemoryRequest
-	metricK8sContainerReady                   metricK8sContainerReady
-	metricK8sContainerRestarts                metricK8sContainerRestarts
-	metricK8sContainerStorageLimit            metricK8sContainerStorageLimit
-	metricK8sContainerStorageRequest          metricK8sContainerStorageRequest
-	metricK8sCronjobActiveJobs                metricK8sCronjobActiveJobs
-	metricK8sDaemonsetCurrentScheduledNodes   metricK8sDaemonsetCurrentScheduledNodes
-	metricK8sDaemonsetDesiredScheduledNodes   metricK8sDaemonsetDesiredScheduledNodes
-	metricK8sDaemonsetMisscheduledNodes       metricK8sDaemonsetMisscheduledNodes
-	metricK8sDaemonsetReadyNodes              metricK8sDaemonsetReadyNodes
-	metricK8sDeploymentAvailable              metricK8sDeploymentAvailable
-	metricK8sDeploymentDesired                metricK8sDeploymentDesired
-	metricK8sHpaCurrentReplicas               metricK8sHpaCurrentReplicas
-	metricK8sHpaDesiredReplicas               metricK8sHpaDesiredReplicas
-	metricK8sHpaMaxReplicas                   metricK8sHpaMaxReplicas
-	metricK8sHpaMinReplicas                   metricK8sHpaMinReplicas
-	metricK8sJobActivePods                    metricK8sJobActivePods
-	metricK8sJobDesiredSuccessfulPods         metricK8sJobDesiredSuccessfulPods
-	metricK8sJobFailedPods                    metricK8sJobFailedPods
-	metricK8sJobMaxParallelPods               metricK8sJobMaxParallelPods
-	metricK8sJobSuccessfulPods                metricK8sJobSuccessfulPods
-	metricK8sNamespacePhase                   metricK8sNamespacePhase
-	metricK8sNodeCondition                    metricK8sNodeCondition
-	metricK8sPodPhase                         metricK8sPodPhase
-	metricK8sPodStatusReason                  metricK8sPodStatusReason
-	metricK8sReplicasetAvailable              metricK8sReplicasetAvailable
-	metricK8sReplicasetDesired                metricK8sReplicasetDesired
-	metricK8sReplicationControllerAvailable   metricK8sReplicationControllerAvailable
-	metricK8sReplicationControllerDesired     metricK8sReplicationControllerDesired
-	metricK8sResourceQuotaHardLimit           metricK8sResourceQuotaHardLimit
-	metricK8sResourceQuotaUsed                metricK8sResourceQuotaUsed
-	metricK8sStatefulsetCurrentPods           metricK8sStatefulsetCurrentPods
-	metricK8sStatefulsetDesiredPods           metricK8sStatefulsetDesiredPods
-	metricK8sStatefulsetReadyPods             metricK8sStatefulsetReadyPods
-	metricK8sStatefulsetUpdatedPods           metricK8sStatefulsetUpdatedPods
-	metricOpenshiftAppliedclusterquotaLimit   metricOpenshiftAppliedclusterquotaLimit
-	metricOpenshiftAppliedclusterquotaUsed    metricOpenshiftAppliedclusterquotaUsed
-	metricOpenshiftClusterquotaLimit          metricOpenshiftClusterquotaLimit
-	metricOpenshiftClusterquotaUsed           metricOpenshiftClusterquotaUsed
+	config                                         MetricsBuilderConfig // config of the metrics builder.
+	startTime                                      pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                                int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                  pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                      component.BuildInfo  // contains version information.
+	metricK8sClusterPodCount                       metricK8sClusterPodCount
+	metricK8sContainerCPULimit                     metricK8sContainerCPULimit
+	metricK8sContainerCPULimitRatio                metricK8sContainerCPULimitRatio
+	metricK8sContainerCPURequest                   metricK8sContainerCPURequest
+	metricK8sContainerCPURequestNodeFraction       metricK8sContainerCPURequestNodeFraction
+	metricK8sContainerEphemeralstorageLimit        metricK8sContainerEphemeralstorageLimit
+	metricK8sContainerEphemeralstorageRequest      metricK8sContainerEphemeralstorageRequest
+	metricK8sContainerLastExitCode                 metricK8sContainerLastExitCode
+	metricK8sContainerLastTerminationReason        metricK8sContainerLastTerminationReason
+	metricK8sContainerMemoryLimit                  metricK8sContainerMemoryLimit
+	metricK8sContainerMemoryLimitRatio             metricK8sContainerMemoryLimitRatio
+	metricK8sContainerMemoryRequest                metricK8sContainerMemoryRequest
+	metricK8sContainerMemoryRequestNodeFraction    metricK8sContainerMemoryRequestNodeFraction
+	metricK8sContainerReady                        metricK8sContainerReady
+	metricK8sContainerRestarts                     metricK8sContainerRestarts
+	metricK8sContainerStarted                      metricK8sContainerStarted
+	metricK8sContainerStorageLimit                 metricK8sContainerStorageLimit
+	metricK8sContainerStorageRequest               metricK8sContainerStorageRequest
+	metricK8sContainerWaiting                      metricK8sContainerWaiting
+	metricK8sCronjobActiveJobs                     metricK8sCronjobActiveJobs
+	metricK8sCronjobLastScheduleAge                metricK8sCronjobLastScheduleAge
+	metricK8sCronjobSuspended                      metricK8sCronjobSuspended
+	metricK8sCustomResourceCount                   metricK8sCustomResourceCount
+	metricK8sDaemonsetCurrentScheduledNodes        metricK8sDaemonsetCurrentScheduledNodes
+	metricK8sDaemonsetDesiredScheduledNodes        metricK8sDaemonsetDesiredScheduledNodes
+	metricK8sDaemonsetGenerationSkew               metricK8sDaemonsetGenerationSkew
+	metricK8sDaemonsetMisscheduledNodes            metricK8sDaemonsetMisscheduledNodes
+	metricK8sDaemonsetReadyNodes                   metricK8sDaemonsetReadyNodes
+	metricK8sDaemonsetReplicasUnavailable          metricK8sDaemonsetReplicasUnavailable
+	metricK8sDaemonsetUnavailableNodes             metricK8sDaemonsetUnavailableNodes
+	metricK8sDaemonsetUpdatedRatio                 metricK8sDaemonsetUpdatedRatio
+	metricK8sDeploymentAvailable                   metricK8sDeploymentAvailable
+	metricK8sDeploymentCollisionCount              metricK8sDeploymentCollisionCount
+	metricK8sDeploymentCondition                   metricK8sDeploymentCondition
+	metricK8sDeploymentDesired                     metricK8sDeploymentDesired
+	metricK8sDeploymentGenerationSkew              metricK8sDeploymentGenerationSkew
+	metricK8sDeploymentPaused                      metricK8sDeploymentPaused
+	metricK8sDeploymentReplicasUnavailable         metricK8sDeploymentReplicasUnavailable
+	metricK8sEndpointsAddressCount                 metricK8sEndpointsAddressCount
+	metricK8sEndpointsNotReadyAddressCount         metricK8sEndpointsNotReadyAddressCount
+	metricK8sEndpointsliceAddressCount             metricK8sEndpointsliceAddressCount
+	metricK8sEndpointsliceReadyCount               metricK8sEndpointsliceReadyCount
+	metricK8sHpaCondition                          metricK8sHpaCondition
+	metricK8sHpaCurrentMetricValue                 metricK8sHpaCurrentMetricValue
+	metricK8sHpaCurrentReplicas                    metricK8sHpaCurrentReplicas
+	metricK8sHpaDesiredReplicas                    metricK8sHpaDesiredReplicas
+	metricK8sHpaExternalCurrentValue               metricK8sHpaExternalCurrentValue
+	metricK8sHpaExternalTargetValue                metricK8sHpaExternalTargetValue
+	metricK8sHpaMaxReplicas                        metricK8sHpaMaxReplicas
+	metricK8sHpaMinReplicas                        metricK8sHpaMinReplicas
+	metricK8sHpaTargetMetricValue                  metricK8sHpaTargetMetricValue
+	metricK8sIngressRuleCount                      metricK8sIngressRuleCount
+	metricK8sIngressTLSCount                       metricK8sIngressTLSCount
+	metricK8sJobActivePods                         metricK8sJobActivePods
+	metricK8sJobBackoffLimit                       metricK8sJobBackoffLimit
+	metricK8sJobCompletedIndexesCount              metricK8sJobCompletedIndexesCount
+	metricK8sJobDesiredSuccessfulPods              metricK8sJobDesiredSuccessfulPods
+	metricK8sJobDuration                           metricK8sJobDuration
+	metricK8sJobFailedExhausted                    metricK8sJobFailedExhausted
+	metricK8sJobFailedPods                         metricK8sJobFailedPods
+	metricK8sJobMaxParallelPods                    metricK8sJobMaxParallelPods
+	metricK8sJobSuccessfulPods                     metricK8sJobSuccessfulPods
+	metricK8sLimitrangeDefaultCPURequest           metricK8sLimitrangeDefaultCPURequest
+	metricK8sLimitrangeDefaultMemoryRequest        metricK8sLimitrangeDefaultMemoryRequest
+	metricK8sLimitrangeMax                         metricK8sLimitrangeMax
+	metricK8sLimitrangeMin                         metricK8sLimitrangeMin
+	metricK8sNamespacePhase                        metricK8sNamespacePhase
+	metricK8sNamespaceSecretCount                  metricK8sNamespaceSecretCount
+	metricK8sNamespaceServiceaccountCount          metricK8sNamespaceServiceaccountCount
+	metricK8sNodeAge                               metricK8sNodeAge
+	metricK8sNodeCondition                         metricK8sNodeCondition
+	metricK8sNodeEvictionRisk                      metricK8sNodeEvictionRisk
+	metricK8sNodeLeaseRenewAge                     metricK8sNodeLeaseRenewAge
+	metricK8sNodePodCount                          metricK8sNodePodCount
+	metricK8sNodeTaintCount                        metricK8sNodeTaintCount
+	metricK8sNodeUnschedulable                     metricK8sNodeUnschedulable
+	metricK8sPdbCurrentHealthy                     metricK8sPdbCurrentHealthy
+	metricK8sPdbDesiredHealthy                     metricK8sPdbDesiredHealthy
+	metricK8sPdbDisruptionsAllowed                 metricK8sPdbDisruptionsAllowed
+	metricK8sPdbExpectedPods                       metricK8sPdbExpectedPods
+	metricK8sPersistentvolumeCapacity              metricK8sPersistentvolumeCapacity
+	metricK8sPersistentvolumePhase                 metricK8sPersistentvolumePhase
+	metricK8sPersistentvolumeclaimActualCapacity   metricK8sPersistentvolumeclaimActualCapacity
+	metricK8sPersistentvolumeclaimPhase            metricK8sPersistentvolumeclaimPhase
+	metricK8sPersistentvolumeclaimRequestedStorage metricK8sPersistentvolumeclaimRequestedStorage
+	metricK8sPodAge                                metricK8sPodAge
+	metricK8sPodCondition                          metricK8sPodCondition
+	metricK8sPodOrphaned                           metricK8sPodOrphaned
+	metricK8sPodPhase                              metricK8sPodPhase
+	metricK8sPodPriority                           metricK8sPodPriority
+	metricK8sPodSchedulingLatency                  metricK8sPodSchedulingLatency
+	metricK8sPodStatusReason                       metricK8sPodStatusReason
+	metricK8sPodUnschedulable                      metricK8sPodUnschedulable
+	metricK8sPriorityclassGlobalDefault            metricK8sPriorityclassGlobalDefault
+	metricK8sPriorityclassValue                    metricK8sPriorityclassValue
+	metricK8sReplicasetAvailable                   metricK8sReplicasetAvailable
+	metricK8sReplicasetDesired                     metricK8sReplicasetDesired
+	metricK8sReplicasetFullyLabeledReplicas        metricK8sReplicasetFullyLabeledReplicas
+	metricK8sReplicationControllerAvailable        metricK8sReplicationControllerAvailable
+	metricK8sReplicationControllerDesired          metricK8sReplicationControllerDesired
+	metricK8sResourceQuotaHardLimit                metricK8sResourceQuotaHardLimit
+	metricK8sResourceQuotaUsed                     metricK8sResourceQuotaUsed
+	metricK8sResourceQuotaUtilization              metricK8sResourceQuotaUtilization
+	metricK8sServicePortCount                      metricK8sServicePortCount
+	metricK8sServiceType                           metricK8sServiceType
+	metricK8sStatefulsetCollisionCount             metricK8sStatefulsetCollisionCount
+	metricK8sStatefulsetCurrentPods                metricK8sStatefulsetCurrentPods
+	metricK8sStatefulsetDesiredPods                metricK8sStatefulsetDesiredPods
+	metricK8sStatefulsetGenerationSkew             metricK8sStatefulsetGenerationSkew
+	metricK8sStatefulsetReadyPods                  metricK8sStatefulsetReadyPods
+	metricK8sStatefulsetRevisionMismatch           metricK8sStatefulsetRevisionMismatch
+	metricK8sStatefulsetUpdatePartition            metricK8sStatefulsetUpdatePartition
+	metricK8sStatefulsetUpdatedPods                metricK8sStatefulsetUpdatedPods
+	metricK8sStorageclassInfo                      metricK8sStorageclassInfo
+	metricK8sVpaTargetCPU                          metricK8sVpaTargetCPU
+	metricK8sVpaTargetMemory                       metricK8sVpaTargetMemory
+	metricOpenshiftAppliedclusterquotaLimit        metricOpenshiftAppliedclusterquotaLimit
+	metricOpenshiftAppliedclusterquotaUsed         metricOpenshiftAppliedclusterquotaUsed
+	metricOpenshiftClusterquotaLimit               metricOpenshiftClusterquotaLimit
+	metricOpenshiftClusterquotaUsed                metricOpenshiftClusterquotaUsed
+	metricOpenshiftDeploymentconfigAvailable       metricOpenshiftDeploymentconfigAvailable
+	metricOpenshiftDeploymentconfigDesired         metricOpenshiftDeploymentconfigDesired
+	metricOpenshiftDeploymentconfigReady           metricOpenshiftDeploymentconfigReady
+	metricOpenshiftDeploymentconfigUpdated         metricOpenshiftDeploymentconfigUpdated
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2253,54 +6299,134 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		settings.Logger.Warn("[WARNING] `k8s.kubeproxy.version` should not be configured: k8s.kubeproxy.version resource attribute is deprecated and will be removed soon.")
 	}
 	mb := &MetricsBuilder{
-		config:                                  mbc,
-		startTime:                               pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                           pmetric.NewMetrics(),
-		buildInfo:                               settings.BuildInfo,
-		metricK8sContainerCPULimit:              newMetricK8sContainerCPULimit(mbc.Metrics.K8sContainerCPULimit),
-		metricK8sContainerCPURequest:            newMetricK8sContainerCPURequest(mbc.Metrics.K8sContainerCPURequest),
-		metricK8sContainerEphemeralstorageLimit: newMetricK8sContainerEphemeralstorageLimit(mbc.Metrics.K8sContainerEphemeralstorageLimit),
-		metricK8sContainerEphemeralstorageRequest: newMetricK8sContainerEphemeralstorageRequest(mbc.Metrics.K8sContainerEphemeralstorageRequest),
-		metricK8sContainerMemoryLimit:             newMetricK8sContainerMemoryLimit(mbc.Metrics.K8sContainerMemoryLimit),
-		metricK8sContainerMemoryRequest:           newMetricK8sContainerMemoryRequest(mbc.Metrics.K8sContainerMemoryRequest),
-		metricK8sContainerReady:                   newMetricK8sContainerReady(mbc.Metrics.K8sContainerReady),
-		metricK8sContainerRestarts:                newMetricK8sContainerRestarts(mbc.Metrics.K8sContainerRestarts),
-		metricK8sContainerStorageLimit:            newMetricK8sContainerStorageLimit(mbc.Metrics.K8sContainerStorageLimit),
-		metricK8sContainerStorageRequest:          newMetricK8sContainerStorageRequest(mbc.Metrics.K8sContainerStorageRequest),
-		metricK8sCronjobActiveJobs:                newMetricK8sCronjobActiveJobs(mbc.Metrics.K8sCronjobActiveJobs),
-		metricK8sDaemonsetCurrentScheduledNodes:   newMetricK8sDaemonsetCurrentScheduledNodes(mbc.Metrics.K8sDaemonsetCurrentScheduledNodes),
-		metricK8sDaemonsetDesiredScheduledNodes:   newMetricK8sDaemonsetDesiredScheduledNodes(mbc.Metrics.K8sDaemonsetDesiredScheduledNodes),
-		metricK8sDaemonsetMisscheduledNodes:       newMetricK8sDaemonsetMisscheduledNodes(mbc.Metrics.K8sDaemonsetMisscheduledNodes),
-		metricK8sDaemonsetReadyNodes:              newMetricK8sDaemonsetReadyNodes(mbc.Metrics.K8sDaemonsetReadyNodes),
-		metricK8sDeploymentAvailable:              newMetricK8sDeploymentAvailable(mbc.Metrics.K8sDeploymentAvailable),
-		metricK8sDeploymentDesired:                newMetricK8sDeploymentDesired(mbc.Metrics.K8sDeploymentDesired),
-		metricK8sHpaCurrentReplicas:               newMetricK8sHpaCurrentReplicas(mbc.Metrics.K8sHpaCurrentReplicas),
-		metricK8sHpaDesiredReplicas:               newMetricK8sHpaDesiredReplicas(mbc.Metrics.K8sHpaDesiredReplicas),
-		metricK8sHpaMaxReplicas:                   newMetricK8sHpaMaxReplicas(mbc.Metrics.K8sHpaMaxReplicas),
-		metricK8sHpaMinReplicas:                   newMetricK8sHpaMinReplicas(mbc.Metrics.K8sHpaMinReplicas),
-		metricK8sJobActivePods:                    newMetricK8sJobActivePods(mbc.Metrics.K8sJobActivePods),
-		metricK8sJobDesiredSuccessfulPods:         newMetricK8sJobDesiredSuccessfulPods(mbc.Metrics.K8sJobDesiredSuccessfulPods),
-		metricK8sJobFailedPods:                    newMetricK8sJobFailedPods(mbc.Metrics.K8sJobFailedPods),
-		metricK8sJobMaxParallelPods:               newMetricK8sJobMaxParallelPods(mbc.Metrics.K8sJobMaxParallelPods),
-		metricK8sJobSuccessfulPods:                newMetricK8sJobSuccessfulPods(mbc.Metrics.K8sJobSuccessfulPods),
-		metricK8sNamespacePhase:                   newMetricK8sNamespacePhase(mbc.Metrics.K8sNamespacePhase),
-		metricK8sNodeCondition:                    newMetricK8sNodeCondition(mbc.Metrics.K8sNodeCondition),
-		metricK8sPodPhase:                         newMetricK8sPodPhase(mbc.Metrics.K8sPodPhase),
-		metricK8sPodStatusReason:                  newMetricK8sPodStatusReason(mbc.Metrics.K8sPodStatusReason),
-		metricK8sReplicasetAvailable:              newMetricK8sReplicasetAvailable(mbc.Metrics.K8sReplicasetAvailable),
-		metricK8sReplicasetDesired:                newMetricK8sReplicasetDesired(mbc.Metrics.K8sReplicasetDesired),
-		metricK8sReplicationControllerAvailable:   newMetricK8sReplicationControllerAvailable(mbc.Metrics.K8sReplicationControllerAvailable),
-		metricK8sReplicationControllerDesired:     newMetricK8sReplicationControllerDesired(mbc.Metrics.K8sReplicationControllerDesired),
-		metricK8sResourceQuotaHardLimit:           newMetricK8sResourceQuotaHardLimit(mbc.Metrics.K8sResourceQuotaHardLimit),
-		metricK8sResourceQuotaUsed:                newMetricK8sResourceQuotaUsed(mbc.Metrics.K8sResourceQuotaUsed),
-		metricK8sStatefulsetCurrentPods:           newMetricK8sStatefulsetCurrentPods(mbc.Metrics.K8sStatefulsetCurrentPods),
-		metricK8sStatefulsetDesiredPods:           newMetricK8sStatefulsetDesiredPods(mbc.Metrics.K8sStatefulsetDesiredPods),
-		metricK8sStatefulsetReadyPods:             newMetricK8sStatefulsetReadyPods(mbc.Metrics.K8sStatefulsetReadyPods),
-		metricK8sStatefulsetUpdatedPods:           newMetricK8sStatefulsetUpdatedPods(mbc.Metrics.K8sStatefulsetUpdatedPods),
-		metricOpenshiftAppliedclusterquotaLimit:   newMetricOpenshiftAppliedclusterquotaLimit(mbc.Metrics.OpenshiftAppliedclusterquotaLimit),
-		metricOpenshiftAppliedclusterquotaUsed:    newMetricOpenshiftAppliedclusterquotaUsed(mbc.Metrics.OpenshiftAppliedclusterquotaUsed),
-		metricOpenshiftClusterquotaLimit:          newMetricOpenshiftClusterquotaLimit(mbc.Metrics.OpenshiftClusterquotaLimit),
-		metricOpenshiftClusterquotaUsed:           newMetricOpenshiftClusterquotaUsed(mbc.Metrics.OpenshiftClusterquotaUsed),
+		config:                                         mbc,
+		startTime:                                      pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                                  pmetric.NewMetrics(),
+		buildInfo:                                      settings.BuildInfo,
+		metricK8sClusterPodCount:                       newMetricK8sClusterPodCount(mbc.Metrics.K8sClusterPodCount),
+		metricK8sContainerCPULimit:                     newMetricK8sContainerCPULimit(mbc.Metrics.K8sContainerCPULimit),
+		metricK8sContainerCPULimitRatio:                newMetricK8sContainerCPULimitRatio(mbc.Metrics.K8sContainerCPULimitRatio),
+		metricK8sContainerCPURequest:                   newMetricK8sContainerCPURequest(mbc.Metrics.K8sContainerCPURequest),
+		metricK8sContainerCPURequestNodeFraction:       newMetricK8sContainerCPURequestNodeFraction(mbc.Metrics.K8sContainerCPURequestNodeFraction),
+		metricK8sContainerEphemeralstorageLimit:        newMetricK8sContainerEphemeralstorageLimit(mbc.Metrics.K8sContainerEphemeralstorageLimit),
+		metricK8sContainerEphemeralstorageRequest:      newMetricK8sContainerEphemeralstorageRequest(mbc.Metrics.K8sContainerEphemeralstorageRequest),
+		metricK8sContainerLastExitCode:                 newMetricK8sContainerLastExitCode(mbc.Metrics.K8sContainerLastExitCode),
+		metricK8sContainerLastTerminationReason:        newMetricK8sContainerLastTerminationReason(mbc.Metrics.K8sContainerLastTerminationReason),
+		metricK8sContainerMemoryLimit:                  newMetricK8sContainerMemoryLimit(mbc.Metrics.K8sContainerMemoryLimit),
+		metricK8sContainerMemoryLimitRatio:             newMetricK8sContainerMemoryLimitRatio(mbc.Metrics.K8sContainerMemoryLimitRatio),
+		metricK8sContainerMemoryRequest:                newMetricK8sContainerMemoryRequest(mbc.Metrics.K8sContainerMemoryRequest),
+		metricK8sContainerMemoryRequestNodeFraction:    newMetricK8sContainerMemoryRequestNodeFraction(mbc.Metrics.K8sContainerMemoryRequestNodeFraction),
+		metricK8sContainerReady:                        newMetricK8sContainerReady(mbc.Metrics.K8sContainerReady),
+		metricK8sContainerRestarts:                     newMetricK8sContainerRestarts(mbc.Metrics.K8sContainerRestarts),
+		metricK8sContainerStarted:                      newMetricK8sContainerStarted(mbc.Metrics.K8sContainerStarted),
+		metricK8sContainerStorageLimit:                 newMetricK8sContainerStorageLimit(mbc.Metrics.K8sContainerStorageLimit),
+		metricK8sContainerStorageRequest:               newMetricK8sContainerStorageRequest(mbc.Metrics.K8sContainerStorageRequest),
+		metricK8sContainerWaiting:                      newMetricK8sContainerWaiting(mbc.Metrics.K8sContainerWaiting),
+		metricK8sCronjobActiveJobs:                     newMetricK8sCronjobActiveJobs(mbc.Metrics.K8sCronjobActiveJobs),
+		metricK8sCronjobLastScheduleAge:                newMetricK8sCronjobLastScheduleAge(mbc.Metrics.K8sCronjobLastScheduleAge),
+		metricK8sCronjobSuspended:                      newMetricK8sCronjobSuspended(mbc.Metrics.K8sCronjobSuspended),
+		metricK8sCustomResourceCount:                   newMetricK8sCustomResourceCount(mbc.Metrics.K8sCustomResourceCount),
+		metricK8sDaemonsetCurrentScheduledNodes:        newMetricK8sDaemonsetCurrentScheduledNodes(mbc.Metrics.K8sDaemonsetCurrentScheduledNodes),
+		metricK8sDaemonsetDesiredScheduledNodes:        newMetricK8sDaemonsetDesiredScheduledNodes(mbc.Metrics.K8sDaemonsetDesiredScheduledNodes),
+		metricK8sDaemonsetGenerationSkew:               newMetricK8sDaemonsetGenerationSkew(mbc.Metrics.K8sDaemonsetGenerationSkew),
+		metricK8sDaemonsetMisscheduledNodes:            newMetricK8sDaemonsetMisscheduledNodes(mbc.Metrics.K8sDaemonsetMisscheduledNodes),
+		metricK8sDaemonsetReadyNodes:                   newMetricK8sDaemonsetReadyNodes(mbc.Metrics.K8sDaemonsetReadyNodes),
+		metricK8sDaemonsetReplicasUnavailable:          newMetricK8sDaemonsetReplicasUnavailable(mbc.Metrics.K8sDaemonsetReplicasUnavailable),
+		metricK8sDaemonsetUnavailableNodes:             newMetricK8sDaemonsetUnavailableNodes(mbc.Metrics.K8sDaemonsetUnavailableNodes),
+		metricK8sDaemonsetUpdatedRatio:                 newMetricK8sDaemonsetUpdatedRatio(mbc.Metrics.K8sDaemonsetUpdatedRatio),
+		metricK8sDeploymentAvailable:                   newMetricK8sDeploymentAvailable(mbc.Metrics.K8sDeploymentAvailable),
+		metricK8sDeploymentCollisionCount:              newMetricK8sDeploymentCollisionCount(mbc.Metrics.K8sDeploymentCollisionCount),
+		metricK8sDeploymentCondition:                   newMetricK8sDeploymentCondition(mbc.Metrics.K8sDeploymentCondition),
+		metricK8sDeploymentDesired:                     newMetricK8sDeploymentDesired(mbc.Metrics.K8sDeploymentDesired),
+		metricK8sDeploymentGenerationSkew:              newMetricK8sDeploymentGenerationSkew(mbc.Metrics.K8sDeploymentGenerationSkew),
+		metricK8sDeploymentPaused:                      newMetricK8sDeploymentPaused(mbc.Metrics.K8sDeploymentPaused),
+		metricK8sDeploymentReplicasUnavailable:         newMetricK8sDeploymentReplicasUnavailable(mbc.Metrics.K8sDeploymentReplicasUnavailable),
+		metricK8sEndpointsAddressCount:                 newMetricK8sEndpointsAddressCount(mbc.Metrics.K8sEndpointsAddressCount),
+		metricK8sEndpointsNotReadyAddressCount:         newMetricK8sEndpointsNotReadyAddressCount(mbc.Metrics.K8sEndpointsNotReadyAddressCount),
+		metricK8sEndpointsliceAddressCount:             newMetricK8sEndpointsliceAddressCount(mbc.Metrics.K8sEndpointsliceAddressCount),
+		metricK8sEndpointsliceReadyCount:               newMetricK8sEndpointsliceReadyCount(mbc.Metrics.K8sEndpointsliceReadyCount),
+		metricK8sHpaCondition:                          newMetricK8sHpaCondition(mbc.Metrics.K8sHpaCondition),
+		metricK8sHpaCurrentMetricValue:                 newMetricK8sHpaCurrentMetricValue(mbc.Metrics.K8sHpaCurrentMetricValue),
+		metricK8sHpaCurrentReplicas:                    newMetricK8sHpaCurrentReplicas(mbc.Metrics.K8sHpaCurrentReplicas),
+		metricK8sHpaDesiredReplicas:                    newMetricK8sHpaDesiredReplicas(mbc.Metrics.K8sHpaDesiredReplicas),
+		metricK8sHpaExternalCurrentValue:               newMetricK8sHpaExternalCurrentValue(mbc.Metrics.K8sHpaExternalCurrentValue),
+		metricK8sHpaExternalTargetValue:                newMetricK8sHpaExternalTargetValue(mbc.Metrics.K8sHpaExternalTargetValue),
+		metricK8sHpaMaxReplicas:                        newMetricK8sHpaMaxReplicas(mbc.Metrics.K8sHpaMaxReplicas),
+		metricK8sHpaMinReplicas:                        newMetricK8sHpaMinReplicas(mbc.Metrics.K8sHpaMinReplicas),
+		metricK8sHpaTargetMetricValue:                  newMetricK8sHpaTargetMetricValue(mbc.Metrics.K8sHpaTargetMetricValue),
+		metricK8sIngressRuleCount:                      newMetricK8sIngressRuleCount(mbc.Metrics.K8sIngressRuleCount),
+		metricK8sIngressTLSCount:                       newMetricK8sIngressTLSCount(mbc.Metrics.K8sIngressTLSCount),
+		metricK8sJobActivePods:                         newMetricK8sJobActivePods(mbc.Metrics.K8sJobActivePods),
+		metricK8sJobBackoffLimit:                       newMetricK8sJobBackoffLimit(mbc.Metrics.K8sJobBackoffLimit),
+		metricK8sJobCompletedIndexesCount:              newMetricK8sJobCompletedIndexesCount(mbc.Metrics.K8sJobCompletedIndexesCount),
+		metricK8sJobDesiredSuccessfulPods:              newMetricK8sJobDesiredSuccessfulPods(mbc.Metrics.K8sJobDesiredSuccessfulPods),
+		metricK8sJobDuration:                           newMetricK8sJobDuration(mbc.Metrics.K8sJobDuration),
+		metricK8sJobFailedExhausted:                    newMetricK8sJobFailedExhausted(mbc.Metrics.K8sJobFailedExhausted),
+		metricK8sJobFailedPods:                         newMetricK8sJobFailedPods(mbc.Metrics.K8sJobFailedPods),
+		metricK8sJobMaxParallelPods:                    newMetricK8sJobMaxParallelPods(mbc.Metrics.K8sJobMaxParallelPods),
+		metricK8sJobSuccessfulPods:                     newMetricK8sJobSuccessfulPods(mbc.Metrics.K8sJobSuccessfulPods),
+		metricK8sLimitrangeDefaultCPURequest:           newMetricK8sLimitrangeDefaultCPURequest(mbc.Metrics.K8sLimitrangeDefaultCPURequest),
+		metricK8sLimitrangeDefaultMemoryRequest:        newMetricK8sLimitrangeDefaultMemoryRequest(mbc.Metrics.K8sLimitrangeDefaultMemoryRequest),
+		metricK8sLimitrangeMax:                         newMetricK8sLimitrangeMax(mbc.Metrics.K8sLimitrangeMax),
+		metricK8sLimitrangeMin:                         newMetricK8sLimitrangeMin(mbc.Metrics.K8sLimitrangeMin),
+		metricK8sNamespacePhase:                        newMetricK8sNamespacePhase(mbc.Metrics.K8sNamespacePhase),
+		metricK8sNamespaceSecretCount:                  newMetricK8sNamespaceSecretCount(mbc.Metrics.K8sNamespaceSecretCount),
+		metricK8sNamespaceServiceaccountCount:          newMetricK8sNamespaceServiceaccountCount(mbc.Metrics.K8sNamespaceServiceaccountCount),
+		metricK8sNodeAge:                               newMetricK8sNodeAge(mbc.Metrics.K8sNodeAge),
+		metricK8sNodeCondition:                         newMetricK8sNodeCondition(mbc.Metrics.K8sNodeCondition),
+		metricK8sNodeEvictionRisk:                      newMetricK8sNodeEvictionRisk(mbc.Metrics.K8sNodeEvictionRisk),
+		metricK8sNodeLeaseRenewAge:                     newMetricK8sNodeLeaseRenewAge(mbc.Metrics.K8sNodeLeaseRenewAge),
+		metricK8sNodePodCount:                          newMetricK8sNodePodCount(mbc.Metrics.K8sNodePodCount),
+		metricK8sNodeTaintCount:                        newMetricK8sNodeTaintCount(mbc.Metrics.K8sNodeTaintCount),
+		metricK8sNodeUnschedulable:                     newMetricK8sNodeUnschedulable(mbc.Metrics.K8sNodeUnschedulable),
+		metricK8sPdbCurrentHealthy:                     newMetricK8sPdbCurrentHealthy(mbc.Metrics.K8sPdbCurrentHealthy),
+		metricK8sPdbDesiredHealthy:                     newMetricK8sPdbDesiredHealthy(mbc.Metrics.K8sPdbDesiredHealthy),
+		metricK8sPdbDisruptionsAllowed:                 newMetricK8sPdbDisruptionsAllowed(mbc.Metrics.K8sPdbDisruptionsAllowed),
+		metricK8sPdbExpectedPods:                       newMetricK8sPdbExpectedPods(mbc.Metrics.K8sPdbExpectedPods),
+		metricK8sPersistentvolumeCapacity:              newMetricK8sPersistentvolumeCapacity(mbc.Metrics.K8sPersistentvolumeCapacity),
+		metricK8sPersistentvolumePhase:                 newMetricK8sPersistentvolumePhase(mbc.Metrics.K8sPersistentvolumePhase),
+		metricK8sPersistentvolumeclaimActualCapacity:   newMetricK8sPersistentvolumeclaimActualCapacity(mbc.Metrics.K8sPersistentvolumeclaimActualCapacity),
+		metricK8sPersistentvolumeclaimPhase:            newMetricK8sPersistentvolumeclaimPhase(mbc.Metrics.K8sPersistentvolumeclaimPhase),
+		metricK8sPersistentvolumeclaimRequestedStorage: newMetricK8sPersistentvolumeclaimRequestedStorage(mbc.Metrics.K8sPersistentvolumeclaimRequestedStorage),
+		metricK8sPodAge:                                newMetricK8sPodAge(mbc.Metrics.K8sPodAge),
+		metricK8sPodCondition:                          newMetricK8sPodCondition(mbc.Metrics.K8sPodCondition),
+		metricK8sPodOrphaned:                           newMetricK8sPodOrphaned(mbc.Metrics.K8sPodOrphaned),
+		metricK8sPodPhase:                              newMetricK8sPodPhase(mbc.Metrics.K8sPodPhase),
+		metricK8sPodPriority:                           newMetricK8sPodPriority(mbc.Metrics.K8sPodPriority),
+		metricK8sPodSchedulingLatency:                  newMetricK8sPodSchedulingLatency(mbc.Metrics.K8sPodSchedulingLatency),
+		metricK8sPodStatusReason:                       newMetricK8sPodStatusReason(mbc.Metrics.K8sPodStatusReason),
+		metricK8sPodUnschedulable:                      newMetricK8sPodUnschedulable(mbc.Metrics.K8sPodUnschedulable),
+		metricK8sPriorityclassGlobalDefault:            newMetricK8sPriorityclassGlobalDefault(mbc.Metrics.K8sPriorityclassGlobalDefault),
+		metricK8sPriorityclassValue:                    newMetricK8sPriorityclassValue(mbc.Metrics.K8sPriorityclassValue),
+		metricK8sReplicasetAvailable:                   newMetricK8sReplicasetAvailable(mbc.Metrics.K8sReplicasetAvailable),
+		metricK8sReplicasetDesired:                     newMetricK8sReplicasetDesired(mbc.Metrics.K8sReplicasetDesired),
+		metricK8sReplicasetFullyLabeledReplicas:        newMetricK8sReplicasetFullyLabeledReplicas(mbc.Metrics.K8sReplicasetFullyLabeledReplicas),
+		metricK8sReplicationControllerAvailable:        newMetricK8sReplicationControllerAvailable(mbc.Metrics.K8sReplicationControllerAvailable),
+		metricK8sReplicationControllerDesired:          newMetricK8sReplicationControllerDesired(mbc.Metrics.K8sReplicationControllerDesired),
+		metricK8sResourceQuotaHardLimit:                newMetricK8sResourceQuotaHardLimit(mbc.Metrics.K8sResourceQuotaHardLimit),
+		metricK8sResourceQuotaUsed:                     newMetricK8sResourceQuotaUsed(mbc.Metrics.K8sResourceQuotaUsed),
+		metricK8sResourceQuotaUtilization:              newMetricK8sResourceQuotaUtilization(mbc.Metrics.K8sResourceQuotaUtilization),
+		metricK8sServicePortCount:                      newMetricK8sServicePortCount(mbc.Metrics.K8sServicePortCount),
+		metricK8sServiceType:                           newMetricK8sServiceType(mbc.Metrics.K8sServiceType),
+		metricK8sStatefulsetCollisionCount:             newMetricK8sStatefulsetCollisionCount(mbc.Metrics.K8sStatefulsetCollisionCount),
+		metricK8sStatefulsetCurrentPods:                newMetricK8sStatefulsetCurrentPods(mbc.Metrics.K8sStatefulsetCurrentPods),
+		metricK8sStatefulsetDesiredPods:                newMetricK8sStatefulsetDesiredPods(mbc.Metrics.K8sStatefulsetDesiredPods),
+		metricK8sStatefulsetGenerationSkew:             newMetricK8sStatefulsetGenerationSkew(mbc.Metrics.K8sStatefulsetGenerationSkew),
+		metricK8sStatefulsetReadyPods:                  newMetricK8sStatefulsetReadyPods(mbc.Metrics.K8sStatefulsetReadyPods),
+		metricK8sStatefulsetRevisionMismatch:           newMetricK8sStatefulsetRevisionMismatch(mbc.Metrics.K8sStatefulsetRevisionMismatch),
+		metricK8sStatefulsetUpdatePartition:            newMetricK8sStatefulsetUpdatePartition(mbc.Metrics.K8sStatefulsetUpdatePartition),
+		metricK8sStatefulsetUpdatedPods:                newMetricK8sStatefulsetUpdatedPods(mbc.Metrics.K8sStatefulsetUpdatedPods),
+		metricK8sStorageclassInfo:                      newMetricK8sStorageclassInfo(mbc.Metrics.K8sStorageclassInfo),
+		metricK8sVpaTargetCPU:                          newMetricK8sVpaTargetCPU(mbc.Metrics.K8sVpaTargetCPU),
+		metricK8sVpaTargetMemory:                       newMetricK8sVpaTargetMemory(mbc.Metrics.K8sVpaTargetMemory),
+		metricOpenshiftAppliedclusterquotaLimit:        newMetricOpenshiftAppliedclusterquotaLimit(mbc.Metrics.OpenshiftAppliedclusterquotaLimit),
+		metricOpenshiftAppliedclusterquotaUsed:         newMetricOpenshiftAppliedclusterquotaUsed(mbc.Metrics.OpenshiftAppliedclusterquotaUsed),
+		metricOpenshiftClusterquotaLimit:               newMetricOpenshiftClusterquotaLimit(mbc.Metrics.OpenshiftClusterquotaLimit),
+		metricOpenshiftClusterquotaUsed:                newMetricOpenshiftClusterquotaUsed(mbc.Metrics.OpenshiftClusterquotaUsed),
+		metricOpenshiftDeploymentconfigAvailable:       newMetricOpenshiftDeploymentconfigAvailable(mbc.Metrics.OpenshiftDeploymentconfigAvailable),
+		metricOpenshiftDeploymentconfigDesired:         newMetricOpenshiftDeploymentconfigDesired(mbc.Metrics.OpenshiftDeploymentconfigDesired),
+		metricOpenshiftDeploymentconfigReady:           newMetricOpenshiftDeploymentconfigReady(mbc.Metrics.OpenshiftDeploymentconfigReady),
+		metricOpenshiftDeploymentconfigUpdated:         newMetricOpenshiftDeploymentconfigUpdated(mbc.Metrics.OpenshiftDeploymentconfigUpdated),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2363,50 +6489,130 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/k8sclusterreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricK8sClusterPodCount.emit(ils.Metrics())
 	mb.metricK8sContainerCPULimit.emit(ils.Metrics())
+	mb.metricK8sContainerCPULimitRatio.emit(ils.Metrics())
 	mb.metricK8sContainerCPURequest.emit(ils.Metrics())
+	mb.metricK8sContainerCPURequestNodeFraction.emit(ils.Metrics())
 	mb.metricK8sContainerEphemeralstorageLimit.emit(ils.Metrics())
 	mb.metricK8sContainerEphemeralstorageRequest.emit(ils.Metrics())
+	mb.metricK8sContainerLastExitCode.emit(ils.Metrics())
+	mb.metricK8sContainerLastTerminationReason.emit(ils.Metrics())
 	mb.metricK8sContainerMemoryLimit.emit(ils.Metrics())
+	mb.metricK8sContainerMemoryLimitRatio.emit(ils.Metrics())
 	mb.metricK8sContainerMemoryRequest.emit(ils.Metrics())
+	mb.metricK8sContainerMemoryRequestNodeFraction.emit(ils.Metrics())
 	mb.metricK8sContainerReady.emit(ils.Metrics())
 	mb.metricK8sContainerRestarts.emit(ils.Metrics())
+	mb.metricK8sContainerStarted.emit(ils.Metrics())
 	mb.metricK8sContainerStorageLimit.emit(ils.Metrics())
 	mb.metricK8sContainerStorageRequest.emit(ils.Metrics())
+	mb.metricK8sContainerWaiting.emit(ils.Metrics())
 	mb.metricK8sCronjobActiveJobs.emit(ils.Metrics())
+	mb.metricK8sCronjobLastScheduleAge.emit(ils.Metrics())
+	mb.metricK8sCronjobSuspended.emit(ils.Metrics())
+	mb.metricK8sCustomResourceCount.emit(ils.Metrics())
 	mb.metricK8sDaemonsetCurrentScheduledNodes.emit(ils.Metrics())
 	mb.metricK8sDaemonsetDesiredScheduledNodes.emit(ils.Metrics())
+	mb.metricK8sDaemonsetGenerationSkew.emit(ils.Metrics())
 	mb.metricK8sDaemonsetMisscheduledNodes.emit(ils.Metrics())
 	mb.metricK8sDaemonsetReadyNodes.emit(ils.Metrics())
+	mb.metricK8sDaemonsetReplicasUnavailable.emit(ils.Metrics())
+	mb.metricK8sDaemonsetUnavailableNodes.emit(ils.Metrics())
+	mb.metricK8sDaemonsetUpdatedRatio.emit(ils.Metrics())
 	mb.metricK8sDeploymentAvailable.emit(ils.Metrics())
+	mb.metricK8sDeploymentCollisionCount.emit(ils.Metrics())
+	mb.metricK8sDeploymentCondition.emit(ils.Metrics())
 	mb.metricK8sDeploymentDesired.emit(ils.Metrics())
+	mb.metricK8sDeploymentGenerationSkew.emit(ils.Metrics())
+	mb.metricK8sDeploymentPaused.emit(ils.Metrics())
+	mb.metricK8sDeploymentReplicasUnavailable.emit(ils.Metrics())
+	mb.metricK8sEndpointsAddressCount.emit(ils.Metrics())
+	mb.metricK8sEndpointsNotReadyAddressCount.emit(ils.Metrics())
+	mb.metricK8sEndpointsliceAddressCount.emit(ils.Metrics())
+	mb.metricK8sEndpointsliceReadyCount.emit(ils.Metrics())
+	mb.metricK8sHpaCondition.emit(ils.Metrics())
+	mb.metricK8sHpaCurrentMetricValue.emit(ils.Metrics())
 	mb.metricK8sHpaCurrentReplicas.emit(ils.Metrics())
 	mb.metricK8sHpaDesiredReplicas.emit(ils.Metrics())
+	mb.metricK8sHpaExternalCurrentValue.emit(ils.Metrics())
+	mb.metricK8sHpaExternalTargetValue.emit(ils.Metrics())
 	mb.metricK8sHpaMaxReplicas.emit(ils.Metrics())
 	mb.metricK8sHpaMinReplicas.emit(ils.Metrics())
+	mb.metricK8sHpaTargetMetricValue.emit(ils.Metrics())
+	mb.metricK8sIngressRuleCount.emit(ils.Metrics())
+	mb.metricK8sIngressTLSCount.emit(ils.Metrics())
 	mb.metricK8sJobActivePods.emit(ils.Metrics())
+	mb.metricK8sJobBackoffLimit.emit(ils.Metrics())
+	mb.metricK8sJobCompletedIndexesCount.emit(ils.Metrics())
 	mb.metricK8sJobDesiredSuccessfulPods.emit(ils.Metrics())
+	mb.metricK8sJobDuration.emit(ils.Metrics())
+	mb.metricK8sJobFailedExhausted.emit(ils.Metrics())
 	mb.metricK8sJobFailedPods.emit(ils.Metrics())
 	mb.metricK8sJobMaxParallelPods.emit(ils.Metrics())
 	mb.metricK8sJobSuccessfulPods.emit(ils.Metrics())
+	mb.metricK8sLimitrangeDefaultCPURequest.emit(ils.Metrics())
+	mb.metricK8sLimitrangeDefaultMemoryRequest.emit(ils.Metrics())
+	mb.metricK8sLimitrangeMax.emit(ils.Metrics())
+	mb.metricK8sLimitrangeMin.emit(ils.Metrics())
 	mb.metricK8sNamespacePhase.emit(ils.Metrics())
+	mb.metricK8sNamespaceSecretCount.emit(ils.Metrics())
+	mb.metricK8sNamespaceServiceaccountCount.emit(ils.Metrics())
+	mb.metricK8sNodeAge.emit(ils.Metrics())
 	mb.metricK8sNodeCondition.emit(ils.Metrics())
+	mb.metricK8sNodeEvictionRisk.emit(ils.Metrics())
+	mb.metricK8sNodeLeaseRenewAge.emit(ils.Metrics())
+	mb.metricK8sNodePodCount.emit(ils.Metrics())
+	mb.metricK8sNodeTaintCount.emit(ils.Metrics())
+	mb.metricK8sNodeUnschedulable.emit(ils.Metrics())
+	mb.metricK8sPdbCurrentHealthy.emit(ils.Metrics())
+	mb.metricK8sPdbDesiredHealthy.emit(ils.Metrics())
+	mb.metricK8sPdbDisruptionsAllowed.emit(ils.Metrics())
+	mb.metricK8sPdbExpectedPods.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeCapacity.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumePhase.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimActualCapacity.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimPhase.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimRequestedStorage.emit(ils.Metrics())
+	mb.metricK8sPodAge.emit(ils.Metrics())
+	mb.metricK8sPodCondition.emit(ils.Metrics())
+	mb.metricK8sPodOrphaned.emit(ils.Metrics())
 	mb.metricK8sPodPhase.emit(ils.Metrics())
+	mb.metricK8sPodPriority.emit(ils.Metrics())
+	mb.metricK8sPodSchedulingLatency.emit(ils.Metrics())
 	mb.metricK8sPodStatusReason.emit(ils.Metrics())
+	mb.metricK8sPodUnschedulable.emit(ils.Metrics())
+	mb.metricK8sPriorityclassGlobalDefault.emit(ils.Metrics())
+	mb.metricK8sPriorityclassValue.emit(ils.Metrics())
 	mb.metricK8sReplicasetAvailable.emit(ils.Metrics())
 	mb.metricK8sReplicasetDesired.emit(ils.Metrics())
+	mb.metricK8sReplicasetFullyLabeledReplicas.emit(ils.Metrics())
 	mb.metricK8sReplicationControllerAvailable.emit(ils.Metrics())
 	mb.metricK8sReplicationControllerDesired.emit(ils.Metrics())
 	mb.metricK8sResourceQuotaHardLimit.emit(ils.Metrics())
 	mb.metricK8sResourceQuotaUsed.emit(ils.Metrics())
+	mb.metricK8sResourceQuotaUtilization.emit(ils.Metrics())
+	mb.metricK8sServicePortCount.emit(ils.Metrics())
+	mb.metricK8sServiceType.emit(ils.Metrics())
+	mb.metricK8sStatefulsetCollisionCount.emit(ils.Metrics())
 	mb.metricK8sStatefulsetCurrentPods.emit(ils.Metrics())
 	mb.metricK8sStatefulsetDesiredPods.emit(ils.Metrics())
+	mb.metricK8sStatefulsetGenerationSkew.emit(ils.Metrics())
 	mb.metricK8sStatefulsetReadyPods.emit(ils.Metrics())
+	mb.metricK8sStatefulsetRevisionMismatch.emit(ils.Metrics())
+	mb.metricK8sStatefulsetUpdatePartition.emit(ils.Metrics())
 	mb.metricK8sStatefulsetUpdatedPods.emit(ils.Metrics())
+	mb.metricK8sStorageclassInfo.emit(ils.Metrics())
+	mb.metricK8sVpaTargetCPU.emit(ils.Metrics())
+	mb.metricK8sVpaTargetMemory.emit(ils.Metrics())
 	mb.metricOpenshiftAppliedclusterquotaLimit.emit(ils.Metrics())
 	mb.metricOpenshiftAppliedclusterquotaUsed.emit(ils.Metrics())
 	mb.metricOpenshiftClusterquotaLimit.emit(ils.Metrics())
 	mb.metricOpenshiftClusterquotaUsed.emit(ils.Metrics())
+	mb.metricOpenshiftDeploymentconfigAvailable.emit(ils.Metrics())
+	mb.metricOpenshiftDeploymentconfigDesired.emit(ils.Metrics())
+	mb.metricOpenshiftDeploymentconfigReady.emit(ils.Metrics())
+	mb.metricOpenshiftDeploymentconfigUpdated.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -2427,16 +6633,31 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordK8sClusterPodCountDataPoint adds a data point to k8s.cluster.pod.count metric.
+func (mb *MetricsBuilder) RecordK8sClusterPodCountDataPoint(ts pcommon.Timestamp, val int64, phaseAttributeValue string) {
+	mb.metricK8sClusterPodCount.recordDataPoint(mb.startTime, ts, val, phaseAttributeValue)
+}
+
 // RecordK8sContainerCPULimitDataPoint adds a data point to k8s.container.cpu_limit metric.
 func (mb *MetricsBuilder) RecordK8sContainerCPULimitDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricK8sContainerCPULimit.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerCPULimitRatioDataPoint adds a data point to k8s.container.cpu_limit_ratio metric.
+func (mb *MetricsBuilder) RecordK8sContainerCPULimitRatioDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sContainerCPULimitRatio.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sContainerCPURequestDataPoint adds a data point to k8s.container.cpu_request metric.
 func (mb *MetricsBuilder) RecordK8sContainerCPURequestDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricK8sContainerCPURequest.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerCPURequestNodeFractionDataPoint adds a data point to k8s.container.cpu_request_node_fraction metric.
+func (mb *MetricsBuilder) RecordK8sContainerCPURequestNodeFractionDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sContainerCPURequestNodeFraction.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sContainerEphemeralstorageLimitDataPoint adds a data point to k8s.container.ephemeralstorage_limit metric.
 func (mb *MetricsBuilder) RecordK8sContainerEphemeralstorageLimitDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sContainerEphemeralstorageLimit.recordDataPoint(mb.startTime, ts, val)
@@ -2447,16 +6668,36 @@ func (mb *MetricsBuilder) RecordK8sContainerEphemeralstorageRequestDataPoint(ts
 	mb.metricK8sContainerEphemeralstorageRequest.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerLastExitCodeDataPoint adds a data point to k8s.container.last_exit_code metric.
+func (mb *MetricsBuilder) RecordK8sContainerLastExitCodeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sContainerLastExitCode.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sContainerLastTerminationReasonDataPoint adds a data point to k8s.container.last_termination_reason metric.
+func (mb *MetricsBuilder) RecordK8sContainerLastTerminationReasonDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sContainerLastTerminationReason.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sContainerMemoryLimitDataPoint adds a data point to k8s.container.memory_limit metric.
 func (mb *MetricsBuilder) RecordK8sContainerMemoryLimitDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sContainerMemoryLimit.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerMemoryLimitRatioDataPoint adds a data point to k8s.container.memory_limit_ratio metric.
+func (mb *MetricsBuilder) RecordK8sContainerMemoryLimitRatioDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sContainerMemoryLimitRatio.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sContainerMemoryRequestDataPoint adds a data point to k8s.container.memory_request metric.
 func (mb *MetricsBuilder) RecordK8sContainerMemoryRequestDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sContainerMemoryRequest.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerMemoryRequestNodeFractionDataPoint adds a data point to k8s.container.memory_request_node_fraction metric.
+func (mb *MetricsBuilder) RecordK8sContainerMemoryRequestNodeFractionDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sContainerMemoryRequestNodeFraction.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sContainerReadyDataPoint adds a data point to k8s.container.ready metric.
 func (mb *MetricsBuilder) RecordK8sContainerReadyDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sContainerReady.recordDataPoint(mb.startTime, ts, val)
@@ -2467,6 +6708,11 @@ func (mb *MetricsBuilder) RecordK8sContainerRestartsDataPoint(ts pcommon.Timesta
 	mb.metricK8sContainerRestarts.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerStartedDataPoint adds a data point to k8s.container.started metric.
+func (mb *MetricsBuilder) RecordK8sContainerStartedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sContainerStarted.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sContainerStorageLimitDataPoint adds a data point to k8s.container.storage_limit metric.
 func (mb *MetricsBuilder) RecordK8sContainerStorageLimitDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sContainerStorageLimit.recordDataPoint(mb.startTime, ts, val)
@@ -2477,11 +6723,31 @@ func (mb *MetricsBuilder) RecordK8sContainerStorageRequestDataPoint(ts pcommon.T
 	mb.metricK8sContainerStorageRequest.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerWaitingDataPoint adds a data point to k8s.container.waiting metric.
+func (mb *MetricsBuilder) RecordK8sContainerWaitingDataPoint(ts pcommon.Timestamp, val int64, reasonAttributeValue string) {
+	mb.metricK8sContainerWaiting.recordDataPoint(mb.startTime, ts, val, reasonAttributeValue)
+}
+
 // RecordK8sCronjobActiveJobsDataPoint adds a data point to k8s.cronjob.active_jobs metric.
 func (mb *MetricsBuilder) RecordK8sCronjobActiveJobsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sCronjobActiveJobs.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sCronjobLastScheduleAgeDataPoint adds a data point to k8s.cronjob.last_schedule_age metric.
+func (mb *MetricsBuilder) RecordK8sCronjobLastScheduleAgeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sCronjobLastScheduleAge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sCronjobSuspendedDataPoint adds a data point to k8s.cronjob.suspended metric.
+func (mb *MetricsBuilder) RecordK8sCronjobSuspendedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sCronjobSuspended.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sCustomResourceCountDataPoint adds a data point to k8s.custom_resource.count metric.
+func (mb *MetricsBuilder) RecordK8sCustomResourceCountDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, versionAttributeValue string, kindAttributeValue string) {
+	mb.metricK8sCustomResourceCount.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, versionAttributeValue, kindAttributeValue)
+}
+
 // RecordK8sDaemonsetCurrentScheduledNodesDataPoint adds a data point to k8s.daemonset.current_scheduled_nodes metric.
 func (mb *MetricsBuilder) RecordK8sDaemonsetCurrentScheduledNodesDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sDaemonsetCurrentScheduledNodes.recordDataPoint(mb.startTime, ts, val)
@@ -2492,6 +6758,11 @@ func (mb *MetricsBuilder) RecordK8sDaemonsetDesiredScheduledNodesDataPoint(ts pc
 	mb.metricK8sDaemonsetDesiredScheduledNodes.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sDaemonsetGenerationSkewDataPoint adds a data point to k8s.daemonset.generation_skew metric.
+func (mb *MetricsBuilder) RecordK8sDaemonsetGenerationSkewDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sDaemonsetGenerationSkew.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sDaemonsetMisscheduledNodesDataPoint adds a data point to k8s.daemonset.misscheduled_nodes metric.
 func (mb *MetricsBuilder) RecordK8sDaemonsetMisscheduledNodesDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sDaemonsetMisscheduledNodes.recordDataPoint(mb.startTime, ts, val)
@@ -2502,16 +6773,86 @@ func (mb *MetricsBuilder) RecordK8sDaemonsetReadyNodesDataPoint(ts pcommon.Times
 	mb.metricK8sDaemonsetReadyNodes.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sDaemonsetReplicasUnavailableDataPoint adds a data point to k8s.daemonset.replicas_unavailable metric.
+func (mb *MetricsBuilder) RecordK8sDaemonsetReplicasUnavailableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sDaemonsetReplicasUnavailable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sDaemonsetUnavailableNodesDataPoint adds a data point to k8s.daemonset.unavailable_nodes metric.
+func (mb *MetricsBuilder) RecordK8sDaemonsetUnavailableNodesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sDaemonsetUnavailableNodes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sDaemonsetUpdatedRatioDataPoint adds a data point to k8s.daemonset.updated_ratio metric.
+func (mb *MetricsBuilder) RecordK8sDaemonsetUpdatedRatioDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sDaemonsetUpdatedRatio.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sDeploymentAvailableDataPoint adds a data point to k8s.deployment.available metric.
 func (mb *MetricsBuilder) RecordK8sDeploymentAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sDeploymentAvailable.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sDeploymentCollisionCountDataPoint adds a data point to k8s.deployment.collision_count metric.
+func (mb *MetricsBuilder) RecordK8sDeploymentCollisionCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sDeploymentCollisionCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sDeploymentConditionDataPoint adds a data point to k8s.deployment.condition metric.
+func (mb *MetricsBuilder) RecordK8sDeploymentConditionDataPoint(ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	mb.metricK8sDeploymentCondition.recordDataPoint(mb.startTime, ts, val, conditionAttributeValue)
+}
+
 // RecordK8sDeploymentDesiredDataPoint adds a data point to k8s.deployment.desired metric.
 func (mb *MetricsBuilder) RecordK8sDeploymentDesiredDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sDeploymentDesired.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sDeploymentGenerationSkewDataPoint adds a data point to k8s.deployment.generation_skew metric.
+func (mb *MetricsBuilder) RecordK8sDeploymentGenerationSkewDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sDeploymentGenerationSkew.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sDeploymentPausedDataPoint adds a data point to k8s.deployment.paused metric.
+func (mb *MetricsBuilder) RecordK8sDeploymentPausedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sDeploymentPaused.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sDeploymentReplicasUnavailableDataPoint adds a data point to k8s.deployment.replicas_unavailable metric.
+func (mb *MetricsBuilder) RecordK8sDeploymentReplicasUnavailableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sDeploymentReplicasUnavailable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sEndpointsAddressCountDataPoint adds a data point to k8s.endpoints.address.count metric.
+func (mb *MetricsBuilder) RecordK8sEndpointsAddressCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sEndpointsAddressCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sEndpointsNotReadyAddressCountDataPoint adds a data point to k8s.endpoints.not_ready_address.count metric.
+func (mb *MetricsBuilder) RecordK8sEndpointsNotReadyAddressCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sEndpointsNotReadyAddressCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sEndpointsliceAddressCountDataPoint adds a data point to k8s.endpointslice.address.count metric.
+func (mb *MetricsBuilder) RecordK8sEndpointsliceAddressCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sEndpointsliceAddressCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sEndpointsliceReadyCountDataPoint adds a data point to k8s.endpointslice.ready.count metric.
+func (mb *MetricsBuilder) RecordK8sEndpointsliceReadyCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sEndpointsliceReadyCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sHpaConditionDataPoint adds a data point to k8s.hpa.condition metric.
+func (mb *MetricsBuilder) RecordK8sHpaConditionDataPoint(ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	mb.metricK8sHpaCondition.recordDataPoint(mb.startTime, ts, val, conditionAttributeValue)
+}
+
+// RecordK8sHpaCurrentMetricValueDataPoint adds a data point to k8s.hpa.current_metric_value metric.
+func (mb *MetricsBuilder) RecordK8sHpaCurrentMetricValueDataPoint(ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricTypeAttributeValue string) {
+	mb.metricK8sHpaCurrentMetricValue.recordDataPoint(mb.startTime, ts, val, hpaMetricNameAttributeValue, hpaMetricTypeAttributeValue)
+}
+
 // RecordK8sHpaCurrentReplicasDataPoint adds a data point to k8s.hpa.current_replicas metric.
 func (mb *MetricsBuilder) RecordK8sHpaCurrentReplicasDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sHpaCurrentReplicas.recordDataPoint(mb.startTime, ts, val)
@@ -2522,6 +6863,16 @@ func (mb *MetricsBuilder) RecordK8sHpaDesiredReplicasDataPoint(ts pcommon.Timest
 	mb.metricK8sHpaDesiredReplicas.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sHpaExternalCurrentValueDataPoint adds a data point to k8s.hpa.external_current_value metric.
+func (mb *MetricsBuilder) RecordK8sHpaExternalCurrentValueDataPoint(ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricSelectorAttributeValue string) {
+	mb.metricK8sHpaExternalCurrentValue.recordDataPoint(mb.startTime, ts, val, hpaMetricNameAttributeValue, hpaMetricSelectorAttributeValue)
+}
+
+// RecordK8sHpaExternalTargetValueDataPoint adds a data point to k8s.hpa.external_target_value metric.
+func (mb *MetricsBuilder) RecordK8sHpaExternalTargetValueDataPoint(ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricSelectorAttributeValue string) {
+	mb.metricK8sHpaExternalTargetValue.recordDataPoint(mb.startTime, ts, val, hpaMetricNameAttributeValue, hpaMetricSelectorAttributeValue)
+}
+
 // RecordK8sHpaMaxReplicasDataPoint adds a data point to k8s.hpa.max_replicas metric.
 func (mb *MetricsBuilder) RecordK8sHpaMaxReplicasDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sHpaMaxReplicas.recordDataPoint(mb.startTime, ts, val)
@@ -2532,16 +6883,51 @@ func (mb *MetricsBuilder) RecordK8sHpaMinReplicasDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sHpaMinReplicas.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sHpaTargetMetricValueDataPoint adds a data point to k8s.hpa.target_metric_value metric.
+func (mb *MetricsBuilder) RecordK8sHpaTargetMetricValueDataPoint(ts pcommon.Timestamp, val float64, hpaMetricNameAttributeValue string, hpaMetricTypeAttributeValue string) {
+	mb.metricK8sHpaTargetMetricValue.recordDataPoint(mb.startTime, ts, val, hpaMetricNameAttributeValue, hpaMetricTypeAttributeValue)
+}
+
+// RecordK8sIngressRuleCountDataPoint adds a data point to k8s.ingress.rule.count metric.
+func (mb *MetricsBuilder) RecordK8sIngressRuleCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sIngressRuleCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sIngressTLSCountDataPoint adds a data point to k8s.ingress.tls.count metric.
+func (mb *MetricsBuilder) RecordK8sIngressTLSCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sIngressTLSCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sJobActivePodsDataPoint adds a data point to k8s.job.active_pods metric.
 func (mb *MetricsBuilder) RecordK8sJobActivePodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sJobActivePods.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sJobBackoffLimitDataPoint adds a data point to k8s.job.backoff_limit metric.
+func (mb *MetricsBuilder) RecordK8sJobBackoffLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sJobBackoffLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sJobCompletedIndexesCountDataPoint adds a data point to k8s.job.completed_indexes_count metric.
+func (mb *MetricsBuilder) RecordK8sJobCompletedIndexesCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sJobCompletedIndexesCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sJobDesiredSuccessfulPodsDataPoint adds a data point to k8s.job.desired_successful_pods metric.
 func (mb *MetricsBuilder) RecordK8sJobDesiredSuccessfulPodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sJobDesiredSuccessfulPods.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sJobDurationDataPoint adds a data point to k8s.job.duration metric.
+func (mb *MetricsBuilder) RecordK8sJobDurationDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sJobDuration.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sJobFailedExhaustedDataPoint adds a data point to k8s.job.failed_exhausted metric.
+func (mb *MetricsBuilder) RecordK8sJobFailedExhaustedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sJobFailedExhausted.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sJobFailedPodsDataPoint adds a data point to k8s.job.failed_pods metric.
 func (mb *MetricsBuilder) RecordK8sJobFailedPodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sJobFailedPods.recordDataPoint(mb.startTime, ts, val)
@@ -2557,26 +6943,171 @@ func (mb *MetricsBuilder) RecordK8sJobSuccessfulPodsDataPoint(ts pcommon.Timesta
 	mb.metricK8sJobSuccessfulPods.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sLimitrangeDefaultCPURequestDataPoint adds a data point to k8s.limitrange.default_cpu_request metric.
+func (mb *MetricsBuilder) RecordK8sLimitrangeDefaultCPURequestDataPoint(ts pcommon.Timestamp, val float64, limitTypeAttributeValue string) {
+	mb.metricK8sLimitrangeDefaultCPURequest.recordDataPoint(mb.startTime, ts, val, limitTypeAttributeValue)
+}
+
+// RecordK8sLimitrangeDefaultMemoryRequestDataPoint adds a data point to k8s.limitrange.default_memory_request metric.
+func (mb *MetricsBuilder) RecordK8sLimitrangeDefaultMemoryRequestDataPoint(ts pcommon.Timestamp, val int64, limitTypeAttributeValue string) {
+	mb.metricK8sLimitrangeDefaultMemoryRequest.recordDataPoint(mb.startTime, ts, val, limitTypeAttributeValue)
+}
+
+// RecordK8sLimitrangeMaxDataPoint adds a data point to k8s.limitrange.max metric.
+func (mb *MetricsBuilder) RecordK8sLimitrangeMaxDataPoint(ts pcommon.Timestamp, val int64, limitTypeAttributeValue string, resourceAttributeValue string) {
+	mb.metricK8sLimitrangeMax.recordDataPoint(mb.startTime, ts, val, limitTypeAttributeValue, resourceAttributeValue)
+}
+
+// RecordK8sLimitrangeMinDataPoint adds a data point to k8s.limitrange.min metric.
+func (mb *MetricsBuilder) RecordK8sLimitrangeMinDataPoint(ts pcommon.Timestamp, val int64, limitTypeAttributeValue string, resourceAttributeValue string) {
+	mb.metricK8sLimitrangeMin.recordDataPoint(mb.startTime, ts, val, limitTypeAttributeValue, resourceAttributeValue)
+}
+
 // RecordK8sNamespacePhaseDataPoint adds a data point to k8s.namespace.phase metric.
 func (mb *MetricsBuilder) RecordK8sNamespacePhaseDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sNamespacePhase.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sNamespaceSecretCountDataPoint adds a data point to k8s.namespace.secret.count metric.
+func (mb *MetricsBuilder) RecordK8sNamespaceSecretCountDataPoint(ts pcommon.Timestamp, val int64, secretTypeAttributeValue string) {
+	mb.metricK8sNamespaceSecretCount.recordDataPoint(mb.startTime, ts, val, secretTypeAttributeValue)
+}
+
+// RecordK8sNamespaceServiceaccountCountDataPoint adds a data point to k8s.namespace.serviceaccount.count metric.
+func (mb *MetricsBuilder) RecordK8sNamespaceServiceaccountCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNamespaceServiceaccountCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sNodeAgeDataPoint adds a data point to k8s.node.age metric.
+func (mb *MetricsBuilder) RecordK8sNodeAgeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodeAge.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sNodeConditionDataPoint adds a data point to k8s.node.condition metric.
 func (mb *MetricsBuilder) RecordK8sNodeConditionDataPoint(ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
 	mb.metricK8sNodeCondition.recordDataPoint(mb.startTime, ts, val, conditionAttributeValue)
 }
 
+// RecordK8sNodeEvictionRiskDataPoint adds a data point to k8s.node.eviction_risk metric.
+func (mb *MetricsBuilder) RecordK8sNodeEvictionRiskDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodeEvictionRisk.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sNodeLeaseRenewAgeDataPoint adds a data point to k8s.node.lease_renew_age metric.
+func (mb *MetricsBuilder) RecordK8sNodeLeaseRenewAgeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodeLeaseRenewAge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sNodePodCountDataPoint adds a data point to k8s.node.pod_count metric.
+func (mb *MetricsBuilder) RecordK8sNodePodCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodePodCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sNodeTaintCountDataPoint adds a data point to k8s.node.taint.count metric.
+func (mb *MetricsBuilder) RecordK8sNodeTaintCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodeTaintCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sNodeUnschedulableDataPoint adds a data point to k8s.node.unschedulable metric.
+func (mb *MetricsBuilder) RecordK8sNodeUnschedulableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodeUnschedulable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPdbCurrentHealthyDataPoint adds a data point to k8s.pdb.current_healthy metric.
+func (mb *MetricsBuilder) RecordK8sPdbCurrentHealthyDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPdbCurrentHealthy.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPdbDesiredHealthyDataPoint adds a data point to k8s.pdb.desired_healthy metric.
+func (mb *MetricsBuilder) RecordK8sPdbDesiredHealthyDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPdbDesiredHealthy.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPdbDisruptionsAllowedDataPoint adds a data point to k8s.pdb.disruptions_allowed metric.
+func (mb *MetricsBuilder) RecordK8sPdbDisruptionsAllowedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPdbDisruptionsAllowed.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPdbExpectedPodsDataPoint adds a data point to k8s.pdb.expected_pods metric.
+func (mb *MetricsBuilder) RecordK8sPdbExpectedPodsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPdbExpectedPods.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeCapacityDataPoint adds a data point to k8s.persistentvolume.capacity metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumePhaseDataPoint adds a data point to k8s.persistentvolume.phase metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumePhaseDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumePhase.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimActualCapacityDataPoint adds a data point to k8s.persistentvolumeclaim.actual_capacity metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimActualCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimActualCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimPhaseDataPoint adds a data point to k8s.persistentvolumeclaim.phase metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimPhaseDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimPhase.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimRequestedStorageDataPoint adds a data point to k8s.persistentvolumeclaim.requested_storage metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimRequestedStorageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimRequestedStorage.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPodAgeDataPoint adds a data point to k8s.pod.age metric.
+func (mb *MetricsBuilder) RecordK8sPodAgeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodAge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPodConditionDataPoint adds a data point to k8s.pod.condition metric.
+func (mb *MetricsBuilder) RecordK8sPodConditionDataPoint(ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	mb.metricK8sPodCondition.recordDataPoint(mb.startTime, ts, val, conditionAttributeValue)
+}
+
+// RecordK8sPodOrphanedDataPoint adds a data point to k8s.pod.orphaned metric.
+func (mb *MetricsBuilder) RecordK8sPodOrphanedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodOrphaned.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodPhaseDataPoint adds a data point to k8s.pod.phase metric.
 func (mb *MetricsBuilder) RecordK8sPodPhaseDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodPhase.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPodPriorityDataPoint adds a data point to k8s.pod.priority metric.
+func (mb *MetricsBuilder) RecordK8sPodPriorityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodPriority.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPodSchedulingLatencyDataPoint adds a data point to k8s.pod.scheduling_latency metric.
+func (mb *MetricsBuilder) RecordK8sPodSchedulingLatencyDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricK8sPodSchedulingLatency.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodStatusReasonDataPoint adds a data point to k8s.pod.status_reason metric.
 func (mb *MetricsBuilder) RecordK8sPodStatusReasonDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodStatusReason.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPodUnschedulableDataPoint adds a data point to k8s.pod.unschedulable metric.
+func (mb *MetricsBuilder) RecordK8sPodUnschedulableDataPoint(ts pcommon.Timestamp, val int64, reasonAttributeValue string) {
+	mb.metricK8sPodUnschedulable.recordDataPoint(mb.startTime, ts, val, reasonAttributeValue)
+}
+
+// RecordK8sPriorityclassGlobalDefaultDataPoint adds a data point to k8s.priorityclass.global_default metric.
+func (mb *MetricsBuilder) RecordK8sPriorityclassGlobalDefaultDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPriorityclassGlobalDefault.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPriorityclassValueDataPoint adds a data point to k8s.priorityclass.value metric.
+func (mb *MetricsBuilder) RecordK8sPriorityclassValueDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPriorityclassValue.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sReplicasetAvailableDataPoint adds a data point to k8s.replicaset.available metric.
 func (mb *MetricsBuilder) RecordK8sReplicasetAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sReplicasetAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -2587,6 +7118,11 @@ func (mb *MetricsBuilder) RecordK8sReplicasetDesiredDataPoint(ts pcommon.Timesta
 	mb.metricK8sReplicasetDesired.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sReplicasetFullyLabeledReplicasDataPoint adds a data point to k8s.replicaset.fully_labeled_replicas metric.
+func (mb *MetricsBuilder) RecordK8sReplicasetFullyLabeledReplicasDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sReplicasetFullyLabeledReplicas.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sReplicationControllerAvailableDataPoint adds a data point to k8s.replication_controller.available metric.
 func (mb *MetricsBuilder) RecordK8sReplicationControllerAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sReplicationControllerAvailable.recordDataPoint(mb.startTime, ts, val)
@@ -2607,6 +7143,26 @@ func (mb *MetricsBuilder) RecordK8sResourceQuotaUsedDataPoint(ts pcommon.Timesta
 	mb.metricK8sResourceQuotaUsed.recordDataPoint(mb.startTime, ts, val, resourceAttributeValue)
 }
 
+// RecordK8sResourceQuotaUtilizationDataPoint adds a data point to k8s.resource_quota.utilization metric.
+func (mb *MetricsBuilder) RecordK8sResourceQuotaUtilizationDataPoint(ts pcommon.Timestamp, val float64, resourceAttributeValue string) {
+	mb.metricK8sResourceQuotaUtilization.recordDataPoint(mb.startTime, ts, val, resourceAttributeValue)
+}
+
+// RecordK8sServicePortCountDataPoint adds a data point to k8s.service.port.count metric.
+func (mb *MetricsBuilder) RecordK8sServicePortCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sServicePortCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sServiceTypeDataPoint adds a data point to k8s.service.type metric.
+func (mb *MetricsBuilder) RecordK8sServiceTypeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sServiceType.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sStatefulsetCollisionCountDataPoint adds a data point to k8s.statefulset.collision_count metric.
+func (mb *MetricsBuilder) RecordK8sStatefulsetCollisionCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sStatefulsetCollisionCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sStatefulsetCurrentPodsDataPoint adds a data point to k8s.statefulset.current_pods metric.
 func (mb *MetricsBuilder) RecordK8sStatefulsetCurrentPodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sStatefulsetCurrentPods.recordDataPoint(mb.startTime, ts, val)
@@ -2617,16 +7173,46 @@ func (mb *MetricsBuilder) RecordK8sStatefulsetDesiredPodsDataPoint(ts pcommon.Ti
 	mb.metricK8sStatefulsetDesiredPods.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sStatefulsetGenerationSkewDataPoint adds a data point to k8s.statefulset.generation_skew metric.
+func (mb *MetricsBuilder) RecordK8sStatefulsetGenerationSkewDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sStatefulsetGenerationSkew.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sStatefulsetReadyPodsDataPoint adds a data point to k8s.statefulset.ready_pods metric.
 func (mb *MetricsBuilder) RecordK8sStatefulsetReadyPodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sStatefulsetReadyPods.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sStatefulsetRevisionMismatchDataPoint adds a data point to k8s.statefulset.revision_mismatch metric.
+func (mb *MetricsBuilder) RecordK8sStatefulsetRevisionMismatchDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sStatefulsetRevisionMismatch.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sStatefulsetUpdatePartitionDataPoint adds a data point to k8s.statefulset.update_partition metric.
+func (mb *MetricsBuilder) RecordK8sStatefulsetUpdatePartitionDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sStatefulsetUpdatePartition.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sStatefulsetUpdatedPodsDataPoint adds a data point to k8s.statefulset.updated_pods metric.
 func (mb *MetricsBuilder) RecordK8sStatefulsetUpdatedPodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sStatefulsetUpdatedPods.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sStorageclassInfoDataPoint adds a data point to k8s.storageclass.info metric.
+func (mb *MetricsBuilder) RecordK8sStorageclassInfoDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sStorageclassInfo.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sVpaTargetCPUDataPoint adds a data point to k8s.vpa.target_cpu metric.
+func (mb *MetricsBuilder) RecordK8sVpaTargetCPUDataPoint(ts pcommon.Timestamp, val float64, vpaContainerNameAttributeValue string) {
+	mb.metricK8sVpaTargetCPU.recordDataPoint(mb.startTime, ts, val, vpaContainerNameAttributeValue)
+}
+
+// RecordK8sVpaTargetMemoryDataPoint adds a data point to k8s.vpa.target_memory metric.
+func (mb *MetricsBuilder) RecordK8sVpaTargetMemoryDataPoint(ts pcommon.Timestamp, val int64, vpaContainerNameAttributeValue string) {
+	mb.metricK8sVpaTargetMemory.recordDataPoint(mb.startTime, ts, val, vpaContainerNameAttributeValue)
+}
+
 // RecordOpenshiftAppliedclusterquotaLimitDataPoint adds a data point to openshift.appliedclusterquota.limit metric.
 func (mb *MetricsBuilder) RecordOpenshiftAppliedclusterquotaLimitDataPoint(ts pcommon.Timestamp, val int64, k8sNamespaceNameAttributeValue string, resourceAttributeValue string) {
 	mb.metricOpenshiftAppliedclusterquotaLimit.recordDataPoint(mb.startTime, ts, val, k8sNamespaceNameAttributeValue, resourceAttributeValue)
@@ -2647,6 +7233,26 @@ func (mb *MetricsBuilder) RecordOpenshiftClusterquotaUsedDataPoint(ts pcommon.Ti
 	mb.metricOpenshiftClusterquotaUsed.recordDataPoint(mb.startTime, ts, val, resourceAttributeValue)
 }
 
+// RecordOpenshiftDeploymentconfigAvailableDataPoint adds a data point to openshift.deploymentconfig.available metric.
+func (mb *MetricsBuilder) RecordOpenshiftDeploymentconfigAvailableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricOpenshiftDeploymentconfigAvailable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordOpenshiftDeploymentconfigDesiredDataPoint adds a data point to openshift.deploymentconfig.desired metric.
+func (mb *MetricsBuilder) RecordOpenshiftDeploymentconfigDesiredDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricOpenshiftDeploymentconfigDesired.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordOpenshiftDeploymentconfigReadyDataPoint adds a data point to openshift.deploymentconfig.ready metric.
+func (mb *MetricsBuilder) RecordOpenshiftDeploymentconfigReadyDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricOpenshiftDeploymentconfigReady.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordOpenshiftDeploymentconfigUpdatedDataPoint adds a data point to openshift.deploymentconfig.updated metric.
+func (mb *MetricsBuilder) RecordOpenshiftDeploymentconfigUpdatedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricOpenshiftDeploymentconfigUpdated.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -59,14 +59,23 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordK8sClusterPodCountDataPoint(ts, 1, "phase-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sContainerCPULimitDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sContainerCPULimitRatioDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sContainerCPURequestDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sContainerCPURequestNodeFractionDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sContainerEphemeralstorageLimitDataPoint(ts, 1)
@@ -75,14 +84,26 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sContainerEphemeralstorageRequestDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sContainerLastExitCodeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sContainerLastTerminationReasonDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sContainerMemoryLimitDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sContainerMemoryLimitRatioDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sContainerMemoryRequestDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sContainerMemoryRequestNodeFractionDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sContainerReadyDataPoint(ts, 1)
@@ -91,6 +112,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sContainerRestartsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sContainerStartedDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sContainerStorageLimitDataPoint(ts, 1)
@@ -99,10 +123,23 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sContainerStorageRequestDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sContainerWaitingDataPoint(ts, 1, "reason-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sCronjobActiveJobsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sCronjobLastScheduleAgeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sCronjobSuspendedDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordK8sCustomResourceCountDataPoint(ts, 1, "group-val", "version-val", "kind-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sDaemonsetCurrentScheduledNodesDataPoint(ts, 1)
@@ -111,6 +148,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sDaemonsetDesiredScheduledNodesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sDaemonsetGenerationSkewDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sDaemonsetMisscheduledNodesDataPoint(ts, 1)
@@ -119,14 +159,56 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sDaemonsetReadyNodesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sDaemonsetReplicasUnavailableDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sDaemonsetUnavailableNodesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sDaemonsetUpdatedRatioDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sDeploymentAvailableDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sDeploymentCollisionCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sDeploymentConditionDataPoint(ts, 1, "condition-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sDeploymentDesiredDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sDeploymentGenerationSkewDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sDeploymentPausedDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sDeploymentReplicasUnavailableDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sEndpointsAddressCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sEndpointsNotReadyAddressCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sEndpointsliceAddressCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sEndpointsliceReadyCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sHpaConditionDataPoint(ts, 1, "condition-val")
+
+			allMetricsCount++
+			mb.RecordK8sHpaCurrentMetricValueDataPoint(ts, 1, "hpa.metric.name-val", "hpa.metric.type-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sHpaCurrentReplicasDataPoint(ts, 1)
@@ -135,6 +217,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sHpaDesiredReplicasDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sHpaExternalCurrentValueDataPoint(ts, 1, "hpa.metric.name-val", "hpa.metric.selector-val")
+
+			allMetricsCount++
+			mb.RecordK8sHpaExternalTargetValueDataPoint(ts, 1, "hpa.metric.name-val", "hpa.metric.selector-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sHpaMaxReplicasDataPoint(ts, 1)
@@ -143,14 +231,35 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sHpaMinReplicasDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sHpaTargetMetricValueDataPoint(ts, 1, "hpa.metric.name-val", "hpa.metric.type-val")
+
+			allMetricsCount++
+			mb.RecordK8sIngressRuleCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sIngressTLSCountDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sJobActivePodsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sJobBackoffLimitDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sJobCompletedIndexesCountDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sJobDesiredSuccessfulPodsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sJobDurationDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sJobFailedExhaustedDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sJobFailedPodsDataPoint(ts, 1)
@@ -163,20 +272,107 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sJobSuccessfulPodsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sLimitrangeDefaultCPURequestDataPoint(ts, 1, "limit.type-val")
+
+			allMetricsCount++
+			mb.RecordK8sLimitrangeDefaultMemoryRequestDataPoint(ts, 1, "limit.type-val")
+
+			allMetricsCount++
+			mb.RecordK8sLimitrangeMaxDataPoint(ts, 1, "limit.type-val", "resource-val")
+
+			allMetricsCount++
+			mb.RecordK8sLimitrangeMinDataPoint(ts, 1, "limit.type-val", "resource-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sNamespacePhaseDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sNamespaceSecretCountDataPoint(ts, 1, "secret.type-val")
+
+			allMetricsCount++
+			mb.RecordK8sNamespaceServiceaccountCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sNodeAgeDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordK8sNodeConditionDataPoint(ts, 1, "condition-val")
 
+			allMetricsCount++
+			mb.RecordK8sNodeEvictionRiskDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sNodeLeaseRenewAgeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sNodePodCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sNodeTaintCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sNodeUnschedulableDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPdbCurrentHealthyDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPdbDesiredHealthyDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPdbDisruptionsAllowedDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPdbExpectedPodsDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeCapacityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumePhaseDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimActualCapacityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimPhaseDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimRequestedStorageDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPodAgeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPodConditionDataPoint(ts, 1, "condition-val")
+
+			allMetricsCount++
+			mb.RecordK8sPodOrphanedDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sPodPhaseDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sPodPriorityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPodSchedulingLatencyDataPoint(ts, 1)
+
 			allMetricsCount++
 			mb.RecordK8sPodStatusReasonDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sPodUnschedulableDataPoint(ts, 1, "reason-val")
+
+			allMetricsCount++
+			mb.RecordK8sPriorityclassGlobalDefaultDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPriorityclassValueDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sReplicasetAvailableDataPoint(ts, 1)
@@ -185,6 +381,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sReplicasetDesiredDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sReplicasetFullyLabeledReplicasDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sReplicationControllerAvailableDataPoint(ts, 1)
@@ -201,6 +400,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sResourceQuotaUsedDataPoint(ts, 1, "resource-val")
 
+			allMetricsCount++
+			mb.RecordK8sResourceQuotaUtilizationDataPoint(ts, 1, "resource-val")
+
+			allMetricsCount++
+			mb.RecordK8sServicePortCountDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sServiceTypeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sStatefulsetCollisionCountDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sStatefulsetCurrentPodsDataPoint(ts, 1)
@@ -209,14 +420,32 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sStatefulsetDesiredPodsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sStatefulsetGenerationSkewDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sStatefulsetReadyPodsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sStatefulsetRevisionMismatchDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sStatefulsetUpdatePartitionDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sStatefulsetUpdatedPodsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sStorageclassInfoDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sVpaTargetCPUDataPoint(ts, 1, "vpa.container.name-val")
+
+			allMetricsCount++
+			mb.RecordK8sVpaTargetMemoryDataPoint(ts, 1, "vpa.container.name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordOpenshiftAppliedclusterquotaLimitDataPoint(ts, 1, "k8s.namespace.name-val", "resource-val")
@@ -233,42 +462,93 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordOpenshiftClusterquotaUsedDataPoint(ts, 1, "resource-val")
 
+			allMetricsCount++
+			mb.RecordOpenshiftDeploymentconfigAvailableDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordOpenshiftDeploymentconfigDesiredDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordOpenshiftDeploymentconfigReadyDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordOpenshiftDeploymentconfigUpdatedDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
+			rb.SetCloudAvailabilityZone("cloud.availability_zone-val")
+			rb.SetCloudRegion("cloud.region-val")
 			rb.SetContainerID("container.id-val")
 			rb.SetContainerImageName("container.image.name-val")
 			rb.SetContainerImageTag("container.image.tag-val")
 			rb.SetContainerRuntime("container.runtime-val")
 			rb.SetContainerRuntimeVersion("container.runtime.version-val")
+			rb.SetHostType("host.type-val")
+			rb.SetK8sClusterName("k8s.cluster.name-val")
 			rb.SetK8sContainerName("k8s.container.name-val")
+			rb.SetK8sContainerType("k8s.container.type-val")
 			rb.SetK8sCronjobName("k8s.cronjob.name-val")
 			rb.SetK8sCronjobUID("k8s.cronjob.uid-val")
 			rb.SetK8sDaemonsetName("k8s.daemonset.name-val")
 			rb.SetK8sDaemonsetUID("k8s.daemonset.uid-val")
 			rb.SetK8sDeploymentName("k8s.deployment.name-val")
 			rb.SetK8sDeploymentUID("k8s.deployment.uid-val")
+			rb.SetK8sEndpointsName("k8s.endpoints.name-val")
+			rb.SetK8sEndpointsUID("k8s.endpoints.uid-val")
+			rb.SetK8sEndpointsliceAddressType("k8s.endpointslice.address_type-val")
+			rb.SetK8sEndpointsliceName("k8s.endpointslice.name-val")
+			rb.SetK8sEndpointsliceUID("k8s.endpointslice.uid-val")
 			rb.SetK8sHpaName("k8s.hpa.name-val")
 			rb.SetK8sHpaUID("k8s.hpa.uid-val")
+			rb.SetK8sIngressName("k8s.ingress.name-val")
+			rb.SetK8sIngressUID("k8s.ingress.uid-val")
 			rb.SetK8sJobName("k8s.job.name-val")
 			rb.SetK8sJobUID("k8s.job.uid-val")
 			rb.SetK8sKubeletVersion("k8s.kubelet.version-val")
 			rb.SetK8sKubeproxyVersion("k8s.kubeproxy.version-val")
+			rb.SetK8sLimitrangeName("k8s.limitrange.name-val")
+			rb.SetK8sLimitrangeUID("k8s.limitrange.uid-val")
 			rb.SetK8sNamespaceName("k8s.namespace.name-val")
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sPdbName("k8s.pdb.name-val")
+			rb.SetK8sPdbUID("k8s.pdb.uid-val")
+			rb.SetK8sPersistentvolumeName("k8s.persistentvolume.name-val")
+			rb.SetK8sPersistentvolumeUID("k8s.persistentvolume.uid-val")
+			rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
+			rb.SetK8sPersistentvolumeclaimUID("k8s.persistentvolumeclaim.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
+			rb.SetK8sPodPriorityClassName("k8s.pod.priority_class_name-val")
 			rb.SetK8sPodQosClass("k8s.pod.qos_class-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
+			rb.SetK8sPriorityclassName("k8s.priorityclass.name-val")
+			rb.SetK8sPriorityclassPreemptionPolicy("k8s.priorityclass.preemption_policy-val")
+			rb.SetK8sPriorityclassUID("k8s.priorityclass.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
 			rb.SetK8sReplicasetUID("k8s.replicaset.uid-val")
 			rb.SetK8sReplicationcontrollerName("k8s.replicationcontroller.name-val")
 			rb.SetK8sReplicationcontrollerUID("k8s.replicationcontroller.uid-val")
 			rb.SetK8sResourcequotaName("k8s.resourcequota.name-val")
+			rb.SetK8sResourcequotaScope("k8s.resourcequota.scope-val")
 			rb.SetK8sResourcequotaUID("k8s.resourcequota.uid-val")
+			rb.SetK8sServiceName("k8s.service.name-val")
+			rb.SetK8sServiceUID("k8s.service.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+			rb.SetK8sStorageclassIsDefault(false)
+			rb.SetK8sStorageclassName("k8s.storageclass.name-val")
+			rb.SetK8sStorageclassProvisioner("k8s.storageclass.provisioner-val")
+			rb.SetK8sStorageclassReclaimPolicy("k8s.storageclass.reclaim_policy-val")
+			rb.SetK8sStorageclassUID("k8s.storageclass.uid-val")
+			rb.SetK8sStorageclassVolumeBindingMode("k8s.storageclass.volume_binding_mode-val")
+			rb.SetK8sVpaName("k8s.vpa.name-val")
+			rb.SetK8sVpaTargetKind("k8s.vpa.target.kind-val")
+			rb.SetK8sVpaTargetName("k8s.vpa.target.name-val")
+			rb.SetK8sVpaUID("k8s.vpa.uid-val")
 			rb.SetOpenshiftClusterquotaName("openshift.clusterquota.name-val")
 			rb.SetOpenshiftClusterquotaUID("openshift.clusterquota.uid-val")
+			rb.SetOpenshiftDeploymentconfigName("openshift.deploymentconfig.name-val")
+			rb.SetOpenshiftDeploymentconfigUID("openshift.deploymentconfig.uid-val")
 			rb.SetOsDescription("os.description-val")
 			rb.SetOsVersion("os.version-val")
 			res := rb.Emit()
@@ -293,6 +573,21 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "k8s.cluster.pod.count":
+					assert.False(t, validatedMetrics["k8s.cluster.pod.count"], "Found a duplicate in the metrics slice: k8s.cluster.pod.count")
+					validatedMetrics["k8s.cluster.pod.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of pods in the cluster per phase. Recorded without resource attributes, as a cluster wide aggregate of all pods.", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("phase")
+					assert.True(t, ok)
+					assert.EqualValues(t, "phase-val", attrVal.Str())
 				case "k8s.container.cpu_limit":
 					assert.False(t, validatedMetrics["k8s.container.cpu_limit"], "Found a duplicate in the metrics slice: k8s.container.cpu_limit")
 					validatedMetrics["k8s.container.cpu_limit"] = true
@@ -305,6 +600,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "k8s.container.cpu_limit_ratio":
+					assert.False(t, validatedMetrics["k8s.container.cpu_limit_ratio"], "Found a duplicate in the metrics slice: k8s.container.cpu_limit_ratio")
+					validatedMetrics["k8s.container.cpu_limit_ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Ratio of the CPU limit to the CPU request of the container. Only sent if both the limit and a non-zero request are set", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "k8s.container.cpu_request":
 					assert.False(t, validatedMetrics["k8s.container.cpu_request"], "Found a duplicate in the metrics slice: k8s.container.cpu_request")
 					validatedMetrics["k8s.container.cpu_request"] = true
@@ -317,6 +624,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "k8s.container.cpu_request_node_fraction":
+					assert.False(t, validatedMetrics["k8s.container.cpu_request_node_fraction"], "Found a duplicate in the metrics slice: k8s.container.cpu_request_node_fraction")
+					validatedMetrics["k8s.container.cpu_request_node_fraction"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "CPU request of the container as a fraction of the allocatable CPU of the node the pod is scheduled to. Only sent for pods scheduled to a node in the cache", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "k8s.container.ephemeralstorage_limit":
 					assert.False(t, validatedMetrics["k8s.container.ephemeralstorage_limit"], "Found a duplicate in the metrics slice: k8s.container.ephemeralstorage_limit")
 					validatedMetrics["k8s.container.ephemeralstorage_limit"] = true
@@ -341,6 +660,30 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.container.last_exit_code":
+					assert.False(t, validatedMetrics["k8s.container.last_exit_code"], "Found a duplicate in the metrics slice: k8s.container.last_exit_code")
+					validatedMetrics["k8s.container.last_exit_code"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Exit code of the last termination of the container, e.g. 137 when it was killed with SIGKILL. Only reported for containers that terminated before", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.container.last_termination_reason":
+					assert.False(t, validatedMetrics["k8s.container.last_termination_reason"], "Found a duplicate in the metrics slice: k8s.container.last_termination_reason")
+					validatedMetrics["k8s.container.last_termination_reason"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Reason of the last termination of the container (1 - OOMKilled, 2 - Error, 3 - Completed, 4 - ContainerCannotRun, 5 - DeadlineExceeded, 6 - Unknown)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.container.memory_limit":
 					assert.False(t, validatedMetrics["k8s.container.memory_limit"], "Found a duplicate in the metrics slice: k8s.container.memory_limit")
 					validatedMetrics["k8s.container.memory_limit"] = true
@@ -353,6 +696,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.container.memory_limit_ratio":
+					assert.False(t, validatedMetrics["k8s.container.memory_limit_ratio"], "Found a duplicate in the metrics slice: k8s.container.memory_limit_ratio")
+					validatedMetrics["k8s.container.memory_limit_ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Ratio of the memory limit to the memory request of the container. Only sent if both the limit and a non-zero request are set", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "k8s.container.memory_request":
 					assert.False(t, validatedMetrics["k8s.container.memory_request"], "Found a duplicate in the metrics slice: k8s.container.memory_request")
 					validatedMetrics["k8s.container.memory_request"] = true
@@ -365,6 +720,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.container.memory_request_node_fraction":
+					assert.False(t, validatedMetrics["k8s.container.memory_request_node_fraction"], "Found a duplicate in the metrics slice: k8s.container.memory_request_node_fraction")
+					validatedMetrics["k8s.container.memory_request_node_fraction"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Memory request of the container as a fraction of the allocatable memory of the node the pod is scheduled to. Only sent for pods scheduled to a node in the cache", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "k8s.container.ready":
 					assert.False(t, validatedMetrics["k8s.container.ready"], "Found a duplicate in the metrics slice: k8s.container.ready")
 					validatedMetrics["k8s.container.ready"] = true
@@ -389,6 +756,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.container.started":
+					assert.False(t, validatedMetrics["k8s.container.started"], "Found a duplicate in the metrics slice: k8s.container.started")
+					validatedMetrics["k8s.container.started"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether a container has passed its startup probe (0 for no, 1 for yes). Only reported once set by the kubelet.", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.container.storage_limit":
 					assert.False(t, validatedMetrics["k8s.container.storage_limit"], "Found a duplicate in the metrics slice: k8s.container.storage_limit")
 					validatedMetrics["k8s.container.storage_limit"] = true
@@ -413,6 +792,21 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.container.waiting":
+					assert.False(t, validatedMetrics["k8s.container.waiting"], "Found a duplicate in the metrics slice: k8s.container.waiting")
+					validatedMetrics["k8s.container.waiting"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the container is waiting to run, e.g. in CrashLoopBackOff or ImagePullBackOff, with the reason of waiting. Only reported for waiting containers", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("reason")
+					assert.True(t, ok)
+					assert.EqualValues(t, "reason-val", attrVal.Str())
 				case "k8s.cronjob.active_jobs":
 					assert.False(t, validatedMetrics["k8s.cronjob.active_jobs"], "Found a duplicate in the metrics slice: k8s.cronjob.active_jobs")
 					validatedMetrics["k8s.cronjob.active_jobs"] = true
@@ -425,6 +819,51 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.cronjob.last_schedule_age":
+					assert.False(t, validatedMetrics["k8s.cronjob.last_schedule_age"], "Found a duplicate in the metrics slice: k8s.cronjob.last_schedule_age")
+					validatedMetrics["k8s.cronjob.last_schedule_age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time elapsed since the cronjob was last successfully scheduled", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.cronjob.suspended":
+					assert.False(t, validatedMetrics["k8s.cronjob.suspended"], "Found a duplicate in the metrics slice: k8s.cronjob.suspended")
+					validatedMetrics["k8s.cronjob.suspended"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the cronjob is suspended (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.custom_resource.count":
+					assert.False(t, validatedMetrics["k8s.custom_resource.count"], "Found a duplicate in the metrics slice: k8s.custom_resource.count")
+					validatedMetrics["k8s.custom_resource.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of custom resources of a kind configured in custom_resources. Recorded without resource attributes, as a cluster wide aggregate.", ms.At(i).Description())
+					assert.Equal(t, "{custom_resource}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("group")
+					assert.True(t, ok)
+					assert.EqualValues(t, "group-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("version")
+					assert.True(t, ok)
+					assert.EqualValues(t, "version-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("kind")
+					assert.True(t, ok)
+					assert.EqualValues(t, "kind-val", attrVal.Str())
 				case "k8s.daemonset.current_scheduled_nodes":
 					assert.False(t, validatedMetrics["k8s.daemonset.current_scheduled_nodes"], "Found a duplicate in the metrics slice: k8s.daemonset.current_scheduled_nodes")
 					validatedMetrics["k8s.daemonset.current_scheduled_nodes"] = true
@@ -449,180 +888,798 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.daemonset.generation_skew":
+					assert.False(t, validatedMetrics["k8s.daemonset.generation_skew"], "Found a duplicate in the metrics slice: k8s.daemonset.generation_skew")
+					validatedMetrics["k8s.daemonset.generation_skew"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the daemon set controller hasn't observed the latest generation of the daemon set yet (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.daemonset.misscheduled_nodes":
 					assert.False(t, validatedMetrics["k8s.daemonset.misscheduled_nodes"], "Found a duplicate in the metrics slice: k8s.daemonset.misscheduled_nodes")
 					validatedMetrics["k8s.daemonset.misscheduled_nodes"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Number of nodes that are running the daemon pod, but are not supposed to run the daemon pod", ms.At(i).Description())
-					assert.Equal(t, "{node}", ms.At(i).Unit())
+					assert.Equal(t, "Number of nodes that are running the daemon pod, but are not supposed to run the daemon pod", ms.At(i).Description())
+					assert.Equal(t, "{node}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.daemonset.ready_nodes":
+					assert.False(t, validatedMetrics["k8s.daemonset.ready_nodes"], "Found a duplicate in the metrics slice: k8s.daemonset.ready_nodes")
+					validatedMetrics["k8s.daemonset.ready_nodes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of nodes that should be running the daemon pod and have one or more of the daemon pod running and ready", ms.At(i).Description())
+					assert.Equal(t, "{node}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.daemonset.replicas_unavailable":
+					assert.False(t, validatedMetrics["k8s.daemonset.replicas_unavailable"], "Found a duplicate in the metrics slice: k8s.daemonset.replicas_unavailable")
+					validatedMetrics["k8s.daemonset.replicas_unavailable"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of nodes that should be running the daemon pod and don't have an available daemon pod, i.e. the desired minus the available nodes. Zero while more nodes than desired are available, e.g. during a scale down", ms.At(i).Description())
+					assert.Equal(t, "{node}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.daemonset.unavailable_nodes":
+					assert.False(t, validatedMetrics["k8s.daemonset.unavailable_nodes"], "Found a duplicate in the metrics slice: k8s.daemonset.unavailable_nodes")
+					validatedMetrics["k8s.daemonset.unavailable_nodes"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of nodes that should be running the daemon pod and have none of the daemon pod running and available", ms.At(i).Description())
+					assert.Equal(t, "{node}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.daemonset.updated_ratio":
+					assert.False(t, validatedMetrics["k8s.daemonset.updated_ratio"], "Found a duplicate in the metrics slice: k8s.daemonset.updated_ratio")
+					validatedMetrics["k8s.daemonset.updated_ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Ratio of the nodes running the updated daemon pod to the nodes that should be running the daemon pod. Not sent if no node should be running the daemon pod", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "k8s.deployment.available":
+					assert.False(t, validatedMetrics["k8s.deployment.available"], "Found a duplicate in the metrics slice: k8s.deployment.available")
+					validatedMetrics["k8s.deployment.available"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Total number of available pods (ready for at least minReadySeconds) targeted by this deployment", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.deployment.collision_count":
+					assert.False(t, validatedMetrics["k8s.deployment.collision_count"], "Found a duplicate in the metrics slice: k8s.deployment.collision_count")
+					validatedMetrics["k8s.deployment.collision_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of hash collisions for the deployment, used by the controller to create the name of the newest ReplicaSet. Only reported once set by the controller.", ms.At(i).Description())
+					assert.Equal(t, "{collision}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.deployment.condition":
+					assert.False(t, validatedMetrics["k8s.deployment.condition"], "Found a duplicate in the metrics slice: k8s.deployment.condition")
+					validatedMetrics["k8s.deployment.condition"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The condition of a particular Deployment (1 - True, 0 - False, -1 - Unknown).", ms.At(i).Description())
+					assert.Equal(t, "{condition}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("condition")
+					assert.True(t, ok)
+					assert.EqualValues(t, "condition-val", attrVal.Str())
+				case "k8s.deployment.desired":
+					assert.False(t, validatedMetrics["k8s.deployment.desired"], "Found a duplicate in the metrics slice: k8s.deployment.desired")
+					validatedMetrics["k8s.deployment.desired"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of desired pods in this deployment", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.deployment.generation_skew":
+					assert.False(t, validatedMetrics["k8s.deployment.generation_skew"], "Found a duplicate in the metrics slice: k8s.deployment.generation_skew")
+					validatedMetrics["k8s.deployment.generation_skew"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the deployment controller hasn't observed the latest generation of the deployment yet (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.deployment.paused":
+					assert.False(t, validatedMetrics["k8s.deployment.paused"], "Found a duplicate in the metrics slice: k8s.deployment.paused")
+					validatedMetrics["k8s.deployment.paused"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the deployment is paused (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.deployment.replicas_unavailable":
+					assert.False(t, validatedMetrics["k8s.deployment.replicas_unavailable"], "Found a duplicate in the metrics slice: k8s.deployment.replicas_unavailable")
+					validatedMetrics["k8s.deployment.replicas_unavailable"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of desired pods in this deployment that are not available, i.e. the desired minus the available pods. Zero while more pods than desired are available, e.g. during a scale down", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.endpoints.address.count":
+					assert.False(t, validatedMetrics["k8s.endpoints.address.count"], "Found a duplicate in the metrics slice: k8s.endpoints.address.count")
+					validatedMetrics["k8s.endpoints.address.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of ready addresses across all subsets of the endpoints. Only reported on clusters that don't serve EndpointSlices.", ms.At(i).Description())
+					assert.Equal(t, "{address}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.endpoints.not_ready_address.count":
+					assert.False(t, validatedMetrics["k8s.endpoints.not_ready_address.count"], "Found a duplicate in the metrics slice: k8s.endpoints.not_ready_address.count")
+					validatedMetrics["k8s.endpoints.not_ready_address.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of not ready addresses across all subsets of the endpoints. Only reported on clusters that don't serve EndpointSlices.", ms.At(i).Description())
+					assert.Equal(t, "{address}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.endpointslice.address.count":
+					assert.False(t, validatedMetrics["k8s.endpointslice.address.count"], "Found a duplicate in the metrics slice: k8s.endpointslice.address.count")
+					validatedMetrics["k8s.endpointslice.address.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of addresses across all endpoints in the endpoint slice", ms.At(i).Description())
+					assert.Equal(t, "{address}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.endpointslice.ready.count":
+					assert.False(t, validatedMetrics["k8s.endpointslice.ready.count"], "Found a duplicate in the metrics slice: k8s.endpointslice.ready.count")
+					validatedMetrics["k8s.endpointslice.ready.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of endpoints in the endpoint slice that are ready to receive traffic", ms.At(i).Description())
+					assert.Equal(t, "{endpoint}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.hpa.condition":
+					assert.False(t, validatedMetrics["k8s.hpa.condition"], "Found a duplicate in the metrics slice: k8s.hpa.condition")
+					validatedMetrics["k8s.hpa.condition"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The condition of a particular HorizontalPodAutoscaler (1 - True, 0 - False, -1 - Unknown).", ms.At(i).Description())
+					assert.Equal(t, "{condition}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("condition")
+					assert.True(t, ok)
+					assert.EqualValues(t, "condition-val", attrVal.Str())
+				case "k8s.hpa.current_metric_value":
+					assert.False(t, validatedMetrics["k8s.hpa.current_metric_value"], "Found a duplicate in the metrics slice: k8s.hpa.current_metric_value")
+					validatedMetrics["k8s.hpa.current_metric_value"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current value of a metric tracked by this autoscaler, in the unit of its target (percent for utilization targets).", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("hpa.metric.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("hpa.metric.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.type-val", attrVal.Str())
+				case "k8s.hpa.current_replicas":
+					assert.False(t, validatedMetrics["k8s.hpa.current_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.current_replicas")
+					validatedMetrics["k8s.hpa.current_replicas"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current number of pod replicas managed by this autoscaler.", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.hpa.desired_replicas":
+					assert.False(t, validatedMetrics["k8s.hpa.desired_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.desired_replicas")
+					validatedMetrics["k8s.hpa.desired_replicas"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Desired number of pod replicas managed by this autoscaler.", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.hpa.external_current_value":
+					assert.False(t, validatedMetrics["k8s.hpa.external_current_value"], "Found a duplicate in the metrics slice: k8s.hpa.external_current_value")
+					validatedMetrics["k8s.hpa.external_current_value"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current value of an external metric tracked by this autoscaler, in the unit of its target.", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("hpa.metric.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("hpa.metric.selector")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.selector-val", attrVal.Str())
+				case "k8s.hpa.external_target_value":
+					assert.False(t, validatedMetrics["k8s.hpa.external_target_value"], "Found a duplicate in the metrics slice: k8s.hpa.external_target_value")
+					validatedMetrics["k8s.hpa.external_target_value"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Target value of an external metric tracked by this autoscaler.", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("hpa.metric.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("hpa.metric.selector")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.selector-val", attrVal.Str())
+				case "k8s.hpa.max_replicas":
+					assert.False(t, validatedMetrics["k8s.hpa.max_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.max_replicas")
+					validatedMetrics["k8s.hpa.max_replicas"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Maximum number of replicas to which the autoscaler can scale up.", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.hpa.min_replicas":
+					assert.False(t, validatedMetrics["k8s.hpa.min_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.min_replicas")
+					validatedMetrics["k8s.hpa.min_replicas"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Minimum number of replicas to which the autoscaler can scale up.", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.hpa.target_metric_value":
+					assert.False(t, validatedMetrics["k8s.hpa.target_metric_value"], "Found a duplicate in the metrics slice: k8s.hpa.target_metric_value")
+					validatedMetrics["k8s.hpa.target_metric_value"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Target value of a metric tracked by this autoscaler (percent for utilization targets).", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("hpa.metric.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("hpa.metric.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hpa.metric.type-val", attrVal.Str())
+				case "k8s.ingress.rule.count":
+					assert.False(t, validatedMetrics["k8s.ingress.rule.count"], "Found a duplicate in the metrics slice: k8s.ingress.rule.count")
+					validatedMetrics["k8s.ingress.rule.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of rules of the ingress. Ingresses with only a default backend have no rules", ms.At(i).Description())
+					assert.Equal(t, "{rule}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.ingress.tls.count":
+					assert.False(t, validatedMetrics["k8s.ingress.tls.count"], "Found a duplicate in the metrics slice: k8s.ingress.tls.count")
+					validatedMetrics["k8s.ingress.tls.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of TLS configurations of the ingress", ms.At(i).Description())
+					assert.Equal(t, "{tls}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.active_pods":
+					assert.False(t, validatedMetrics["k8s.job.active_pods"], "Found a duplicate in the metrics slice: k8s.job.active_pods")
+					validatedMetrics["k8s.job.active_pods"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of actively running pods for a job", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.backoff_limit":
+					assert.False(t, validatedMetrics["k8s.job.backoff_limit"], "Found a duplicate in the metrics slice: k8s.job.backoff_limit")
+					validatedMetrics["k8s.job.backoff_limit"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of retries before the job is marked as failed", ms.At(i).Description())
+					assert.Equal(t, "{retry}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.completed_indexes_count":
+					assert.False(t, validatedMetrics["k8s.job.completed_indexes_count"], "Found a duplicate in the metrics slice: k8s.job.completed_indexes_count")
+					validatedMetrics["k8s.job.completed_indexes_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of completed indexes of an indexed job", ms.At(i).Description())
+					assert.Equal(t, "{index}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.desired_successful_pods":
+					assert.False(t, validatedMetrics["k8s.job.desired_successful_pods"], "Found a duplicate in the metrics slice: k8s.job.desired_successful_pods")
+					validatedMetrics["k8s.job.desired_successful_pods"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The desired number of successfully finished pods the job should be run with", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.duration":
+					assert.False(t, validatedMetrics["k8s.job.duration"], "Found a duplicate in the metrics slice: k8s.job.duration")
+					validatedMetrics["k8s.job.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time taken by a completed job to run, from its start time to its completion time", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.failed_exhausted":
+					assert.False(t, validatedMetrics["k8s.job.failed_exhausted"], "Found a duplicate in the metrics slice: k8s.job.failed_exhausted")
+					validatedMetrics["k8s.job.failed_exhausted"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the job gave up after exhausting its backoff limit, active deadline or pod failure policy, i.e. it has a Failed or FailureTarget condition (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.failed_pods":
+					assert.False(t, validatedMetrics["k8s.job.failed_pods"], "Found a duplicate in the metrics slice: k8s.job.failed_pods")
+					validatedMetrics["k8s.job.failed_pods"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of pods which reached phase Failed for a job", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.max_parallel_pods":
+					assert.False(t, validatedMetrics["k8s.job.max_parallel_pods"], "Found a duplicate in the metrics slice: k8s.job.max_parallel_pods")
+					validatedMetrics["k8s.job.max_parallel_pods"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The max desired number of pods the job should run at any given time", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.job.successful_pods":
+					assert.False(t, validatedMetrics["k8s.job.successful_pods"], "Found a duplicate in the metrics slice: k8s.job.successful_pods")
+					validatedMetrics["k8s.job.successful_pods"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of pods which reached phase Succeeded for a job", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.limitrange.default_cpu_request":
+					assert.False(t, validatedMetrics["k8s.limitrange.default_cpu_request"], "Found a duplicate in the metrics slice: k8s.limitrange.default_cpu_request")
+					validatedMetrics["k8s.limitrange.default_cpu_request"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The default CPU request set on containers by the limit range", ms.At(i).Description())
+					assert.Equal(t, "{cpu}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("limit.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "limit.type-val", attrVal.Str())
+				case "k8s.limitrange.default_memory_request":
+					assert.False(t, validatedMetrics["k8s.limitrange.default_memory_request"], "Found a duplicate in the metrics slice: k8s.limitrange.default_memory_request")
+					validatedMetrics["k8s.limitrange.default_memory_request"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The default memory request set on containers by the limit range", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("limit.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "limit.type-val", attrVal.Str())
+				case "k8s.limitrange.max":
+					assert.False(t, validatedMetrics["k8s.limitrange.max"], "Found a duplicate in the metrics slice: k8s.limitrange.max")
+					validatedMetrics["k8s.limitrange.max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The maximum usage of a particular resource allowed by the limit range. CPU will be sent as millicores", ms.At(i).Description())
+					assert.Equal(t, "{resource}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("limit.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "limit.type-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("resource")
+					assert.True(t, ok)
+					assert.EqualValues(t, "resource-val", attrVal.Str())
+				case "k8s.limitrange.min":
+					assert.False(t, validatedMetrics["k8s.limitrange.min"], "Found a duplicate in the metrics slice: k8s.limitrange.min")
+					validatedMetrics["k8s.limitrange.min"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The minimum usage of a particular resource required by the limit range. CPU will be sent as millicores", ms.At(i).Description())
+					assert.Equal(t, "{resource}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("limit.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "limit.type-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("resource")
+					assert.True(t, ok)
+					assert.EqualValues(t, "resource-val", attrVal.Str())
+				case "k8s.namespace.phase":
+					assert.False(t, validatedMetrics["k8s.namespace.phase"], "Found a duplicate in the metrics slice: k8s.namespace.phase")
+					validatedMetrics["k8s.namespace.phase"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The current phase of namespaces (1 for active and 0 for terminating)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.namespace.secret.count":
+					assert.False(t, validatedMetrics["k8s.namespace.secret.count"], "Found a duplicate in the metrics slice: k8s.namespace.secret.count")
+					validatedMetrics["k8s.namespace.secret.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of secrets of a particular type in the namespace. Requires permissions to list and watch secrets", ms.At(i).Description())
+					assert.Equal(t, "{secret}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("secret.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "secret.type-val", attrVal.Str())
+				case "k8s.namespace.serviceaccount.count":
+					assert.False(t, validatedMetrics["k8s.namespace.serviceaccount.count"], "Found a duplicate in the metrics slice: k8s.namespace.serviceaccount.count")
+					validatedMetrics["k8s.namespace.serviceaccount.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of service accounts in the namespace. Requires permissions to list and watch service accounts", ms.At(i).Description())
+					assert.Equal(t, "{serviceaccount}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.node.age":
+					assert.False(t, validatedMetrics["k8s.node.age"], "Found a duplicate in the metrics slice: k8s.node.age")
+					validatedMetrics["k8s.node.age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time elapsed since the creation of the node, as of the collection", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.node.condition":
+					assert.False(t, validatedMetrics["k8s.node.condition"], "Found a duplicate in the metrics slice: k8s.node.condition")
+					validatedMetrics["k8s.node.condition"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The condition of a particular Node (1 - True, 0 - False, -1 - Unknown).", ms.At(i).Description())
+					assert.Equal(t, "{condition}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("condition")
+					assert.True(t, ok)
+					assert.EqualValues(t, "condition-val", attrVal.Str())
+				case "k8s.node.eviction_risk":
+					assert.False(t, validatedMetrics["k8s.node.eviction_risk"], "Found a duplicate in the metrics slice: k8s.node.eviction_risk")
+					validatedMetrics["k8s.node.eviction_risk"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the node is under memory, disk or PID pressure and may evict pods, i.e. any of the MemoryPressure, DiskPressure or PIDPressure conditions is True (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.daemonset.ready_nodes":
-					assert.False(t, validatedMetrics["k8s.daemonset.ready_nodes"], "Found a duplicate in the metrics slice: k8s.daemonset.ready_nodes")
-					validatedMetrics["k8s.daemonset.ready_nodes"] = true
+				case "k8s.node.lease_renew_age":
+					assert.False(t, validatedMetrics["k8s.node.lease_renew_age"], "Found a duplicate in the metrics slice: k8s.node.lease_renew_age")
+					validatedMetrics["k8s.node.lease_renew_age"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Number of nodes that should be running the daemon pod and have one or more of the daemon pod running and ready", ms.At(i).Description())
-					assert.Equal(t, "{node}", ms.At(i).Unit())
+					assert.Equal(t, "The time since the kubelet last renewed the node lease in the kube-node-lease namespace. Enabling it requires permissions to watch leases.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.deployment.available":
-					assert.False(t, validatedMetrics["k8s.deployment.available"], "Found a duplicate in the metrics slice: k8s.deployment.available")
-					validatedMetrics["k8s.deployment.available"] = true
+				case "k8s.node.pod_count":
+					assert.False(t, validatedMetrics["k8s.node.pod_count"], "Found a duplicate in the metrics slice: k8s.node.pod_count")
+					validatedMetrics["k8s.node.pod_count"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Total number of available pods (ready for at least minReadySeconds) targeted by this deployment", ms.At(i).Description())
+					assert.Equal(t, "The number of pods scheduled to the node, from the pods whose node name matches the node", ms.At(i).Description())
 					assert.Equal(t, "{pod}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.deployment.desired":
-					assert.False(t, validatedMetrics["k8s.deployment.desired"], "Found a duplicate in the metrics slice: k8s.deployment.desired")
-					validatedMetrics["k8s.deployment.desired"] = true
+				case "k8s.node.taint.count":
+					assert.False(t, validatedMetrics["k8s.node.taint.count"], "Found a duplicate in the metrics slice: k8s.node.taint.count")
+					validatedMetrics["k8s.node.taint.count"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Number of desired pods in this deployment", ms.At(i).Description())
-					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					assert.Equal(t, "The number of taints set on the node.", ms.At(i).Description())
+					assert.Equal(t, "{taint}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.hpa.current_replicas":
-					assert.False(t, validatedMetrics["k8s.hpa.current_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.current_replicas")
-					validatedMetrics["k8s.hpa.current_replicas"] = true
+				case "k8s.node.unschedulable":
+					assert.False(t, validatedMetrics["k8s.node.unschedulable"], "Found a duplicate in the metrics slice: k8s.node.unschedulable")
+					validatedMetrics["k8s.node.unschedulable"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Current number of pod replicas managed by this autoscaler.", ms.At(i).Description())
-					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					assert.Equal(t, "Whether the node is marked as unschedulable, e.g. when it's cordoned (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.hpa.desired_replicas":
-					assert.False(t, validatedMetrics["k8s.hpa.desired_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.desired_replicas")
-					validatedMetrics["k8s.hpa.desired_replicas"] = true
+				case "k8s.pdb.current_healthy":
+					assert.False(t, validatedMetrics["k8s.pdb.current_healthy"], "Found a duplicate in the metrics slice: k8s.pdb.current_healthy")
+					validatedMetrics["k8s.pdb.current_healthy"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Desired number of pod replicas managed by this autoscaler.", ms.At(i).Description())
+					assert.Equal(t, "Current number of healthy pods selected by this pod disruption budget.", ms.At(i).Description())
 					assert.Equal(t, "{pod}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.hpa.max_replicas":
-					assert.False(t, validatedMetrics["k8s.hpa.max_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.max_replicas")
-					validatedMetrics["k8s.hpa.max_replicas"] = true
+				case "k8s.pdb.desired_healthy":
+					assert.False(t, validatedMetrics["k8s.pdb.desired_healthy"], "Found a duplicate in the metrics slice: k8s.pdb.desired_healthy")
+					validatedMetrics["k8s.pdb.desired_healthy"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Maximum number of replicas to which the autoscaler can scale up.", ms.At(i).Description())
+					assert.Equal(t, "Minimum desired number of healthy pods selected by this pod disruption budget.", ms.At(i).Description())
 					assert.Equal(t, "{pod}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.hpa.min_replicas":
-					assert.False(t, validatedMetrics["k8s.hpa.min_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.min_replicas")
-					validatedMetrics["k8s.hpa.min_replicas"] = true
+				case "k8s.pdb.disruptions_allowed":
+					assert.False(t, validatedMetrics["k8s.pdb.disruptions_allowed"], "Found a duplicate in the metrics slice: k8s.pdb.disruptions_allowed")
+					validatedMetrics["k8s.pdb.disruptions_allowed"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "Minimum number of replicas to which the autoscaler can scale up.", ms.At(i).Description())
-					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					assert.Equal(t, "Number of pod disruptions that are currently allowed by this pod disruption budget.", ms.At(i).Description())
+					assert.Equal(t, "{disruption}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.job.active_pods":
-					assert.False(t, validatedMetrics["k8s.job.active_pods"], "Found a duplicate in the metrics slice: k8s.job.active_pods")
-					validatedMetrics["k8s.job.active_pods"] = true
+				case "k8s.pdb.expected_pods":
+					assert.False(t, validatedMetrics["k8s.pdb.expected_pods"], "Found a duplicate in the metrics slice: k8s.pdb.expected_pods")
+					validatedMetrics["k8s.pdb.expected_pods"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "The number of actively running pods for a job", ms.At(i).Description())
+					assert.Equal(t, "Total number of pods counted by this pod disruption budget.", ms.At(i).Description())
 					assert.Equal(t, "{pod}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.job.desired_successful_pods":
-					assert.False(t, validatedMetrics["k8s.job.desired_successful_pods"], "Found a duplicate in the metrics slice: k8s.job.desired_successful_pods")
-					validatedMetrics["k8s.job.desired_successful_pods"] = true
+				case "k8s.persistentvolume.capacity":
+					assert.False(t, validatedMetrics["k8s.persistentvolume.capacity"], "Found a duplicate in the metrics slice: k8s.persistentvolume.capacity")
+					validatedMetrics["k8s.persistentvolume.capacity"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "The desired number of successfully finished pods the job should be run with", ms.At(i).Description())
-					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					assert.Equal(t, "The storage capacity of the persistent volume (the `spec.capacity.storage` field)", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.job.failed_pods":
-					assert.False(t, validatedMetrics["k8s.job.failed_pods"], "Found a duplicate in the metrics slice: k8s.job.failed_pods")
-					validatedMetrics["k8s.job.failed_pods"] = true
+				case "k8s.persistentvolume.phase":
+					assert.False(t, validatedMetrics["k8s.persistentvolume.phase"], "Found a duplicate in the metrics slice: k8s.persistentvolume.phase")
+					validatedMetrics["k8s.persistentvolume.phase"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "The number of pods which reached phase Failed for a job", ms.At(i).Description())
-					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					assert.Equal(t, "Current phase of the persistent volume (1 - Pending, 2 - Available, 3 - Bound, 4 - Released, 5 - Failed)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.job.max_parallel_pods":
-					assert.False(t, validatedMetrics["k8s.job.max_parallel_pods"], "Found a duplicate in the metrics slice: k8s.job.max_parallel_pods")
-					validatedMetrics["k8s.job.max_parallel_pods"] = true
+				case "k8s.persistentvolumeclaim.actual_capacity":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.actual_capacity"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.actual_capacity")
+					validatedMetrics["k8s.persistentvolumeclaim.actual_capacity"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "The max desired number of pods the job should run at any given time", ms.At(i).Description())
-					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					assert.Equal(t, "The actual storage capacity of the volume bound to the persistent volume claim (the `status.capacity.storage` field), which may exceed the requested storage once the volume is expanded. Not sent before the claim is bound", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.job.successful_pods":
-					assert.False(t, validatedMetrics["k8s.job.successful_pods"], "Found a duplicate in the metrics slice: k8s.job.successful_pods")
-					validatedMetrics["k8s.job.successful_pods"] = true
+				case "k8s.persistentvolumeclaim.phase":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.phase"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.phase")
+					validatedMetrics["k8s.persistentvolumeclaim.phase"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "The number of pods which reached phase Succeeded for a job", ms.At(i).Description())
-					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					assert.Equal(t, "Current phase of the persistent volume claim (1 - Pending, 2 - Bound, 3 - Lost)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.namespace.phase":
-					assert.False(t, validatedMetrics["k8s.namespace.phase"], "Found a duplicate in the metrics slice: k8s.namespace.phase")
-					validatedMetrics["k8s.namespace.phase"] = true
+				case "k8s.persistentvolumeclaim.requested_storage":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.requested_storage"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.requested_storage")
+					validatedMetrics["k8s.persistentvolumeclaim.requested_storage"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "The current phase of namespaces (1 for active and 0 for terminating)", ms.At(i).Description())
-					assert.Equal(t, "", ms.At(i).Unit())
+					assert.Equal(t, "The amount of storage requested by the persistent volume claim (the `spec.resources.requests.storage` field)", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
-				case "k8s.node.condition":
-					assert.False(t, validatedMetrics["k8s.node.condition"], "Found a duplicate in the metrics slice: k8s.node.condition")
-					validatedMetrics["k8s.node.condition"] = true
+				case "k8s.pod.age":
+					assert.False(t, validatedMetrics["k8s.pod.age"], "Found a duplicate in the metrics slice: k8s.pod.age")
+					validatedMetrics["k8s.pod.age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time elapsed since the creation of the pod, as of the collection", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.pod.condition":
+					assert.False(t, validatedMetrics["k8s.pod.condition"], "Found a duplicate in the metrics slice: k8s.pod.condition")
+					validatedMetrics["k8s.pod.condition"] = true
 					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
 					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
-					assert.Equal(t, "The condition of a particular Node.", ms.At(i).Description())
+					assert.Equal(t, "The condition of a particular Pod (1 - True, 0 - False, -1 - Unknown). Only conditions present in the pod status are reported.", ms.At(i).Description())
 					assert.Equal(t, "{condition}", ms.At(i).Unit())
 					dp := ms.At(i).Gauge().DataPoints().At(0)
 					assert.Equal(t, start, dp.StartTimestamp())
@@ -632,6 +1689,18 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("condition")
 					assert.True(t, ok)
 					assert.EqualValues(t, "condition-val", attrVal.Str())
+				case "k8s.pod.orphaned":
+					assert.False(t, validatedMetrics["k8s.pod.orphaned"], "Found a duplicate in the metrics slice: k8s.pod.orphaned")
+					validatedMetrics["k8s.pod.orphaned"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the pod has no controller owner (0 for no, 1 for yes). Not reported for mirror pods of static pods.", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.pod.phase":
 					assert.False(t, validatedMetrics["k8s.pod.phase"], "Found a duplicate in the metrics slice: k8s.pod.phase")
 					validatedMetrics["k8s.pod.phase"] = true
@@ -644,6 +1713,30 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.pod.priority":
+					assert.False(t, validatedMetrics["k8s.pod.priority"], "Found a duplicate in the metrics slice: k8s.pod.priority")
+					validatedMetrics["k8s.pod.priority"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The priority of the pod, resolved from its priority class on admission. Not reported for pods without a resolved priority", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.pod.scheduling_latency":
+					assert.False(t, validatedMetrics["k8s.pod.scheduling_latency"], "Found a duplicate in the metrics slice: k8s.pod.scheduling_latency")
+					validatedMetrics["k8s.pod.scheduling_latency"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time between the creation of the pod and it being scheduled to a node. Only reported for pods that are scheduled and started.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "k8s.pod.status_reason":
 					assert.False(t, validatedMetrics["k8s.pod.status_reason"], "Found a duplicate in the metrics slice: k8s.pod.status_reason")
 					validatedMetrics["k8s.pod.status_reason"] = true
@@ -656,6 +1749,45 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.pod.unschedulable":
+					assert.False(t, validatedMetrics["k8s.pod.unschedulable"], "Found a duplicate in the metrics slice: k8s.pod.unschedulable")
+					validatedMetrics["k8s.pod.unschedulable"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the pod is pending because it can't be scheduled (1 for yes). Only reported for pending pods with a false PodScheduled condition.", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("reason")
+					assert.True(t, ok)
+					assert.EqualValues(t, "reason-val", attrVal.Str())
+				case "k8s.priorityclass.global_default":
+					assert.False(t, validatedMetrics["k8s.priorityclass.global_default"], "Found a duplicate in the metrics slice: k8s.priorityclass.global_default")
+					validatedMetrics["k8s.priorityclass.global_default"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the priority class is the default for pods without a priority class (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.priorityclass.value":
+					assert.False(t, validatedMetrics["k8s.priorityclass.value"], "Found a duplicate in the metrics slice: k8s.priorityclass.value")
+					validatedMetrics["k8s.priorityclass.value"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The priority value of the priority class, which pods using the class receive", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.replicaset.available":
 					assert.False(t, validatedMetrics["k8s.replicaset.available"], "Found a duplicate in the metrics slice: k8s.replicaset.available")
 					validatedMetrics["k8s.replicaset.available"] = true
@@ -680,6 +1812,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.replicaset.fully_labeled_replicas":
+					assert.False(t, validatedMetrics["k8s.replicaset.fully_labeled_replicas"], "Found a duplicate in the metrics slice: k8s.replicaset.fully_labeled_replicas")
+					validatedMetrics["k8s.replicaset.fully_labeled_replicas"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of pods of this replicaset whose labels match the labels of its pod template. Fewer than the pods of the replicaset when adopted pods are mislabeled", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.replication_controller.available":
 					assert.False(t, validatedMetrics["k8s.replication_controller.available"], "Found a duplicate in the metrics slice: k8s.replication_controller.available")
 					validatedMetrics["k8s.replication_controller.available"] = true
@@ -734,6 +1878,57 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("resource")
 					assert.True(t, ok)
 					assert.EqualValues(t, "resource-val", attrVal.Str())
+				case "k8s.resource_quota.utilization":
+					assert.False(t, validatedMetrics["k8s.resource_quota.utilization"], "Found a duplicate in the metrics slice: k8s.resource_quota.utilization")
+					validatedMetrics["k8s.resource_quota.utilization"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The ratio of the usage to the upper limit for a particular resource in a specific namespace. Will not be sent for resources with a zero limit.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("resource")
+					assert.True(t, ok)
+					assert.EqualValues(t, "resource-val", attrVal.Str())
+				case "k8s.service.port.count":
+					assert.False(t, validatedMetrics["k8s.service.port.count"], "Found a duplicate in the metrics slice: k8s.service.port.count")
+					validatedMetrics["k8s.service.port.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of ports exposed by the service", ms.At(i).Description())
+					assert.Equal(t, "{port}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.service.type":
+					assert.False(t, validatedMetrics["k8s.service.type"], "Found a duplicate in the metrics slice: k8s.service.type")
+					validatedMetrics["k8s.service.type"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Type of the service (1 - ClusterIP, 2 - NodePort, 3 - LoadBalancer, 4 - ExternalName)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.statefulset.collision_count":
+					assert.False(t, validatedMetrics["k8s.statefulset.collision_count"], "Found a duplicate in the metrics slice: k8s.statefulset.collision_count")
+					validatedMetrics["k8s.statefulset.collision_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of hash collisions for the stateful set, used by the controller to create the name of the newest ControllerRevision. Only reported once set by the controller.", ms.At(i).Description())
+					assert.Equal(t, "{collision}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.statefulset.current_pods":
 					assert.False(t, validatedMetrics["k8s.statefulset.current_pods"], "Found a duplicate in the metrics slice: k8s.statefulset.current_pods")
 					validatedMetrics["k8s.statefulset.current_pods"] = true
@@ -758,6 +1953,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.statefulset.generation_skew":
+					assert.False(t, validatedMetrics["k8s.statefulset.generation_skew"], "Found a duplicate in the metrics slice: k8s.statefulset.generation_skew")
+					validatedMetrics["k8s.statefulset.generation_skew"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the stateful set controller hasn't observed the latest generation of the stateful set yet (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.statefulset.ready_pods":
 					assert.False(t, validatedMetrics["k8s.statefulset.ready_pods"], "Found a duplicate in the metrics slice: k8s.statefulset.ready_pods")
 					validatedMetrics["k8s.statefulset.ready_pods"] = true
@@ -770,6 +1977,30 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.statefulset.revision_mismatch":
+					assert.False(t, validatedMetrics["k8s.statefulset.revision_mismatch"], "Found a duplicate in the metrics slice: k8s.statefulset.revision_mismatch")
+					validatedMetrics["k8s.statefulset.revision_mismatch"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the current revision of the stateful set differs from its update revision, i.e. a rollout is in progress (0 for no, 1 for yes)", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.statefulset.update_partition":
+					assert.False(t, validatedMetrics["k8s.statefulset.update_partition"], "Found a duplicate in the metrics slice: k8s.statefulset.update_partition")
+					validatedMetrics["k8s.statefulset.update_partition"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The ordinal at which the stateful set is partitioned for rolling updates, pods with a lower ordinal are not updated. Only reported for the RollingUpdate strategy with a partition set.", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.statefulset.updated_pods":
 					assert.False(t, validatedMetrics["k8s.statefulset.updated_pods"], "Found a duplicate in the metrics slice: k8s.statefulset.updated_pods")
 					validatedMetrics["k8s.statefulset.updated_pods"] = true
@@ -782,6 +2013,48 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.storageclass.info":
+					assert.False(t, validatedMetrics["k8s.storageclass.info"], "Found a duplicate in the metrics slice: k8s.storageclass.info")
+					validatedMetrics["k8s.storageclass.info"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Information about the storage class, always 1. The storage class is described by its resource attributes", ms.At(i).Description())
+					assert.Equal(t, "", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.vpa.target_cpu":
+					assert.False(t, validatedMetrics["k8s.vpa.target_cpu"], "Found a duplicate in the metrics slice: k8s.vpa.target_cpu")
+					validatedMetrics["k8s.vpa.target_cpu"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "CPU recommended by the vertical pod autoscaler for a container. Only reported once the autoscaler provides a recommendation.", ms.At(i).Description())
+					assert.Equal(t, "{cpu}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("vpa.container.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "vpa.container.name-val", attrVal.Str())
+				case "k8s.vpa.target_memory":
+					assert.False(t, validatedMetrics["k8s.vpa.target_memory"], "Found a duplicate in the metrics slice: k8s.vpa.target_memory")
+					validatedMetrics["k8s.vpa.target_memory"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Memory recommended by the vertical pod autoscaler for a container. Only reported once the autoscaler provides a recommendation.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("vpa.container.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "vpa.container.name-val", attrVal.Str())
 				case "openshift.appliedclusterquota.limit":
 					assert.False(t, validatedMetrics["openshift.appliedclusterquota.limit"], "Found a duplicate in the metrics slice: openshift.appliedclusterquota.limit")
 					validatedMetrics["openshift.appliedclusterquota.limit"] = true
@@ -848,6 +2121,54 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("resource")
 					assert.True(t, ok)
 					assert.EqualValues(t, "resource-val", attrVal.Str())
+				case "openshift.deploymentconfig.available":
+					assert.False(t, validatedMetrics["openshift.deploymentconfig.available"], "Found a duplicate in the metrics slice: openshift.deploymentconfig.available")
+					validatedMetrics["openshift.deploymentconfig.available"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Total number of available pods (ready for at least minReadySeconds) targeted by this deployment config", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "openshift.deploymentconfig.desired":
+					assert.False(t, validatedMetrics["openshift.deploymentconfig.desired"], "Found a duplicate in the metrics slice: openshift.deploymentconfig.desired")
+					validatedMetrics["openshift.deploymentconfig.desired"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of desired pods in this deployment config", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "openshift.deploymentconfig.ready":
+					assert.False(t, validatedMetrics["openshift.deploymentconfig.ready"], "Found a duplicate in the metrics slice: openshift.deploymentconfig.ready")
+					validatedMetrics["openshift.deploymentconfig.ready"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Total number of ready pods targeted by this deployment config", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "openshift.deploymentconfig.updated":
+					assert.False(t, validatedMetrics["openshift.deploymentconfig.updated"], "Found a duplicate in the metrics slice: openshift.deploymentconfig.updated")
+					validatedMetrics["openshift.deploymentconfig.updated"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Total number of non-terminated pods targeted by this deployment config that have the desired template spec", ms.At(i).Description())
+					assert.Equal(t, "{pod}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
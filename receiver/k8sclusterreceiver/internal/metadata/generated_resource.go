@@ -21,6 +21,20 @@ func NewResourceBuilder(rac ResourceAttributesConfig) *ResourceBuilder {
 	}
 }
 
+// SetCloudAvailabilityZone sets provided value as "cloud.availability_zone" attribute.
+func (rb *ResourceBuilder) SetCloudAvailabilityZone(val string) {
+	if rb.config.CloudAvailabilityZone.Enabled {
+		rb.res.Attributes().PutStr("cloud.availability_zone", val)
+	}
+}
+
+// SetCloudRegion sets provided value as "cloud.region" attribute.
+func (rb *ResourceBuilder) SetCloudRegion(val string) {
+	if rb.config.CloudRegion.Enabled {
+		rb.res.Attributes().PutStr("cloud.region", val)
+	}
+}
+
 // SetContainerID sets provided value as "container.id" attribute.
 func (rb *ResourceBuilder) SetContainerID(val string) {
 	if rb.config.ContainerID.Enabled {
@@ -56,6 +70,20 @@ func (rb *ResourceBuilder) SetContainerRuntimeVersion(val string) {
 	}
 }
 
+// SetHostType sets provided value as "host.type" attribute.
+func (rb *ResourceBuilder) SetHostType(val string) {
+	if rb.config.HostType.Enabled {
+		rb.res.Attributes().PutStr("host.type", val)
+	}
+}
+
+// SetK8sClusterName sets provided value as "k8s.cluster.name" attribute.
+func (rb *ResourceBuilder) SetK8sClusterName(val string) {
+	if rb.config.K8sClusterName.Enabled {
+		rb.res.Attributes().PutStr("k8s.cluster.name", val)
+	}
+}
+
 // SetK8sContainerName sets provided value as "k8s.container.name" attribute.
 func (rb *ResourceBuilder) SetK8sContainerName(val string) {
 	if rb.config.K8sContainerName.Enabled {
@@ -63,6 +91,13 @@ func (rb *ResourceBuilder) SetK8sContainerName(val string) {
 	}
 }
 
+// SetK8sContainerType sets provided value as "k8s.container.type" attribute.
+func (rb *ResourceBuilder) SetK8sContainerType(val string) {
+	if rb.config.K8sContainerType.Enabled {
+		rb.res.Attributes().PutStr("k8s.container.type", val)
+	}
+}
+
 // SetK8sCronjobName sets provided value as "k8s.cronjob.name" attribute.
 func (rb *ResourceBuilder) SetK8sCronjobName(val string) {
 	if rb.config.K8sCronjobName.Enabled {
@@ -105,6 +140,41 @@ func (rb *ResourceBuilder) SetK8sDeploymentUID(val string) {
 	}
 }
 
+// SetK8sEndpointsName sets provided value as "k8s.endpoints.name" attribute.
+func (rb *ResourceBuilder) SetK8sEndpointsName(val string) {
+	if rb.config.K8sEndpointsName.Enabled {
+		rb.res.Attributes().PutStr("k8s.endpoints.name", val)
+	}
+}
+
+// SetK8sEndpointsUID sets provided value as "k8s.endpoints.uid" attribute.
+func (rb *ResourceBuilder) SetK8sEndpointsUID(val string) {
+	if rb.config.K8sEndpointsUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.endpoints.uid", val)
+	}
+}
+
+// SetK8sEndpointsliceAddressType sets provided value as "k8s.endpointslice.address_type" attribute.
+func (rb *ResourceBuilder) SetK8sEndpointsliceAddressType(val string) {
+	if rb.config.K8sEndpointsliceAddressType.Enabled {
+		rb.res.Attributes().PutStr("k8s.endpointslice.address_type", val)
+	}
+}
+
+// SetK8sEndpointsliceName sets provided value as "k8s.endpointslice.name" attribute.
+func (rb *ResourceBuilder) SetK8sEndpointsliceName(val string) {
+	if rb.config.K8sEndpointsliceName.Enabled {
+		rb.res.Attributes().PutStr("k8s.endpointslice.name", val)
+	}
+}
+
+// SetK8sEndpointsliceUID sets provided value as "k8s.endpointslice.uid" attribute.
+func (rb *ResourceBuilder) SetK8sEndpointsliceUID(val string) {
+	if rb.config.K8sEndpointsliceUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.endpointslice.uid", val)
+	}
+}
+
 // SetK8sHpaName sets provided value as "k8s.hpa.name" attribute.
 func (rb *ResourceBuilder) SetK8sHpaName(val string) {
 	if rb.config.K8sHpaName.Enabled {
@@ -119,6 +189,20 @@ func (rb *ResourceBuilder) SetK8sHpaUID(val string) {
 	}
 }
 
+// SetK8sIngressName sets provided value as "k8s.ingress.name" attribute.
+func (rb *ResourceBuilder) SetK8sIngressName(val string) {
+	if rb.config.K8sIngressName.Enabled {
+		rb.res.Attributes().PutStr("k8s.ingress.name", val)
+	}
+}
+
+// SetK8sIngressUID sets provided value as "k8s.ingress.uid" attribute.
+func (rb *ResourceBuilder) SetK8sIngressUID(val string) {
+	if rb.config.K8sIngressUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.ingress.uid", val)
+	}
+}
+
 // SetK8sJobName sets provided value as "k8s.job.name" attribute.
 func (rb *ResourceBuilder) SetK8sJobName(val string) {
 	if rb.config.K8sJobName.Enabled {
@@ -147,6 +231,20 @@ func (rb *ResourceBuilder) SetK8sKubeproxyVersion(val string) {
 	}
 }
 
+// SetK8sLimitrangeName sets provided value as "k8s.limitrange.name" attribute.
+func (rb *ResourceBuilder) SetK8sLimitrangeName(val string) {
+	if rb.config.K8sLimitrangeName.Enabled {
+		rb.res.Attributes().PutStr("k8s.limitrange.name", val)
+	}
+}
+
+// SetK8sLimitrangeUID sets provided value as "k8s.limitrange.uid" attribute.
+func (rb *ResourceBuilder) SetK8sLimitrangeUID(val string) {
+	if rb.config.K8sLimitrangeUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.limitrange.uid", val)
+	}
+}
+
 // SetK8sNamespaceName sets provided value as "k8s.namespace.name" attribute.
 func (rb *ResourceBuilder) SetK8sNamespaceName(val string) {
 	if rb.config.K8sNamespaceName.Enabled {
@@ -175,6 +273,48 @@ func (rb *ResourceBuilder) SetK8sNodeUID(val string) {
 	}
 }
 
+// SetK8sPdbName sets provided value as "k8s.pdb.name" attribute.
+func (rb *ResourceBuilder) SetK8sPdbName(val string) {
+	if rb.config.K8sPdbName.Enabled {
+		rb.res.Attributes().PutStr("k8s.pdb.name", val)
+	}
+}
+
+// SetK8sPdbUID sets provided value as "k8s.pdb.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPdbUID(val string) {
+	if rb.config.K8sPdbUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.pdb.uid", val)
+	}
+}
+
+// SetK8sPersistentvolumeName sets provided value as "k8s.persistentvolume.name" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeName(val string) {
+	if rb.config.K8sPersistentvolumeName.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolume.name", val)
+	}
+}
+
+// SetK8sPersistentvolumeUID sets provided value as "k8s.persistentvolume.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeUID(val string) {
+	if rb.config.K8sPersistentvolumeUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolume.uid", val)
+	}
+}
+
+// SetK8sPersistentvolumeclaimName sets provided value as "k8s.persistentvolumeclaim.name" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeclaimName(val string) {
+	if rb.config.K8sPersistentvolumeclaimName.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolumeclaim.name", val)
+	}
+}
+
+// SetK8sPersistentvolumeclaimUID sets provided value as "k8s.persistentvolumeclaim.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeclaimUID(val string) {
+	if rb.config.K8sPersistentvolumeclaimUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolumeclaim.uid", val)
+	}
+}
+
 // SetK8sPodName sets provided value as "k8s.pod.name" attribute.
 func (rb *ResourceBuilder) SetK8sPodName(val string) {
 	if rb.config.K8sPodName.Enabled {
@@ -182,6 +322,13 @@ func (rb *ResourceBuilder) SetK8sPodName(val string) {
 	}
 }
 
+// SetK8sPodPriorityClassName sets provided value as "k8s.pod.priority_class_name" attribute.
+func (rb *ResourceBuilder) SetK8sPodPriorityClassName(val string) {
+	if rb.config.K8sPodPriorityClassName.Enabled {
+		rb.res.Attributes().PutStr("k8s.pod.priority_class_name", val)
+	}
+}
+
 // SetK8sPodQosClass sets provided value as "k8s.pod.qos_class" attribute.
 func (rb *ResourceBuilder) SetK8sPodQosClass(val string) {
 	if rb.config.K8sPodQosClass.Enabled {
@@ -196,6 +343,27 @@ func (rb *ResourceBuilder) SetK8sPodUID(val string) {
 	}
 }
 
+// SetK8sPriorityclassName sets provided value as "k8s.priorityclass.name" attribute.
+func (rb *ResourceBuilder) SetK8sPriorityclassName(val string) {
+	if rb.config.K8sPriorityclassName.Enabled {
+		rb.res.Attributes().PutStr("k8s.priorityclass.name", val)
+	}
+}
+
+// SetK8sPriorityclassPreemptionPolicy sets provided value as "k8s.priorityclass.preemption_policy" attribute.
+func (rb *ResourceBuilder) SetK8sPriorityclassPreemptionPolicy(val string) {
+	if rb.config.K8sPriorityclassPreemptionPolicy.Enabled {
+		rb.res.Attributes().PutStr("k8s.priorityclass.preemption_policy", val)
+	}
+}
+
+// SetK8sPriorityclassUID sets provided value as "k8s.priorityclass.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPriorityclassUID(val string) {
+	if rb.config.K8sPriorityclassUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.priorityclass.uid", val)
+	}
+}
+
 // SetK8sReplicasetName sets provided value as "k8s.replicaset.name" attribute.
 func (rb *ResourceBuilder) SetK8sReplicasetName(val string) {
 	if rb.config.K8sReplicasetName.Enabled {
@@ -231,6 +399,13 @@ func (rb *ResourceBuilder) SetK8sResourcequotaName(val string) {
 	}
 }
 
+// SetK8sResourcequotaScope sets provided value as "k8s.resourcequota.scope" attribute.
+func (rb *ResourceBuilder) SetK8sResourcequotaScope(val string) {
+	if rb.config.K8sResourcequotaScope.Enabled {
+		rb.res.Attributes().PutStr("k8s.resourcequota.scope", val)
+	}
+}
+
 // SetK8sResourcequotaUID sets provided value as "k8s.resourcequota.uid" attribute.
 func (rb *ResourceBuilder) SetK8sResourcequotaUID(val string) {
 	if rb.config.K8sResourcequotaUID.Enabled {
@@ -238,6 +413,20 @@ func (rb *ResourceBuilder) SetK8sResourcequotaUID(val string) {
 	}
 }
 
+// SetK8sServiceName sets provided value as "k8s.service.name" attribute.
+func (rb *ResourceBuilder) SetK8sServiceName(val string) {
+	if rb.config.K8sServiceName.Enabled {
+		rb.res.Attributes().PutStr("k8s.service.name", val)
+	}
+}
+
+// SetK8sServiceUID sets provided value as "k8s.service.uid" attribute.
+func (rb *ResourceBuilder) SetK8sServiceUID(val string) {
+	if rb.config.K8sServiceUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.service.uid", val)
+	}
+}
+
 // SetK8sStatefulsetName sets provided value as "k8s.statefulset.name" attribute.
 func (rb *ResourceBuilder) SetK8sStatefulsetName(val string) {
 	if rb.config.K8sStatefulsetName.Enabled {
@@ -252,6 +441,76 @@ func (rb *ResourceBuilder) SetK8sStatefulsetUID(val string) {
 	}
 }
 
+// SetK8sStorageclassIsDefault sets provided value as "k8s.storageclass.is_default" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassIsDefault(val bool) {
+	if rb.config.K8sStorageclassIsDefault.Enabled {
+		rb.res.Attributes().PutBool("k8s.storageclass.is_default", val)
+	}
+}
+
+// SetK8sStorageclassName sets provided value as "k8s.storageclass.name" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassName(val string) {
+	if rb.config.K8sStorageclassName.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.name", val)
+	}
+}
+
+// SetK8sStorageclassProvisioner sets provided value as "k8s.storageclass.provisioner" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassProvisioner(val string) {
+	if rb.config.K8sStorageclassProvisioner.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.provisioner", val)
+	}
+}
+
+// SetK8sStorageclassReclaimPolicy sets provided value as "k8s.storageclass.reclaim_policy" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassReclaimPolicy(val string) {
+	if rb.config.K8sStorageclassReclaimPolicy.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.reclaim_policy", val)
+	}
+}
+
+// SetK8sStorageclassUID sets provided value as "k8s.storageclass.uid" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassUID(val string) {
+	if rb.config.K8sStorageclassUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.uid", val)
+	}
+}
+
+// SetK8sStorageclassVolumeBindingMode sets provided value as "k8s.storageclass.volume_binding_mode" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassVolumeBindingMode(val string) {
+	if rb.config.K8sStorageclassVolumeBindingMode.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.volume_binding_mode", val)
+	}
+}
+
+// SetK8sVpaName sets provided value as "k8s.vpa.name" attribute.
+func (rb *ResourceBuilder) SetK8sVpaName(val string) {
+	if rb.config.K8sVpaName.Enabled {
+		rb.res.Attributes().PutStr("k8s.vpa.name", val)
+	}
+}
+
+// SetK8sVpaTargetKind sets provided value as "k8s.vpa.target.kind" attribute.
+func (rb *ResourceBuilder) SetK8sVpaTargetKind(val string) {
+	if rb.config.K8sVpaTargetKind.Enabled {
+		rb.res.Attributes().PutStr("k8s.vpa.target.kind", val)
+	}
+}
+
+// SetK8sVpaTargetName sets provided value as "k8s.vpa.target.name" attribute.
+func (rb *ResourceBuilder) SetK8sVpaTargetName(val string) {
+	if rb.config.K8sVpaTargetName.Enabled {
+		rb.res.Attributes().PutStr("k8s.vpa.target.name", val)
+	}
+}
+
+// SetK8sVpaUID sets provided value as "k8s.vpa.uid" attribute.
+func (rb *ResourceBuilder) SetK8sVpaUID(val string) {
+	if rb.config.K8sVpaUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.vpa.uid", val)
+	}
+}
+
 // SetOpenshiftClusterquotaName sets provided value as "openshift.clusterquota.name" attribute.
 func (rb *ResourceBuilder) SetOpenshiftClusterquotaName(val string) {
 	if rb.config.OpenshiftClusterquotaName.Enabled {
@@ -266,6 +525,20 @@ func (rb *ResourceBuilder) SetOpenshiftClusterquotaUID(val string) {
 	}
 }
 
+// SetOpenshiftDeploymentconfigName sets provided value as "openshift.deploymentconfig.name" attribute.
+func (rb *ResourceBuilder) SetOpenshiftDeploymentconfigName(val string) {
+	if rb.config.OpenshiftDeploymentconfigName.Enabled {
+		rb.res.Attributes().PutStr("openshift.deploymentconfig.name", val)
+	}
+}
+
+// SetOpenshiftDeploymentconfigUID sets provided value as "openshift.deploymentconfig.uid" attribute.
+func (rb *ResourceBuilder) SetOpenshiftDeploymentconfigUID(val string) {
+	if rb.config.OpenshiftDeploymentconfigUID.Enabled {
+		rb.res.Attributes().PutStr("openshift.deploymentconfig.uid", val)
+	}
+}
+
 // SetOsDescription sets provided value as "os.description" attribute.
 func (rb *ResourceBuilder) SetOsDescription(val string) {
 	if rb.config.OsDescription.Enabled {
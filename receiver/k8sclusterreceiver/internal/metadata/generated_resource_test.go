@@ -13,41 +13,80 @@ func TestResourceBuilder(t *testing.T) {
 		t.Run(test, func(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, test)
 			rb := NewResourceBuilder(cfg)
+			rb.SetCloudAvailabilityZone("cloud.availability_zone-val")
+			rb.SetCloudRegion("cloud.region-val")
 			rb.SetContainerID("container.id-val")
 			rb.SetContainerImageName("container.image.name-val")
 			rb.SetContainerImageTag("container.image.tag-val")
 			rb.SetContainerRuntime("container.runtime-val")
 			rb.SetContainerRuntimeVersion("container.runtime.version-val")
+			rb.SetHostType("host.type-val")
+			rb.SetK8sClusterName("k8s.cluster.name-val")
 			rb.SetK8sContainerName("k8s.container.name-val")
+			rb.SetK8sContainerType("k8s.container.type-val")
 			rb.SetK8sCronjobName("k8s.cronjob.name-val")
 			rb.SetK8sCronjobUID("k8s.cronjob.uid-val")
 			rb.SetK8sDaemonsetName("k8s.daemonset.name-val")
 			rb.SetK8sDaemonsetUID("k8s.daemonset.uid-val")
 			rb.SetK8sDeploymentName("k8s.deployment.name-val")
 			rb.SetK8sDeploymentUID("k8s.deployment.uid-val")
+			rb.SetK8sEndpointsName("k8s.endpoints.name-val")
+			rb.SetK8sEndpointsUID("k8s.endpoints.uid-val")
+			rb.SetK8sEndpointsliceAddressType("k8s.endpointslice.address_type-val")
+			rb.SetK8sEndpointsliceName("k8s.endpointslice.name-val")
+			rb.SetK8sEndpointsliceUID("k8s.endpointslice.uid-val")
 			rb.SetK8sHpaName("k8s.hpa.name-val")
 			rb.SetK8sHpaUID("k8s.hpa.uid-val")
+			rb.SetK8sIngressName("k8s.ingress.name-val")
+			rb.SetK8sIngressUID("k8s.ingress.uid-val")
 			rb.SetK8sJobName("k8s.job.name-val")
 			rb.SetK8sJobUID("k8s.job.uid-val")
 			rb.SetK8sKubeletVersion("k8s.kubelet.version-val")
 			rb.SetK8sKubeproxyVersion("k8s.kubeproxy.version-val")
+			rb.SetK8sLimitrangeName("k8s.limitrange.name-val")
+			rb.SetK8sLimitrangeUID("k8s.limitrange.uid-val")
 			rb.SetK8sNamespaceName("k8s.namespace.name-val")
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sPdbName("k8s.pdb.name-val")
+			rb.SetK8sPdbUID("k8s.pdb.uid-val")
+			rb.SetK8sPersistentvolumeName("k8s.persistentvolume.name-val")
+			rb.SetK8sPersistentvolumeUID("k8s.persistentvolume.uid-val")
+			rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
+			rb.SetK8sPersistentvolumeclaimUID("k8s.persistentvolumeclaim.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
+			rb.SetK8sPodPriorityClassName("k8s.pod.priority_class_name-val")
 			rb.SetK8sPodQosClass("k8s.pod.qos_class-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
+			rb.SetK8sPriorityclassName("k8s.priorityclass.name-val")
+			rb.SetK8sPriorityclassPreemptionPolicy("k8s.priorityclass.preemption_policy-val")
+			rb.SetK8sPriorityclassUID("k8s.priorityclass.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
 			rb.SetK8sReplicasetUID("k8s.replicaset.uid-val")
 			rb.SetK8sReplicationcontrollerName("k8s.replicationcontroller.name-val")
 			rb.SetK8sReplicationcontrollerUID("k8s.replicationcontroller.uid-val")
 			rb.SetK8sResourcequotaName("k8s.resourcequota.name-val")
+			rb.SetK8sResourcequotaScope("k8s.resourcequota.scope-val")
 			rb.SetK8sResourcequotaUID("k8s.resourcequota.uid-val")
+			rb.SetK8sServiceName("k8s.service.name-val")
+			rb.SetK8sServiceUID("k8s.service.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+			rb.SetK8sStorageclassIsDefault(false)
+			rb.SetK8sStorageclassName("k8s.storageclass.name-val")
+			rb.SetK8sStorageclassProvisioner("k8s.storageclass.provisioner-val")
+			rb.SetK8sStorageclassReclaimPolicy("k8s.storageclass.reclaim_policy-val")
+			rb.SetK8sStorageclassUID("k8s.storageclass.uid-val")
+			rb.SetK8sStorageclassVolumeBindingMode("k8s.storageclass.volume_binding_mode-val")
+			rb.SetK8sVpaName("k8s.vpa.name-val")
+			rb.SetK8sVpaTargetKind("k8s.vpa.target.kind-val")
+			rb.SetK8sVpaTargetName("k8s.vpa.target.name-val")
+			rb.SetK8sVpaUID("k8s.vpa.uid-val")
 			rb.SetOpenshiftClusterquotaName("openshift.clusterquota.name-val")
 			rb.SetOpenshiftClusterquotaUID("openshift.clusterquota.uid-val")
+			rb.SetOpenshiftDeploymentconfigName("openshift.deploymentconfig.name-val")
+			rb.SetOpenshiftDeploymentconfigUID("openshift.deploymentconfig.uid-val")
 			rb.SetOsDescription("os.description-val")
 			rb.SetOsVersion("os.version-val")
 
@@ -56,9 +95,9 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch test {
 			case "default":
-				assert.Equal(t, 30, res.Attributes().Len())
+				assert.Equal(t, 61, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 37, res.Attributes().Len())
+				assert.Equal(t, 76, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -66,7 +105,17 @@ func TestResourceBuilder(t *testing.T) {
 				assert.Failf(t, "unexpected test case: %s", test)
 			}
 
-			val, ok := res.Attributes().Get("container.id")
+			val, ok := res.Attributes().Get("cloud.availability_zone")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "cloud.availability_zone-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("cloud.region")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "cloud.region-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("container.id")
 			assert.True(t, ok)
 			if ok {
 				assert.EqualValues(t, "container.id-val", val.Str())
@@ -91,11 +140,26 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "container.runtime.version-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("host.type")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "host.type-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.cluster.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.cluster.name-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.container.name")
 			assert.True(t, ok)
 			if ok {
 				assert.EqualValues(t, "k8s.container.name-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.container.type")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "k8s.container.type-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.cronjob.name")
 			assert.True(t, ok)
 			if ok {
@@ -126,6 +190,31 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.deployment.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.endpoints.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.endpoints.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.endpoints.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.endpoints.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.endpointslice.address_type")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.endpointslice.address_type-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.endpointslice.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.endpointslice.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.endpointslice.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.endpointslice.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.hpa.name")
 			assert.True(t, ok)
 			if ok {
@@ -136,6 +225,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.hpa.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.ingress.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.ingress.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.ingress.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.ingress.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.job.name")
 			assert.True(t, ok)
 			if ok {
@@ -156,6 +255,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.kubeproxy.version-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.limitrange.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.limitrange.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.limitrange.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.limitrange.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.namespace.name")
 			assert.True(t, ok)
 			if ok {
@@ -176,11 +285,46 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.node.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.pdb.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.pdb.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.pdb.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.pdb.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolume.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.persistentvolume.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolume.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.persistentvolume.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolumeclaim.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.persistentvolumeclaim.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolumeclaim.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.persistentvolumeclaim.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.pod.name")
 			assert.True(t, ok)
 			if ok {
 				assert.EqualValues(t, "k8s.pod.name-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.pod.priority_class_name")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "k8s.pod.priority_class_name-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.pod.qos_class")
 			assert.Equal(t, test == "all_set", ok)
 			if ok {
@@ -191,6 +335,21 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.pod.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.priorityclass.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.priorityclass.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.priorityclass.preemption_policy")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.priorityclass.preemption_policy-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.priorityclass.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.priorityclass.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.replicaset.name")
 			assert.True(t, ok)
 			if ok {
@@ -216,11 +375,26 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.resourcequota.name-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.resourcequota.scope")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "k8s.resourcequota.scope-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.resourcequota.uid")
 			assert.True(t, ok)
 			if ok {
 				assert.EqualValues(t, "k8s.resourcequota.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.service.name")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "k8s.service.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.service.uid")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "k8s.service.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.statefulset.name")
 			assert.True(t, ok)
 			if ok {
@@ -231,6 +405,56 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.statefulset.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.storageclass.is_default")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, false, val.Bool())
+			}
+			val, ok = res.Attributes().Get("k8s.storageclass.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.storageclass.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.storageclass.provisioner")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.storageclass.provisioner-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.storageclass.reclaim_policy")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.storageclass.reclaim_policy-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.storageclass.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.storageclass.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.storageclass.volume_binding_mode")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.storageclass.volume_binding_mode-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.vpa.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.vpa.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.vpa.target.kind")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.vpa.target.kind-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.vpa.target.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.vpa.target.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.vpa.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.vpa.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("openshift.clusterquota.name")
 			assert.True(t, ok)
 			if ok {
@@ -241,6 +465,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "openshift.clusterquota.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("openshift.deploymentconfig.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "openshift.deploymentconfig.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("openshift.deploymentconfig.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "openshift.deploymentconfig.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("os.description")
 			assert.Equal(t, test == "all_set", ok)
 			if ok {
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateKeyPatterns checks that the given label or annotation key patterns are either
+// exact keys or prefixes followed by a single trailing "*".
+func ValidateKeyPatterns(patterns []string) error {
+	for _, p := range patterns {
+		if p == "" {
+			return errors.New("empty key pattern")
+		}
+		if i := strings.Index(p, "*"); i >= 0 && i != len(p)-1 {
+			return fmt.Errorf("key pattern %q must only contain \"*\" as the last character", p)
+		}
+	}
+	return nil
+}
+
+// FilterKeys returns the entries of m whose keys match one of the given patterns.
+// It returns nil if no entry matches.
+func FilterKeys(m map[string]string, patterns []string) map[string]string {
+	var out map[string]string
+	for k, v := range m {
+		if matchesAny(k, patterns) {
+			if out == nil {
+				out = map[string]string{}
+			}
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// AddLabelsAndAnnotations copies the labels and annotations of om matching the given patterns
+// into the metadata of km as "<entity type>.label.<key>" and "<entity type>.annotation.<key>".
+func AddLabelsAndAnnotations(km *KubernetesMetadata, om v1.Object, labelPatterns, annotationPatterns []string) {
+	for k, v := range FilterKeys(om.GetLabels(), labelPatterns) {
+		km.Metadata[km.EntityType+".label."+k] = v
+	}
+	for k, v := range FilterKeys(om.GetAnnotations(), annotationPatterns) {
+		km.Metadata[km.EntityType+".annotation."+k] = v
+	}
+}
+
+func matchesAny(key string, patterns []string) bool {
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if key == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateKeyPatterns(t *testing.T) {
+	assert.NoError(t, ValidateKeyPatterns(nil))
+	assert.NoError(t, ValidateKeyPatterns([]string{"app", "app.kubernetes.io/*", "*"}))
+	assert.EqualError(t, ValidateKeyPatterns([]string{""}), "empty key pattern")
+	assert.EqualError(t, ValidateKeyPatterns([]string{"*/name"}), "key pattern \"*/name\" must only contain \"*\" as the last character")
+}
+
+func TestFilterKeys(t *testing.T) {
+	m := map[string]string{
+		"app":                        "my-app",
+		"application":                "other",
+		"app.kubernetes.io/name":     "name",
+		"app.kubernetes.io/instance": "instance",
+		"team":                       "a-team",
+	}
+	assert.Equal(t, map[string]string{
+		"app":                        "my-app",
+		"app.kubernetes.io/name":     "name",
+		"app.kubernetes.io/instance": "instance",
+	}, FilterKeys(m, []string{"app", "app.kubernetes.io/*", "version"}))
+	assert.Nil(t, FilterKeys(m, nil))
+	assert.Nil(t, FilterKeys(nil, []string{"app"}))
+}
+
+func TestAddLabelsAndAnnotations(t *testing.T) {
+	om := &v1.ObjectMeta{
+		Labels: map[string]string{
+			"app":     "my-app",
+			"team":    "a-team",
+			"version": "v1",
+		},
+		Annotations: map[string]string{
+			"owner.example.com/email": "team@example.com",
+			"unrelated":               "value",
+		},
+	}
+	km := &KubernetesMetadata{
+		EntityType: "k8s.pod",
+		Metadata:   map[string]string{"k8s.workload.name": "test-pod"},
+	}
+	AddLabelsAndAnnotations(km, om, []string{"app", "team", "version", "missing"}, []string{"owner.example.com/*"})
+	assert.Equal(t, map[string]string{
+		"k8s.workload.name":                          "test-pod",
+		"k8s.pod.label.app":                          "my-app",
+		"k8s.pod.label.team":                         "a-team",
+		"k8s.pod.label.version":                      "v1",
+		"k8s.pod.annotation.owner.example.com/email": "team@example.com",
+	}, km.Metadata)
+}
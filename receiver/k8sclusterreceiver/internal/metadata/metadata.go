@@ -38,9 +38,10 @@ func TransformObjectMeta(om v1.ObjectMeta) v1.ObjectMeta {
 	}
 	for _, or := range om.OwnerReferences {
 		newOM.OwnerReferences = append(newOM.OwnerReferences, v1.OwnerReference{
-			Kind: or.Kind,
-			Name: or.Name,
-			UID:  or.UID,
+			Kind:       or.Kind,
+			Name:       or.Name,
+			UID:        or.UID,
+			Controller: or.Controller,
 		})
 	}
 	return newOM
@@ -62,6 +63,7 @@ func GetGenericMetadata(om *v1.ObjectMeta, resourceType string) *KubernetesMetad
 		metadata[GetOTelNameFromKind(kind)] = or.Name
 		metadata[GetOTelUIDFromKind(kind)] = string(or.UID)
 	}
+	metadata = maps.MergeStringMaps(metadata, GetControllerOwnerMetadata(om, rType))
 
 	return &KubernetesMetadata{
 		EntityType:    getOTelEntityTypeFromKind(rType),
@@ -71,6 +73,20 @@ func GetGenericMetadata(om *v1.ObjectMeta, resourceType string) *KubernetesMetad
 	}
 }
 
+// GetControllerOwnerMetadata returns the kind and UID of the controller owner of the object
+// as "k8s.<kind>.owner.kind" and "k8s.<kind>.owner.uid". It returns nil if the object
+// has no controller owner.
+func GetControllerOwnerMetadata(om *v1.ObjectMeta, kind string) map[string]string {
+	ref := v1.GetControllerOfNoCopy(om)
+	if ref == nil {
+		return nil
+	}
+	return map[string]string{
+		fmt.Sprintf("k8s.%s.owner.kind", kind): ref.Kind,
+		fmt.Sprintf("k8s.%s.owner.uid", kind):  string(ref.UID),
+	}
+}
+
 func GetOTelUIDFromKind(kind string) string {
 	return fmt.Sprintf("k8s.%s.uid", kind)
 }
@@ -56,6 +56,60 @@ func Test_getGenericMetadata(t *testing.T) {
 	}, rm.Metadata)
 }
 
+func Test_getGenericMetadataWithControllerOwner(t *testing.T) {
+	isController := true
+	om := &v1.ObjectMeta{
+		Name: "test-name",
+		UID:  "test-uid",
+		OwnerReferences: []v1.OwnerReference{
+			{
+				Kind: "Other",
+				UID:  "other-uid",
+				Name: "other",
+			},
+			{
+				Kind:       "Deployment",
+				UID:        "deployment-uid",
+				Name:       "deployment",
+				Controller: &isController,
+			},
+		},
+	}
+
+	rm := GetGenericMetadata(om, "ReplicaSet")
+	assert.Equal(t, "Deployment", rm.Metadata["k8s.replicaset.owner.kind"])
+	assert.Equal(t, "deployment-uid", rm.Metadata["k8s.replicaset.owner.uid"])
+
+	// Objects without a controller owner don't get owner keys.
+	om.OwnerReferences = om.OwnerReferences[:1]
+	rm = GetGenericMetadata(om, "ReplicaSet")
+	assert.NotContains(t, rm.Metadata, "k8s.replicaset.owner.kind")
+	assert.NotContains(t, rm.Metadata, "k8s.replicaset.owner.uid")
+}
+
+func TestTransformObjectMetaKeepsController(t *testing.T) {
+	isController := true
+	om := v1.ObjectMeta{
+		OwnerReferences: []v1.OwnerReference{
+			{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				UID:        "rs-uid",
+				Name:       "rs",
+				Controller: &isController,
+			},
+		},
+	}
+	assert.Equal(t, []v1.OwnerReference{
+		{
+			Kind:       "ReplicaSet",
+			UID:        "rs-uid",
+			Name:       "rs",
+			Controller: &isController,
+		},
+	}, TransformObjectMeta(om).OwnerReferences)
+}
+
 func metadataMap(mdata map[string]string) map[experimentalmetricmetadata.ResourceID]*KubernetesMetadata {
 	rid := experimentalmetricmetadata.ResourceID("resource_id")
 	return map[experimentalmetricmetadata.ResourceID]*KubernetesMetadata{
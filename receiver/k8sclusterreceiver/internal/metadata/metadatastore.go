@@ -11,8 +11,12 @@ import (
 // Store keeps track of required caches exposed by informers.
 // This store is used while collecting metadata about Pods to be able
 // to correlate other Kubernetes objects with a Pod.
+// The caches are only set up before the informers are started, after that
+// Store is read-only and relies on the informer caches for synchronization,
+// so informer updates and metric collection never contend on a Store-wide lock.
 type Store struct {
-	stores map[schema.GroupVersionKind]cache.Store
+	stores          map[schema.GroupVersionKind]cache.Store
+	namespaceFilter *NamespaceFilter
 }
 
 // NewStore creates a new Store.
@@ -32,7 +36,20 @@ func (ms *Store) Setup(gvk schema.GroupVersionKind, store cache.Store) {
 	ms.stores[gvk] = store
 }
 
-// ForEach iterates over all objects in a given cache.Store.
+// SetNamespaceFilter sets the filter selecting the objects iterated by ForEach.
+// Like Setup, it must be called before the informers are started.
+func (ms *Store) SetNamespaceFilter(filter *NamespaceFilter) {
+	ms.namespaceFilter = filter
+}
+
+// Accepts returns whether the object is selected by the namespace filter of the store.
+func (ms *Store) Accepts(obj any) bool {
+	return ms.namespaceFilter.Accepts(obj)
+}
+
+// ForEach iterates over all objects in a given cache.Store that are selected by
+// the namespace filter. The objects are taken from a single snapshot of the cache,
+// so updates made while f runs are not observed by this iteration.
 func (ms *Store) ForEach(gvk schema.GroupVersionKind, f func(o any)) {
 	store := ms.Get(gvk)
 	if store == nil {
@@ -40,6 +57,8 @@ func (ms *Store) ForEach(gvk schema.GroupVersionKind, f func(o any)) {
 		return
 	}
 	for _, obj := range store.List() {
-		f(obj)
+		if ms.namespaceFilter.Accepts(obj) {
+			f(obj)
+		}
 	}
 }
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestStoreForEachDuringUpdates(t *testing.T) {
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	ms := NewStore()
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	ms.Setup(podGVK, store)
+
+	const numPods = 1000
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < numPods; i++ {
+			assert.NoError(t, store.Add(&corev1.Pod{
+				ObjectMeta: v1.ObjectMeta{Name: "pod-" + strconv.Itoa(i), Namespace: "test-namespace"},
+			}))
+		}
+	}()
+
+	for done := false; !done; {
+		before := len(store.List())
+		var seen int
+		ms.ForEach(podGVK, func(any) {
+			seen++
+		})
+		assert.GreaterOrEqual(t, seen, before)
+		done = seen == numPods
+	}
+	wg.Wait()
+}
+
+func TestStoreForEachNotSetup(t *testing.T) {
+	ms := NewStore()
+	ms.ForEach(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, func(any) {
+		assert.Fail(t, "no objects expected")
+	})
+}
+
+func TestStoreForEachNamespaceFilter(t *testing.T) {
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, namespace := range []string{"default", "kube-system"} {
+		assert.NoError(t, store.Add(&corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: "pod", Namespace: namespace},
+		}))
+	}
+	ms := NewStore()
+	ms.Setup(podGVK, store)
+	ms.SetNamespaceFilter(NewNamespaceFilter(nil, []string{"kube-system"}))
+
+	var namespaces []string
+	ms.ForEach(podGVK, func(o any) {
+		namespaces = append(namespaces, o.(*corev1.Pod).Namespace)
+	})
+	assert.Equal(t, []string{"default"}, namespaces)
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceFilter selects the objects to collect by their namespace. Cluster-scoped objects are
+// always selected, except for Namespace objects which are selected by their own name.
+// A nil NamespaceFilter selects all objects.
+type NamespaceFilter struct {
+	include map[string]struct{}
+	exclude map[string]struct{}
+}
+
+// NewNamespaceFilter returns a NamespaceFilter selecting the objects in the include namespaces,
+// or in all namespaces if include is empty, that are not in the exclude namespaces.
+// It returns nil if both lists are empty.
+func NewNamespaceFilter(include, exclude []string) *NamespaceFilter {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil
+	}
+	return &NamespaceFilter{
+		include: toSet(include),
+		exclude: toSet(exclude),
+	}
+}
+
+// Accepts returns whether the object is selected by the filter.
+func (f *NamespaceFilter) Accepts(obj any) bool {
+	if f == nil {
+		return true
+	}
+	om, ok := obj.(metav1.Object)
+	if !ok {
+		return true
+	}
+	namespace := om.GetNamespace()
+	if _, ok := obj.(*corev1.Namespace); ok {
+		namespace = om.GetName()
+	} else if namespace == "" {
+		return true
+	}
+	if _, ok := f.include[namespace]; len(f.include) > 0 && !ok {
+		return false
+	}
+	_, excluded := f.exclude[namespace]
+	return !excluded
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
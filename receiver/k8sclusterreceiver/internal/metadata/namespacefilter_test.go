@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceFilter(t *testing.T) {
+	pod := func(namespace string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: namespace}}
+	}
+	namespace := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}}
+
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		obj     any
+		want    bool
+	}{
+		{
+			name: "no_filter",
+			obj:  pod("kube-system"),
+			want: true,
+		},
+		{
+			name:    "excluded",
+			exclude: []string{"kube-system"},
+			obj:     pod("kube-system"),
+			want:    false,
+		},
+		{
+			name:    "not_excluded",
+			exclude: []string{"kube-system"},
+			obj:     pod("default"),
+			want:    true,
+		},
+		{
+			name:    "included",
+			include: []string{"default"},
+			obj:     pod("default"),
+			want:    true,
+		},
+		{
+			name:    "not_included",
+			include: []string{"default"},
+			obj:     pod("kube-system"),
+			want:    false,
+		},
+		{
+			name:    "included_and_excluded",
+			include: []string{"default"},
+			exclude: []string{"default"},
+			obj:     pod("default"),
+			want:    false,
+		},
+		{
+			name:    "excluded_namespace_object",
+			exclude: []string{"kube-system"},
+			obj:     namespace("kube-system"),
+			want:    false,
+		},
+		{
+			name:    "cluster_scoped",
+			include: []string{"default"},
+			exclude: []string{"kube-system"},
+			obj:     node,
+			want:    true,
+		},
+		{
+			name:    "not_an_object",
+			exclude: []string{"kube-system"},
+			obj:     "kube-system",
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewNamespaceFilter(tt.include, tt.exclude).Accepts(tt.obj))
+		})
+	}
+}
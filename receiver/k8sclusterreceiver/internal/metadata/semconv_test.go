@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+)
+
+// TestResourceAttributesMatchSemanticConventions makes sure the resource attributes of the kinds
+// that have semantic conventions use the keys of the conventions.
+func TestResourceAttributesMatchSemanticConventions(t *testing.T) {
+	tests := []struct {
+		key string
+		set func(rb *ResourceBuilder, val string)
+	}{
+		{key: conventions.AttributeContainerID, set: (*ResourceBuilder).SetContainerID},
+		{key: conventions.AttributeContainerImageName, set: (*ResourceBuilder).SetContainerImageName},
+		{key: conventions.AttributeContainerImageTag, set: (*ResourceBuilder).SetContainerImageTag},
+		{key: conventions.AttributeK8SContainerName, set: (*ResourceBuilder).SetK8sContainerName},
+		{key: conventions.AttributeK8SCronJobName, set: (*ResourceBuilder).SetK8sCronjobName},
+		{key: conventions.AttributeK8SCronJobUID, set: (*ResourceBuilder).SetK8sCronjobUID},
+		{key: conventions.AttributeK8SDaemonSetName, set: (*ResourceBuilder).SetK8sDaemonsetName},
+		{key: conventions.AttributeK8SDaemonSetUID, set: (*ResourceBuilder).SetK8sDaemonsetUID},
+		{key: conventions.AttributeK8SDeploymentName, set: (*ResourceBuilder).SetK8sDeploymentName},
+		{key: conventions.AttributeK8SDeploymentUID, set: (*ResourceBuilder).SetK8sDeploymentUID},
+		{key: conventions.AttributeK8SJobName, set: (*ResourceBuilder).SetK8sJobName},
+		{key: conventions.AttributeK8SJobUID, set: (*ResourceBuilder).SetK8sJobUID},
+		{key: conventions.AttributeK8SNamespaceName, set: (*ResourceBuilder).SetK8sNamespaceName},
+		{key: conventions.AttributeK8SNodeName, set: (*ResourceBuilder).SetK8sNodeName},
+		{key: conventions.AttributeK8SNodeUID, set: (*ResourceBuilder).SetK8sNodeUID},
+		{key: conventions.AttributeK8SPodName, set: (*ResourceBuilder).SetK8sPodName},
+		{key: conventions.AttributeK8SPodUID, set: (*ResourceBuilder).SetK8sPodUID},
+		{key: conventions.AttributeK8SReplicaSetName, set: (*ResourceBuilder).SetK8sReplicasetName},
+		{key: conventions.AttributeK8SReplicaSetUID, set: (*ResourceBuilder).SetK8sReplicasetUID},
+		{key: conventions.AttributeK8SStatefulSetName, set: (*ResourceBuilder).SetK8sStatefulsetName},
+		{key: conventions.AttributeK8SStatefulSetUID, set: (*ResourceBuilder).SetK8sStatefulsetUID},
+		{key: conventions.AttributeOSDescription, set: (*ResourceBuilder).SetOsDescription},
+	}
+
+	cfg := DefaultResourceAttributesConfig()
+	cfg.OsDescription.Enabled = true
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			rb := NewResourceBuilder(cfg)
+			tt.set(rb, "val")
+			attrs := rb.Emit().Attributes().AsRaw()
+			assert.Equal(t, map[string]any{tt.key: "val"}, attrs)
+		})
+	}
+}
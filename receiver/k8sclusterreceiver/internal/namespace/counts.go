@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/namespace"
+
+import (
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// TransformSecret transforms the secret to remove the fields that we don't use to reduce RAM utilization.
+// Only the number of secrets is recorded, so the secret data is never kept.
+func TransformSecret(secret *corev1.Secret) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metadata.TransformObjectMeta(secret.ObjectMeta),
+		Type:       secret.Type,
+	}
+}
+
+// TransformServiceAccount transforms the service account to remove the fields that we don't use to
+// reduce RAM utilization. Only the number of service accounts is recorded.
+func TransformServiceAccount(sa *corev1.ServiceAccount) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metadata.TransformObjectMeta(sa.ObjectMeta),
+	}
+}
+
+type objectCounts struct {
+	secretsByType   map[corev1.SecretType]int64
+	serviceAccounts int64
+}
+
+// RecordObjectCounts records, for each namespace, the number of secrets by type and the number
+// of service accounts. Namespaces without any of these objects are not reported.
+func RecordObjectCounts(mb *metadata.MetricsBuilder, secrets []*corev1.Secret, serviceAccounts []*corev1.ServiceAccount, ts pcommon.Timestamp) {
+	counts := map[string]*objectCounts{}
+	countsFor := func(namespace string) *objectCounts {
+		c, ok := counts[namespace]
+		if !ok {
+			c = &objectCounts{secretsByType: map[corev1.SecretType]int64{}}
+			counts[namespace] = c
+		}
+		return c
+	}
+	for _, secret := range secrets {
+		countsFor(secret.Namespace).secretsByType[secret.Type]++
+	}
+	for _, sa := range serviceAccounts {
+		countsFor(sa.Namespace).serviceAccounts++
+	}
+
+	namespaces := make([]string, 0, len(counts))
+	for ns := range counts {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	for _, ns := range namespaces {
+		c := counts[ns]
+		for secretType, count := range c.secretsByType {
+			mb.RecordK8sNamespaceSecretCountDataPoint(ts, count, string(secretType))
+		}
+		if c.serviceAccounts > 0 {
+			mb.RecordK8sNamespaceServiceaccountCountDataPoint(ts, c.serviceAccounts)
+		}
+		rb := mb.NewResourceBuilder()
+		rb.SetK8sNamespaceName(ns)
+		mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package namespace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func TestRecordObjectCounts(t *testing.T) {
+	secret := func(namespace string, secretType corev1.SecretType) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: v1.ObjectMeta{Namespace: namespace}, Type: secretType}
+	}
+	secrets := []*corev1.Secret{
+		secret("ns1", corev1.SecretTypeOpaque),
+		secret("ns1", corev1.SecretTypeOpaque),
+		secret("ns1", corev1.SecretTypeTLS),
+		secret("ns2", corev1.SecretTypeOpaque),
+	}
+	serviceAccounts := []*corev1.ServiceAccount{
+		{ObjectMeta: v1.ObjectMeta{Namespace: "ns1"}},
+		{ObjectMeta: v1.ObjectMeta{Namespace: "ns3"}},
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sNamespaceSecretCount.Enabled = true
+	mbc.Metrics.K8sNamespaceServiceaccountCount.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordObjectCounts(mb, secrets, serviceAccounts, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	secretCounts := map[string]int64{}
+	serviceAccountCounts := map[string]int64{}
+	require.Equal(t, 3, m.ResourceMetrics().Len())
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		ns, ok := rm.Resource().Attributes().Get("k8s.namespace.name")
+		require.True(t, ok)
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			require.Equal(t, pmetric.MetricTypeGauge, ms.At(j).Type())
+			dps := ms.At(j).Gauge().DataPoints()
+			switch ms.At(j).Name() {
+			case "k8s.namespace.secret.count":
+				for k := 0; k < dps.Len(); k++ {
+					secretType, ok := dps.At(k).Attributes().Get("secret.type")
+					require.True(t, ok)
+					secretCounts[ns.Str()+"/"+secretType.Str()] = dps.At(k).IntValue()
+				}
+			case "k8s.namespace.serviceaccount.count":
+				require.Equal(t, 1, dps.Len())
+				serviceAccountCounts[ns.Str()] = dps.At(0).IntValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"ns1/Opaque":            2,
+		"ns1/kubernetes.io/tls": 1,
+		"ns2/Opaque":            1,
+	}, secretCounts)
+	assert.Equal(t, map[string]int64{"ns1": 1, "ns3": 1}, serviceAccountCounts)
+}
+
+func TestTransformSecret(t *testing.T) {
+	orig := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+		StringData: map[string]string{"user": "admin"},
+	}
+	want := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "default",
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+	assert.Equal(t, want, TransformSecret(orig))
+}
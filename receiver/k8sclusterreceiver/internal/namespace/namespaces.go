@@ -4,12 +4,25 @@
 package namespace // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/namespace"
 
 import (
+	"strings"
+	"time"
+
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	imetadata "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
+const (
+	// Keys for namespace metadata.
+	namespaceCreationTime = "namespace.creation_timestamp"
+	namespacePhase        = "k8s.namespace.phase"
+)
+
 func RecordMetrics(mb *imetadata.MetricsBuilder, ns *corev1.Namespace, ts pcommon.Timestamp) {
 	mb.RecordK8sNamespacePhaseDataPoint(ts, int64(namespacePhaseValues[ns.Status.Phase]))
 	rb := mb.NewResourceBuilder()
@@ -18,6 +31,28 @@ func RecordMetrics(mb *imetadata.MetricsBuilder, ns *corev1.Namespace, ts pcommo
 	mb.EmitForResource(imetadata.WithResource(rb.Emit()))
 }
 
+// GetMetadata returns the metadata of the namespace, including its labels. Namespaces
+// that are being terminated are still reported, with phase "terminating".
+func GetMetadata(ns *corev1.Namespace) map[experimentalmetricmetadata.ResourceID]*imetadata.KubernetesMetadata {
+	meta := maps.MergeStringMaps(map[string]string{}, ns.Labels)
+
+	meta[conventions.AttributeK8SNamespaceName] = ns.Name
+	meta[namespaceCreationTime] = ns.GetCreationTimestamp().Format(time.RFC3339)
+	if ns.Status.Phase != "" {
+		meta[namespacePhase] = strings.ToLower(string(ns.Status.Phase))
+	}
+
+	nsID := experimentalmetricmetadata.ResourceID(ns.UID)
+	return map[experimentalmetricmetadata.ResourceID]*imetadata.KubernetesMetadata{
+		nsID: {
+			EntityType:    "k8s.namespace",
+			ResourceIDKey: constants.K8sKeyNamespaceUID,
+			ResourceID:    nsID,
+			Metadata:      meta,
+		},
+	}
+}
+
 var namespacePhaseValues = map[corev1.NamespacePhase]int32{
 	corev1.NamespaceActive:      1,
 	corev1.NamespaceTerminating: 0,
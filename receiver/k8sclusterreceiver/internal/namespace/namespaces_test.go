@@ -8,10 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
@@ -36,3 +40,52 @@ func TestNamespaceMetrics(t *testing.T) {
 	),
 	)
 }
+
+func TestNamespacePhaseMetric(t *testing.T) {
+	tests := []struct {
+		name  string
+		phase corev1.NamespacePhase
+		want  int64
+	}{
+		{name: "kube-system", phase: corev1.NamespaceActive, want: 1},
+		{name: "default", phase: corev1.NamespaceActive, want: 1},
+		{name: "kube-system", phase: corev1.NamespaceTerminating, want: 0},
+		{name: "stuck", phase: corev1.NamespaceTerminating, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"_"+string(tt.phase), func(t *testing.T) {
+			n := testutils.NewNamespace("1")
+			n.Name = tt.name
+			n.Status.Phase = tt.phase
+			mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, n, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			rm := m.ResourceMetrics().At(0)
+			name, ok := rm.Resource().Attributes().Get("k8s.namespace.name")
+			require.True(t, ok)
+			assert.Equal(t, tt.name, name.Str())
+			require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+			testutils.AssertMetricInt(t, rm.ScopeMetrics().At(0).Metrics().At(0), "k8s.namespace.phase", pmetric.MetricTypeGauge, tt.want)
+		})
+	}
+}
+
+func TestNamespaceMetadata(t *testing.T) {
+	n := testutils.NewNamespace("1")
+	actual := GetMetadata(n)
+	require.Len(t, actual, 1)
+	assert.Equal(t, &metadata.KubernetesMetadata{
+		EntityType:    "k8s.namespace",
+		ResourceIDKey: "k8s.namespace.uid",
+		ResourceID:    "test-namespace-1-uid",
+		Metadata: map[string]string{
+			"foo":                          "bar",
+			"foo1":                         "",
+			"k8s.namespace.name":           "test-namespace-1",
+			"k8s.namespace.phase":          "terminating",
+			"namespace.creation_timestamp": "0001-01-01T00:00:00Z",
+		},
+	}, actual[experimentalmetricmetadata.ResourceID("test-namespace-1-uid")])
+}
@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package node // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	imetadata "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// RecordPodCounts records, for each node, the number of pods scheduled to it. Nodes without
+// pods report zero. Pods that aren't scheduled yet, or whose node isn't known, aren't counted.
+func RecordPodCounts(mb *imetadata.MetricsBuilder, nodes []*corev1.Node, pods []*corev1.Pod, ts pcommon.Timestamp) {
+	counts := make(map[string]int64, len(nodes))
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			counts[pod.Spec.NodeName]++
+		}
+	}
+	for _, node := range nodes {
+		mb.RecordK8sNodePodCountDataPoint(ts, counts[node.Name])
+		rb := mb.NewResourceBuilder()
+		rb.SetK8sNodeUID(string(node.UID))
+		rb.SetK8sNodeName(node.Name)
+		mb.EmitForResource(imetadata.WithResource(rb.Emit()))
+	}
+}
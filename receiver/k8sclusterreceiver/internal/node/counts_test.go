@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestRecordPodCounts(t *testing.T) {
+	nodes := []*corev1.Node{testutils.NewNode("1"), testutils.NewNode("2")}
+	pod := func(nodeName string) *corev1.Pod {
+		return &corev1.Pod{Spec: corev1.PodSpec{NodeName: nodeName}}
+	}
+	pods := []*corev1.Pod{
+		pod("test-node-1"),
+		pod("test-node-1"),
+		// Pending pods and pods on unknown nodes aren't counted.
+		pod(""),
+		pod("unknown-node"),
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sNodePodCount.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordPodCounts(mb, nodes, pods, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	counts := map[string]int64{}
+	require.Equal(t, 2, m.ResourceMetrics().Len())
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.node.name")
+		require.True(t, ok)
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 1, ms.Len())
+		assert.Equal(t, "k8s.node.pod_count", ms.At(0).Name())
+		counts[name.Str()] = ms.At(0).Gauge().DataPoints().At(0).IntValue()
+	}
+	assert.Equal(t, map[string]int64{"test-node-1": 2, "test-node-2": 0}, counts)
+}
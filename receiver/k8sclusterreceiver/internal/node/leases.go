@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package node // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	coordinationv1 "k8s.io/api/coordination/v1"
+
+	imetadata "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// RecordLeaseMetrics records the renewal age of a node lease. Node leases are named after
+// their node. Leases that were never renewed are skipped.
+func RecordLeaseMetrics(mb *imetadata.MetricsBuilder, lease *coordinationv1.Lease, ts pcommon.Timestamp) {
+	if lease.Spec.RenewTime == nil {
+		return
+	}
+	mb.RecordK8sNodeLeaseRenewAgeDataPoint(ts, int64(ts.AsTime().Sub(lease.Spec.RenewTime.Time).Seconds()))
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNodeName(lease.Name)
+	mb.EmitForResource(imetadata.WithResource(rb.Emit()))
+}
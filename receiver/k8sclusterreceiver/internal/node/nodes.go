@@ -5,6 +5,7 @@ package node // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -20,11 +21,16 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	imetadata "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
 const (
 	// Keys for node metadata.
-	nodeCreationTime = "node.creation_timestamp"
+	nodeCreationTime            = "node.creation_timestamp"
+	nodeKubeletVersion          = "k8s.node.kubelet_version"
+	nodeKernelVersion           = "k8s.node.kernel_version"
+	nodeOSImage                 = "k8s.node.os_image"
+	nodeContainerRuntimeVersion = "k8s.node.container_runtime_version"
 )
 
 // Transform transforms the node to remove the fields that we don't use to reduce RAM utilization.
@@ -32,7 +38,12 @@ const (
 func Transform(node *corev1.Node) *corev1.Node {
 	newNode := &corev1.Node{
 		ObjectMeta: metadata.TransformObjectMeta(node.ObjectMeta),
+		Spec: corev1.NodeSpec{
+			Taints:        node.Spec.Taints,
+			Unschedulable: node.Spec.Unschedulable,
+		},
 		Status: corev1.NodeStatus{
+			Capacity:    node.Status.Capacity,
 			Allocatable: node.Status.Allocatable,
 			NodeInfo: corev1.NodeSystemInfo{
 				KubeletVersion:          node.Status.NodeInfo.KubeletVersion,
@@ -54,13 +65,20 @@ func Transform(node *corev1.Node) *corev1.Node {
 
 func RecordMetrics(mb *imetadata.MetricsBuilder, node *corev1.Node, ts pcommon.Timestamp) {
 	for _, c := range node.Status.Conditions {
-		mb.RecordK8sNodeConditionDataPoint(ts, nodeConditionValues[c.Status], string(c.Type))
+		mb.RecordK8sNodeConditionDataPoint(ts, utils.ConditionStatusValue(c.Status), string(c.Type))
+	}
+	mb.RecordK8sNodeTaintCountDataPoint(ts, int64(len(node.Spec.Taints)))
+	mb.RecordK8sNodeUnschedulableDataPoint(ts, boolToInt64(node.Spec.Unschedulable))
+	mb.RecordK8sNodeEvictionRiskDataPoint(ts, boolToInt64(underPressure(node)))
+	if !node.CreationTimestamp.IsZero() {
+		mb.RecordK8sNodeAgeDataPoint(ts, int64(ts.AsTime().Sub(node.CreationTimestamp.Time).Seconds()))
 	}
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sNodeUID(string(node.UID))
 	rb.SetK8sNodeName(node.Name)
 	rb.SetK8sKubeletVersion(node.Status.NodeInfo.KubeletVersion)
 	rb.SetK8sKubeproxyVersion(node.Status.NodeInfo.KubeProxyVersion)
+	setCloudAttributes(rb, node)
 
 	mb.EmitForResource(imetadata.WithResource(rb.Emit()))
 }
@@ -102,6 +120,26 @@ func CustomMetrics(set receiver.CreateSettings, rb *metadata.ResourceBuilder, no
 		dp.SetTimestamp(ts)
 	}
 
+	// Adding 'node capacity type' metrics for the same types as the allocatable ones, so that
+	// the resources reserved for the system can be computed as capacity minus allocatable.
+	for _, nodeCapacityTypeValue := range allocatableTypesToReport {
+		v1NodeCapacityTypeValue := corev1.ResourceName(nodeCapacityTypeValue)
+		quantity, ok := node.Status.Capacity[v1NodeCapacityTypeValue]
+		if !ok {
+			set.Logger.Debug(fmt.Errorf("capacity type %v not found in node %v", nodeCapacityTypeValue,
+				node.GetName()).Error())
+			continue
+		}
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(getNodeCapacityMetric(nodeCapacityTypeValue))
+		m.SetDescription(fmt.Sprintf("Total amount of %v on the node", nodeCapacityTypeValue))
+		m.SetUnit(getNodeAllocatableUnit(v1NodeCapacityTypeValue))
+		g := m.SetEmptyGauge()
+		dp := g.DataPoints().AppendEmpty()
+		setNodeAllocatableValue(dp, v1NodeCapacityTypeValue, quantity)
+		dp.SetTimestamp(ts)
+	}
+
 	if sm.Metrics().Len() == 0 {
 		return pmetric.NewResourceMetrics()
 	}
@@ -126,16 +164,35 @@ func CustomMetrics(set receiver.CreateSettings, rb *metadata.ResourceBuilder, no
 	}
 
 	rb.SetOsDescription(node.Status.NodeInfo.OSImage)
+	setCloudAttributes(rb, node)
 	rb.Emit().MoveTo(rm.Resource())
 	return rm
 }
 
-var nodeConditionValues = map[corev1.ConditionStatus]int64{
-	corev1.ConditionTrue:    1,
-	corev1.ConditionFalse:   0,
-	corev1.ConditionUnknown: -1,
+// cloudLabels maps the well-known node labels set by cloud providers to the resource attributes
+// they are reported as.
+var cloudLabels = map[string]string{
+	corev1.LabelTopologyRegion:     conventions.AttributeCloudRegion,
+	corev1.LabelTopologyZone:       conventions.AttributeCloudAvailabilityZone,
+	corev1.LabelInstanceTypeStable: conventions.AttributeHostType,
+}
+
+// setCloudAttributes sets the cloud region, availability zone and instance type of the node from
+// its well-known labels. Nodes without these labels, like bare metal nodes, don't get them set.
+func setCloudAttributes(rb *imetadata.ResourceBuilder, node *corev1.Node) {
+	if region := node.Labels[corev1.LabelTopologyRegion]; region != "" {
+		rb.SetCloudRegion(region)
+	}
+	if zone := node.Labels[corev1.LabelTopologyZone]; zone != "" {
+		rb.SetCloudAvailabilityZone(zone)
+	}
+	if instanceType := node.Labels[corev1.LabelInstanceTypeStable]; instanceType != "" {
+		rb.SetHostType(instanceType)
+	}
 }
 
+// nodeConditionValue returns the value of the condition of the given type of the node, see
+// utils.ConditionStatusValue. Conditions that are not reported by the node are Unknown.
 func nodeConditionValue(node *corev1.Node, condType corev1.NodeConditionType) int64 {
 	status := corev1.ConditionUnknown
 	for _, c := range node.Status.Conditions {
@@ -144,7 +201,36 @@ func nodeConditionValue(node *corev1.Node, condType corev1.NodeConditionType) in
 			break
 		}
 	}
-	return nodeConditionValues[status]
+	return utils.ConditionStatusValue(status)
+}
+
+// pressureConditions are the node conditions under which the kubelet starts evicting pods.
+var pressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+}
+
+// underPressure returns whether any of the pressure conditions of the node is True.
+func underPressure(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		for _, t := range pressureConditions {
+			if c.Type == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
 }
 
 func GetMetadata(node *corev1.Node) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
@@ -153,6 +239,23 @@ func GetMetadata(node *corev1.Node) map[experimentalmetricmetadata.ResourceID]*m
 	meta[conventions.AttributeK8SNodeName] = node.Name
 	meta[nodeCreationTime] = node.GetCreationTimestamp().Format(time.RFC3339)
 
+	// NodeInfo may not be populated yet for nodes that are still registering.
+	for key, value := range map[string]string{
+		nodeKubeletVersion:          node.Status.NodeInfo.KubeletVersion,
+		nodeKernelVersion:           node.Status.NodeInfo.KernelVersion,
+		nodeOSImage:                 node.Status.NodeInfo.OSImage,
+		nodeContainerRuntimeVersion: node.Status.NodeInfo.ContainerRuntimeVersion,
+	} {
+		if value != "" {
+			meta[key] = value
+		}
+	}
+	for label, key := range cloudLabels {
+		if value := node.Labels[label]; value != "" {
+			meta[key] = value
+		}
+	}
+
 	nodeID := experimentalmetricmetadata.ResourceID(node.UID)
 	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
 		nodeID: {
@@ -201,5 +304,17 @@ func setNodeAllocatableValue(dp pmetric.NumberDataPoint, res corev1.ResourceName
 }
 
 func getNodeAllocatableMetric(nodeAllocatableTypeValue string) string {
-	return fmt.Sprintf("k8s.node.allocatable_%s", strcase.ToSnake(nodeAllocatableTypeValue))
+	return fmt.Sprintf("k8s.node.allocatable_%s", sanitizeResourceName(nodeAllocatableTypeValue))
+}
+
+func getNodeCapacityMetric(nodeCapacityTypeValue string) string {
+	return fmt.Sprintf("k8s.node.capacity_%s", sanitizeResourceName(nodeCapacityTypeValue))
+}
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// sanitizeResourceName converts a resource name to snake case that can be used in a metric name.
+// Extended resource names are prefixed with a domain, e.g. nvidia.com/gpu becomes nvidia_com_gpu.
+func sanitizeResourceName(name string) string {
+	return invalidResourceNameChars.ReplaceAllString(strcase.ToSnake(name), "_")
 }
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -96,6 +97,72 @@ func TestNodeOptionalMetrics(t *testing.T) {
 	)
 
 }
+func TestNodeExtendedResourceMetrics(t *testing.T) {
+	n := testutils.NewNode("1")
+	n.Status.Allocatable["nvidia.com/gpu"] = *resource.NewQuantity(4, resource.DecimalSI)
+	n.Status.Capacity = corev1.ResourceList{
+		"nvidia.com/gpu": *resource.NewQuantity(8, resource.DecimalSI),
+	}
+	rb := metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig())
+	rm := CustomMetrics(receivertest.NewNopCreateSettings(), rb, n, nil,
+		[]string{"nvidia.com/gpu"},
+		pcommon.Timestamp(time.Now().UnixNano()),
+	)
+
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(0), "k8s.node.allocatable_nvidia_com_gpu", pmetric.MetricTypeGauge, 4)
+	assert.Equal(t, "{nvidia.com/gpu}", ms.At(0).Unit())
+	testutils.AssertMetricInt(t, ms.At(1), "k8s.node.capacity_nvidia_com_gpu", pmetric.MetricTypeGauge, 8)
+}
+
+func TestSanitizeResourceName(t *testing.T) {
+	tests := map[string]string{
+		"cpu":               "cpu",
+		"ephemeral-storage": "ephemeral_storage",
+		"hugepages-2Mi":     "hugepages_2_mi",
+		"nvidia.com/gpu":    "nvidia_com_gpu",
+		"example.com/foo":   "example_com_foo",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, sanitizeResourceName(name), name)
+	}
+}
+
+func TestNodeCapacityMetrics(t *testing.T) {
+	n := testutils.NewNode("1")
+	n.Status.Capacity = corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewMilliQuantity(2000, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(1024, resource.DecimalSI),
+	}
+	rb := metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig())
+	rm := CustomMetrics(receivertest.NewNopCreateSettings(), rb, n, nil,
+		[]string{"cpu", "memory", "pods"},
+		pcommon.Timestamp(time.Now().UnixNano()),
+	)
+
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	got := map[string]float64{}
+	for i := 0; i < ms.Len(); i++ {
+		dp := ms.At(i).Gauge().DataPoints().At(0)
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble {
+			got[ms.At(i).Name()] = dp.DoubleValue()
+		} else {
+			got[ms.At(i).Name()] = float64(dp.IntValue())
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"k8s.node.allocatable_cpu":    0.123,
+		"k8s.node.allocatable_memory": 456,
+		"k8s.node.allocatable_pods":   12,
+		"k8s.node.capacity_cpu":       2,
+		"k8s.node.capacity_memory":    1024,
+	}, got)
+}
+
 func TestNodeConditionValue(t *testing.T) {
 	type args struct {
 		node     *corev1.Node
@@ -151,6 +218,74 @@ func TestNodeConditionValue(t *testing.T) {
 			},
 			want: -1,
 		},
+		{
+			name: "Node with PIDPressure condition true",
+			args: args{
+				node: &corev1.Node{Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodePIDPressure,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				}},
+				condType: corev1.NodePIDPressure,
+			},
+			want: 1,
+		},
+		{
+			name: "Node with MemoryPressure condition false",
+			args: args{
+				node: &corev1.Node{Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeMemoryPressure,
+							Status: corev1.ConditionFalse,
+						},
+					},
+				}},
+				condType: corev1.NodeMemoryPressure,
+			},
+			want: 0,
+		},
+		{
+			name: "Node with DiskPressure condition unknown",
+			args: args{
+				node: &corev1.Node{Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeDiskPressure,
+							Status: corev1.ConditionUnknown,
+						},
+					},
+				}},
+				condType: corev1.NodeDiskPressure,
+			},
+			want: -1,
+		},
+		{
+			name: "Node without the condition",
+			args: args{
+				node:     &corev1.Node{},
+				condType: corev1.NodePIDPressure,
+			},
+			want: -1,
+		},
+		{
+			name: "Node with an unexpected condition status",
+			args: args{
+				node: &corev1.Node{Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{
+							Type:   corev1.NodeMemoryPressure,
+							Status: "",
+						},
+					},
+				}},
+				condType: corev1.NodeMemoryPressure,
+			},
+			want: -1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -162,6 +297,13 @@ func TestNodeConditionValue(t *testing.T) {
 	}
 }
 
+func TestGetNodeConditionMetric(t *testing.T) {
+	assert.Equal(t, "k8s.node.condition_ready", getNodeConditionMetric("Ready"))
+	assert.Equal(t, "k8s.node.condition_memory_pressure", getNodeConditionMetric("MemoryPressure"))
+	assert.Equal(t, "k8s.node.condition_disk_pressure", getNodeConditionMetric("DiskPressure"))
+	assert.Equal(t, "k8s.node.condition_pid_pressure", getNodeConditionMetric("PIDPressure"))
+}
+
 func TestNodeMetrics(t *testing.T) {
 	n := testutils.NewNode("1")
 
@@ -186,6 +328,160 @@ func TestNodeMetrics(t *testing.T) {
 	)
 }
 
+func TestNodeTaintAndUnschedulableMetrics(t *testing.T) {
+	n := testutils.NewNode("1")
+	n.Spec.Unschedulable = true
+	n.Spec.Taints = []corev1.Taint{
+		{Key: "node.kubernetes.io/unschedulable", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute},
+	}
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sNodeTaintCount.Enabled = true
+	mbc.Metrics.K8sNodeUnschedulable.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, n, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.node.uid":  "test-node-1-uid",
+			"k8s.node.name": "test-node-1",
+		},
+		rm.Resource().Attributes().AsRaw())
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.node.taint.count", pmetric.MetricTypeGauge, 2)
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.node.unschedulable", pmetric.MetricTypeGauge, 1)
+}
+
+func TestNodeEvictionRisk(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []corev1.NodeCondition
+		expected   int64
+	}{
+		{
+			name:     "no pressure",
+			expected: 0,
+		},
+		{
+			name: "disk pressure",
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue},
+			},
+			expected: 1,
+		},
+		{
+			name: "unknown pressure",
+			conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionUnknown},
+				{Type: corev1.NodePIDPressure, Status: corev1.ConditionFalse},
+			},
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := testutils.NewNode("1")
+			n.Status.Conditions = tt.conditions
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sNodeEvictionRisk.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, n, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.MetricCount())
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			testutils.AssertMetricInt(t, ms.At(0), "k8s.node.eviction_risk", pmetric.MetricTypeGauge, tt.expected)
+		})
+	}
+}
+
+func TestNodeAge(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := testutils.NewNode("1")
+	n.CreationTimestamp = metav1.NewTime(created)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sNodeAge.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	// The age is computed as of the collection timestamp.
+	RecordMetrics(mb, n, pcommon.NewTimestampFromTime(created.Add(2*time.Hour+500*time.Millisecond)))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.MetricCount())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	testutils.AssertMetricInt(t, ms.At(0), "k8s.node.age", pmetric.MetricTypeGauge, 7200)
+}
+
+func TestNodeMetadata(t *testing.T) {
+	n := testutils.NewNode("1")
+	n.Status.NodeInfo = corev1.NodeSystemInfo{}
+
+	actualMetadata := GetMetadata(n)
+
+	require.Equal(t, 1, len(actualMetadata))
+	require.Equal(t,
+		metadata.KubernetesMetadata{
+			EntityType:    "k8s.node",
+			ResourceIDKey: "k8s.node.uid",
+			ResourceID:    "test-node-1-uid",
+			Metadata: map[string]string{
+				"foo":                     "bar",
+				"foo1":                    "",
+				"k8s.node.name":           "test-node-1",
+				"node.creation_timestamp": "0001-01-01T00:00:00Z",
+			},
+		},
+		*actualMetadata["test-node-1-uid"],
+	)
+}
+
+func TestNodeCloudAttributes(t *testing.T) {
+	n := testutils.NewNode("1")
+	n.Labels = map[string]string{
+		"topology.kubernetes.io/region":    "us-east-1",
+		"topology.kubernetes.io/zone":      "us-east-1a",
+		"node.kubernetes.io/instance-type": "m5.xlarge",
+	}
+
+	meta := GetMetadata(n)["test-node-1-uid"].Metadata
+	assert.Equal(t, "us-east-1", meta["cloud.region"])
+	assert.Equal(t, "us-east-1a", meta["cloud.availability_zone"])
+	assert.Equal(t, "m5.xlarge", meta["host.type"])
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.ResourceAttributes.CloudRegion.Enabled = true
+	mbc.ResourceAttributes.CloudAvailabilityZone.Enabled = true
+	mbc.ResourceAttributes.HostType.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, n, pcommon.Timestamp(time.Now().UnixNano()))
+	attrs := mb.Emit().ResourceMetrics().At(0).Resource().Attributes().AsRaw()
+	assert.Equal(t, "us-east-1", attrs["cloud.region"])
+	assert.Equal(t, "us-east-1a", attrs["cloud.availability_zone"])
+	assert.Equal(t, "m5.xlarge", attrs["host.type"])
+
+	// Nodes without the labels, like bare metal nodes, don't get the attributes.
+	n.Labels = nil
+	assert.NotContains(t, GetMetadata(n)["test-node-1-uid"].Metadata, "cloud.region")
+	RecordMetrics(mb, n, pcommon.Timestamp(time.Now().UnixNano()))
+	attrs = mb.Emit().ResourceMetrics().At(0).Resource().Attributes().AsRaw()
+	assert.NotContains(t, attrs, "cloud.region")
+	assert.NotContains(t, attrs, "cloud.availability_zone")
+	assert.NotContains(t, attrs, "host.type")
+}
+
 func TestTransform(t *testing.T) {
 	originalNode := &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -249,6 +545,10 @@ func TestTransform(t *testing.T) {
 					Status: corev1.ConditionTrue,
 				},
 			},
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("8"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
 			Allocatable: corev1.ResourceList{
 				corev1.ResourceCPU:    resource.MustParse("4"),
 				corev1.ResourceMemory: resource.MustParse("8Gi"),
@@ -264,3 +564,30 @@ func TestTransform(t *testing.T) {
 	}
 	assert.Equal(t, wantNode, Transform(originalNode))
 }
+
+func TestNodeLeaseMetrics(t *testing.T) {
+	now := time.Now()
+	renewed := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node-1", Namespace: corev1.NamespaceNodeLease},
+		Spec: coordinationv1.LeaseSpec{
+			RenewTime: &metav1.MicroTime{Time: now.Add(-15 * time.Second)},
+		},
+	}
+	neverRenewed := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node-2", Namespace: corev1.NamespaceNodeLease},
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sNodeLeaseRenewAge.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	ts := pcommon.NewTimestampFromTime(now)
+	RecordLeaseMetrics(mb, renewed, ts)
+	RecordLeaseMetrics(mb, neverRenewed, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"k8s.node.name": "test-node-1"}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+	testutils.AssertMetricInt(t, rm.ScopeMetrics().At(0).Metrics().At(0), "k8s.node.lease_renew_age", pmetric.MetricTypeGauge, 15)
+}
@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pdb // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pdb"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	policyv1 "k8s.io/api/policy/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pdb *policyv1.PodDisruptionBudget, ts pcommon.Timestamp) {
+	mb.RecordK8sPdbCurrentHealthyDataPoint(ts, int64(pdb.Status.CurrentHealthy))
+	mb.RecordK8sPdbDesiredHealthyDataPoint(ts, int64(pdb.Status.DesiredHealthy))
+	mb.RecordK8sPdbExpectedPodsDataPoint(ts, int64(pdb.Status.ExpectedPods))
+	mb.RecordK8sPdbDisruptionsAllowedDataPoint(ts, int64(pdb.Status.DisruptionsAllowed))
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(pdb.Namespace)
+	rb.SetK8sPdbUID(string(pdb.UID))
+	rb.SetK8sPdbName(pdb.Name)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
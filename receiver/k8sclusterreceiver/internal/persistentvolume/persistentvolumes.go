@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolume // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolume"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pv *corev1.PersistentVolume, ts pcommon.Timestamp) {
+	// A missing storage capacity results in a zero quantity.
+	capacity := pv.Spec.Capacity[corev1.ResourceStorage]
+	mb.RecordK8sPersistentvolumeCapacityDataPoint(ts, capacity.Value())
+	mb.RecordK8sPersistentvolumePhaseDataPoint(ts, int64(phaseToInt(pv.Status.Phase)))
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sPersistentvolumeUID(string(pv.UID))
+	rb.SetK8sPersistentvolumeName(pv.Name)
+	if pv.Spec.StorageClassName != "" {
+		rb.SetK8sStorageclassName(pv.Spec.StorageClassName)
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func phaseToInt(phase corev1.PersistentVolumePhase) int32 {
+	switch phase {
+	case corev1.VolumePending:
+		return 1
+	case corev1.VolumeAvailable:
+		return 2
+	case corev1.VolumeBound:
+		return 3
+	case corev1.VolumeReleased:
+		return 4
+	case corev1.VolumeFailed:
+		return 5
+	default:
+		return 0
+	}
+}
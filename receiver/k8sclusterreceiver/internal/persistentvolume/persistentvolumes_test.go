@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolume
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPersistentVolumeMetrics(t *testing.T) {
+	pv := testutils.NewPersistentVolume("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, pv, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.persistentvolume.uid":  "test-persistentvolume-1-uid",
+			"k8s.persistentvolume.name": "test-persistentvolume-1",
+			"k8s.storageclass.name":     "standard",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.persistentvolume.capacity", pmetric.MetricTypeGauge, int64(1024))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.persistentvolume.phase", pmetric.MetricTypeGauge, int64(3))
+}
+
+func TestPersistentVolumeMetricsWithoutCapacity(t *testing.T) {
+	pv := testutils.NewPersistentVolume("1")
+	pv.Spec.Capacity = nil
+	pv.Spec.StorageClassName = ""
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, pv, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	_, ok := rm.Resource().Attributes().Get("k8s.storageclass.name")
+	assert.False(t, ok)
+
+	sms := rm.ScopeMetrics().At(0)
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.persistentvolume.capacity", pmetric.MetricTypeGauge, int64(0))
+}
+
+func metricsBuilderConfig() metadata.MetricsBuilderConfig {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPersistentvolumeCapacity.Enabled = true
+	mbc.Metrics.K8sPersistentvolumePhase.Enabled = true
+	return mbc
+}
+
+func TestPhaseToInt(t *testing.T) {
+	tests := []struct {
+		phase corev1.PersistentVolumePhase
+		want  int32
+	}{
+		{corev1.VolumePending, 1},
+		{corev1.VolumeAvailable, 2},
+		{corev1.VolumeBound, 3},
+		{corev1.VolumeReleased, 4},
+		{corev1.VolumeFailed, 5},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.phase), func(t *testing.T) {
+			assert.Equal(t, tt.want, phaseToInt(tt.phase))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolumeclaim // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolumeclaim"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pvc *corev1.PersistentVolumeClaim, ts pcommon.Timestamp) {
+	mb.RecordK8sPersistentvolumeclaimPhaseDataPoint(ts, int64(phaseToInt(pvc.Status.Phase)))
+	if storage, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeclaimRequestedStorageDataPoint(ts, storage.Value())
+	}
+	// The capacity is only set once the claim is bound.
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeclaimActualCapacityDataPoint(ts, capacity.Value())
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(pvc.Namespace)
+	rb.SetK8sPersistentvolumeclaimName(pvc.Name)
+	rb.SetK8sPersistentvolumeclaimUID(string(pvc.UID))
+	if pvc.Spec.VolumeName != "" {
+		rb.SetK8sPersistentvolumeName(pvc.Spec.VolumeName)
+	}
+	if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+		rb.SetK8sStorageclassName(*pvc.Spec.StorageClassName)
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func phaseToInt(phase corev1.PersistentVolumeClaimPhase) int32 {
+	switch phase {
+	case corev1.ClaimPending:
+		return 1
+	case corev1.ClaimBound:
+		return 2
+	case corev1.ClaimLost:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolumeclaim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPersistentVolumeClaimMetrics(t *testing.T) {
+	pvc := testutils.NewPersistentVolumeClaim("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, pvc, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.namespace.name":             "test-namespace",
+			"k8s.persistentvolumeclaim.uid":  "test-persistentvolumeclaim-1-uid",
+			"k8s.persistentvolumeclaim.name": "test-persistentvolumeclaim-1",
+			"k8s.persistentvolume.name":      "test-persistentvolume-1",
+			"k8s.storageclass.name":          "standard",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.persistentvolumeclaim.phase", pmetric.MetricTypeGauge, int64(2))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.persistentvolumeclaim.requested_storage", pmetric.MetricTypeGauge, int64(1024))
+}
+
+func TestPendingPersistentVolumeClaimMetrics(t *testing.T) {
+	pvc := testutils.NewPersistentVolumeClaim("1")
+	pvc.Spec.Resources.Requests = nil
+	pvc.Spec.VolumeName = ""
+	pvc.Spec.StorageClassName = nil
+	pvc.Status.Phase = corev1.ClaimPending
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, pvc, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.namespace.name":             "test-namespace",
+			"k8s.persistentvolumeclaim.uid":  "test-persistentvolumeclaim-1-uid",
+			"k8s.persistentvolumeclaim.name": "test-persistentvolumeclaim-1",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 1, sms.Metrics().Len())
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.persistentvolumeclaim.phase", pmetric.MetricTypeGauge, int64(1))
+}
+
+func TestPersistentVolumeClaimActualCapacity(t *testing.T) {
+	mbc := metricsBuilderConfig()
+	mbc.Metrics.K8sPersistentvolumeclaimActualCapacity.Enabled = true
+
+	// The volume was expanded beyond the request.
+	pvc := testutils.NewPersistentVolumeClaim("1")
+	pvc.Status.Capacity = corev1.ResourceList{
+		corev1.ResourceStorage: *resource.NewQuantity(2048, resource.BinarySI),
+	}
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, pvc, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	sms := m.ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, 3, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.persistentvolumeclaim.actual_capacity", pmetric.MetricTypeGauge, int64(2048))
+	testutils.AssertMetricInt(t, sms.Metrics().At(2), "k8s.persistentvolumeclaim.requested_storage", pmetric.MetricTypeGauge, int64(1024))
+
+	// Claims that are not bound yet don't have a capacity.
+	pvc.Status.Capacity = nil
+	mb = metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, pvc, pcommon.Timestamp(time.Now().UnixNano()))
+	sms = mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	for i := 0; i < sms.Metrics().Len(); i++ {
+		assert.NotEqual(t, "k8s.persistentvolumeclaim.actual_capacity", sms.Metrics().At(i).Name())
+	}
+}
+
+func metricsBuilderConfig() metadata.MetricsBuilderConfig {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPersistentvolumeclaimPhase.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeclaimRequestedStorage.Enabled = true
+	return mbc
+}
@@ -38,27 +38,78 @@ func Transform(pod *corev1.Pod) *corev1.Pod {
 	newPod := &corev1.Pod{
 		ObjectMeta: metadata.TransformObjectMeta(pod.ObjectMeta),
 		Spec: corev1.PodSpec{
-			NodeName: pod.Spec.NodeName,
+			NodeName:          pod.Spec.NodeName,
+			Priority:          pod.Spec.Priority,
+			PriorityClassName: pod.Spec.PriorityClassName,
 		},
 		Status: corev1.PodStatus{
-			Phase:    pod.Status.Phase,
-			QOSClass: pod.Status.QOSClass,
+			Phase:     pod.Status.Phase,
+			QOSClass:  pod.Status.QOSClass,
+			StartTime: pod.Status.StartTime,
 		},
 	}
+	// Mirror pods are identified by their annotation, which is otherwise dropped.
+	if v, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		newPod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: v}
+	}
+	for _, c := range pod.Status.Conditions {
+		newPod.Status.Conditions = append(newPod.Status.Conditions, corev1.PodCondition{
+			Type:               c.Type,
+			Status:             c.Status,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+		})
+	}
 	for _, cs := range pod.Status.ContainerStatuses {
-		if cs.ContainerID == "" {
+		// Containers that haven't been created yet are only kept while they are waiting,
+		// e.g. for their image to be pulled.
+		if cs.ContainerID == "" && cs.State.Waiting == nil {
 			continue
 		}
-		newPod.Status.ContainerStatuses = append(newPod.Status.ContainerStatuses, corev1.ContainerStatus{
+		newCS := corev1.ContainerStatus{
 			Name:         cs.Name,
 			Image:        cs.Image,
+			ImageID:      cs.ImageID,
 			ContainerID:  cs.ContainerID,
 			RestartCount: cs.RestartCount,
 			Ready:        cs.Ready,
+			Started:      cs.Started,
+		}
+		if cs.State.Waiting != nil {
+			newCS.State.Waiting = &corev1.ContainerStateWaiting{
+				Reason: cs.State.Waiting.Reason,
+			}
+		}
+		if cs.LastTerminationState.Terminated != nil {
+			newCS.LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+				Reason:   cs.LastTerminationState.Terminated.Reason,
+				ExitCode: cs.LastTerminationState.Terminated.ExitCode,
+			}
+		}
+		newPod.Status.ContainerStatuses = append(newPod.Status.ContainerStatuses, newCS)
+	}
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.ContainerID == "" {
+			continue
+		}
+		newPod.Status.InitContainerStatuses = append(newPod.Status.InitContainerStatuses, corev1.ContainerStatus{
+			Name:        cs.Name,
+			Image:       cs.Image,
+			ContainerID: cs.ContainerID,
 		})
 	}
 	for _, c := range pod.Spec.Containers {
 		newPod.Spec.Containers = append(newPod.Spec.Containers, corev1.Container{
+			Name:  c.Name,
+			Image: c.Image,
+			Resources: corev1.ResourceRequirements{
+				Requests: c.Resources.Requests,
+				Limits:   c.Resources.Limits,
+			},
+		})
+	}
+	for _, c := range pod.Spec.InitContainers {
+		newPod.Spec.InitContainers = append(newPod.Spec.InitContainers, corev1.Container{
 			Name: c.Name,
 			Resources: corev1.ResourceRequirements{
 				Requests: c.Resources.Requests,
@@ -69,20 +120,147 @@ func Transform(pod *corev1.Pod) *corev1.Pod {
 	return newPod
 }
 
-func RecordMetrics(logger *zap.Logger, mb *metadata.MetricsBuilder, pod *corev1.Pod, ts pcommon.Timestamp) {
+// RecordMetrics records the metrics of the pod and its containers. nodeAllocatable holds the
+// allocatable resources of the node of the pod, or is nil if the node is not known.
+func RecordMetrics(logger *zap.Logger, mb *metadata.MetricsBuilder, pod *corev1.Pod, nodeAllocatable corev1.ResourceList, ts pcommon.Timestamp) {
 	mb.RecordK8sPodPhaseDataPoint(ts, int64(phaseToInt(pod.Status.Phase)))
 	mb.RecordK8sPodStatusReasonDataPoint(ts, int64(reasonToInt(pod.Status.Reason)))
+	for _, c := range pod.Status.Conditions {
+		mb.RecordK8sPodConditionDataPoint(ts, utils.ConditionStatusValue(c.Status), string(c.Type))
+	}
+	if reason, ok := unschedulableReason(pod); ok {
+		mb.RecordK8sPodUnschedulableDataPoint(ts, 1, reason)
+	}
+	if latency, ok := schedulingLatency(pod); ok {
+		mb.RecordK8sPodSchedulingLatencyDataPoint(ts, latency)
+	}
+	if !pod.CreationTimestamp.IsZero() {
+		mb.RecordK8sPodAgeDataPoint(ts, int64(ts.AsTime().Sub(pod.CreationTimestamp.Time).Seconds()))
+	}
+	if orphaned, ok := isOrphaned(pod); ok {
+		mb.RecordK8sPodOrphanedDataPoint(ts, orphaned)
+	}
+	if pod.Spec.Priority != nil {
+		mb.RecordK8sPodPriorityDataPoint(ts, int64(*pod.Spec.Priority))
+	}
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sNamespaceName(pod.Namespace)
 	rb.SetK8sNodeName(pod.Spec.NodeName)
 	rb.SetK8sPodName(pod.Name)
 	rb.SetK8sPodUID(string(pod.UID))
-	rb.SetK8sPodQosClass(string(pod.Status.QOSClass))
+	rb.SetK8sPodQosClass(string(qosClass(pod)))
+	if pod.Spec.PriorityClassName != "" {
+		rb.SetK8sPodPriorityClassName(pod.Spec.PriorityClassName)
+	}
 	mb.EmitForResource(metadata.WithResource(rb.Emit()))
 
 	for _, c := range pod.Spec.Containers {
-		container.RecordSpecMetrics(logger, mb, c, pod, ts)
+		container.RecordSpecMetrics(logger, mb, c, pod, nodeAllocatable, ts)
 	}
+	for _, c := range pod.Spec.InitContainers {
+		container.RecordInitContainerSpecMetrics(logger, mb, c, pod, ts)
+	}
+}
+
+// isOrphaned returns 1 if the pod has no controller owner, e.g. when it was left behind by a
+// deleted workload. Mirror pods are managed by the kubelet and are not reported.
+func isOrphaned(pod *corev1.Pod) (int64, bool) {
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return 0, false
+	}
+	if v1.GetControllerOfNoCopy(pod) == nil {
+		return 1, true
+	}
+	return 0, true
+}
+
+// unschedulableReason returns the reason of the PodScheduled condition of a pending pod that
+// the scheduler failed to schedule. It returns false for pods that are scheduled or not pending.
+func unschedulableReason(pod *corev1.Pod) (string, bool) {
+	if pod.Status.Phase != corev1.PodPending {
+		return "", false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse {
+			return c.Reason, true
+		}
+	}
+	return "", false
+}
+
+// schedulingLatency returns the seconds between the creation of the pod and the last transition
+// of its PodScheduled condition. It returns false if the pod isn't scheduled and started yet.
+func schedulingLatency(pod *corev1.Pod) (float64, bool) {
+	if pod.Status.StartTime == nil {
+		return 0, false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime.Sub(pod.CreationTimestamp.Time).Seconds(), true
+		}
+	}
+	return 0, false
+}
+
+// qosClass returns the QoS class of the pod. The class reported in the pod status is used if set,
+// otherwise it's computed from the container requests and limits, e.g. for pods that are still pending.
+func qosClass(pod *corev1.Pod) corev1.PodQOSClass {
+	if pod.Status.QOSClass != "" {
+		return pod.Status.QOSClass
+	}
+
+	requests := corev1.ResourceList{}
+	limits := corev1.ResourceList{}
+	isGuaranteed := true
+	containers := make([]corev1.Container, 0, len(pod.Spec.Containers)+len(pod.Spec.InitContainers))
+	containers = append(containers, pod.Spec.Containers...)
+	containers = append(containers, pod.Spec.InitContainers...)
+	for _, c := range containers {
+		for name, quantity := range c.Resources.Requests {
+			if !isQOSComputeResource(name) || quantity.IsZero() {
+				continue
+			}
+			if existing, ok := requests[name]; ok {
+				quantity.Add(existing)
+			}
+			requests[name] = quantity
+		}
+		qosLimitsFound := 0
+		for name, quantity := range c.Resources.Limits {
+			if !isQOSComputeResource(name) || quantity.IsZero() {
+				continue
+			}
+			qosLimitsFound++
+			if existing, ok := limits[name]; ok {
+				quantity.Add(existing)
+			}
+			limits[name] = quantity
+		}
+		// A container must set both cpu and memory limits for the pod to be Guaranteed.
+		if qosLimitsFound != 2 {
+			isGuaranteed = false
+		}
+	}
+
+	if len(requests) == 0 && len(limits) == 0 {
+		return corev1.PodQOSBestEffort
+	}
+	if isGuaranteed {
+		for name, req := range requests {
+			if lim, ok := limits[name]; !ok || lim.Cmp(req) != 0 {
+				isGuaranteed = false
+				break
+			}
+		}
+	}
+	if isGuaranteed && len(requests) == len(limits) {
+		return corev1.PodQOSGuaranteed
+	}
+	return corev1.PodQOSBurstable
+}
+
+func isQOSComputeResource(name corev1.ResourceName) bool {
+	return name == corev1.ResourceCPU || name == corev1.ResourceMemory
 }
 
 func reasonToInt(reason string) int32 {
@@ -102,6 +280,22 @@ func reasonToInt(reason string) int32 {
 	}
 }
 
+// podPhases lists the phases reported by k8s.cluster.pod.count.
+var podPhases = []corev1.PodPhase{corev1.PodPending, corev1.PodRunning, corev1.PodSucceeded, corev1.PodFailed, corev1.PodUnknown}
+
+// RecordClusterMetrics records the number of pods per phase across the cluster. Pods with an
+// empty or unrecognized phase are counted as Unknown.
+func RecordClusterMetrics(mb *metadata.MetricsBuilder, pods []*corev1.Pod, ts pcommon.Timestamp) {
+	counts := make([]int64, len(podPhases))
+	for _, pod := range pods {
+		counts[phaseToInt(pod.Status.Phase)-1]++
+	}
+	for i, phase := range podPhases {
+		mb.RecordK8sClusterPodCountDataPoint(ts, counts[i], string(phase))
+	}
+	mb.EmitForResource()
+}
+
 func phaseToInt(phase corev1.PodPhase) int32 {
 	switch phase {
 	case corev1.PodPending:
@@ -112,9 +306,8 @@ func phaseToInt(phase corev1.PodPhase) int32 {
 		return 3
 	case corev1.PodFailed:
 		return 4
-	case corev1.PodUnknown:
-		return 5
 	default:
+		// Pods with an empty or unrecognized phase are reported as Unknown.
 		return 5
 	}
 }
@@ -137,6 +330,7 @@ func GetMetadata(pod *corev1.Pod, mc *metadata.Store, logger *zap.Logger) map[ex
 		meta[constants.K8sKeyWorkLoadKind] = or.Kind
 		meta[constants.K8sKeyWorkLoadName] = or.Name
 	}
+	meta = maps.MergeStringMaps(meta, metadata.GetControllerOwnerMetadata(&pod.ObjectMeta, "pod"))
 
 	if store := mc.Get(gvk.Service); store != nil {
 		meta = maps.MergeStringMaps(meta, service.GetPodServiceTags(pod, store))
@@ -158,7 +352,7 @@ func GetMetadata(pod *corev1.Pod, mc *metadata.Store, logger *zap.Logger) map[ex
 			ResourceID:    podID,
 			Metadata:      meta,
 		},
-	}, getPodContainerProperties(pod))
+	}, getPodContainerProperties(pod, logger))
 }
 
 // collectPodJobProperties checks if pod owner of type Job is cached. Check owners reference
@@ -235,11 +429,18 @@ func getWorkloadProperties(ref *v1.OwnerReference, labelKey string) map[string]s
 	}
 }
 
-func getPodContainerProperties(pod *corev1.Pod) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+func getPodContainerProperties(pod *corev1.Pod, logger *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
 	km := map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{}
-	for _, cs := range pod.Status.ContainerStatuses {
-		md := container.GetMetadata(cs)
-		km[md.ResourceID] = md
+	for _, c := range pod.Spec.Containers {
+		for _, cs := range pod.Status.ContainerStatuses {
+			// Containers without an ID, e.g. waiting for their image to be pulled, have no entity yet.
+			if cs.Name != c.Name || cs.ContainerID == "" {
+				continue
+			}
+			md := container.GetMetadata(c, cs, logger)
+			km[md.ResourceID] = md
+			break
+		}
 	}
 	return km
 }
@@ -6,6 +6,7 @@ package pod
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -45,7 +47,7 @@ func TestPodAndContainerMetricsReportCPUMetrics(t *testing.T) {
 
 	ts := pcommon.Timestamp(time.Now().UnixNano())
 	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
-	RecordMetrics(zap.NewNop(), mb, pod, ts)
+	RecordMetrics(zap.NewNop(), mb, pod, nil, ts)
 	m := mb.Emit()
 	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
 	require.NoError(t, err)
@@ -71,7 +73,7 @@ func TestPodStatusReasonAndContainerMetricsReportCPUMetrics(t *testing.T) {
 	mbc.ResourceAttributes.K8sPodQosClass.Enabled = true
 	ts := pcommon.Timestamp(time.Now().UnixNano())
 	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
-	RecordMetrics(zap.NewNop(), mb, pod, ts)
+	RecordMetrics(zap.NewNop(), mb, pod, nil, ts)
 	m := mb.Emit()
 
 	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected_evicted.yaml"))
@@ -86,10 +88,620 @@ func TestPodStatusReasonAndContainerMetricsReportCPUMetrics(t *testing.T) {
 	)
 }
 
+func TestContainerLastTerminationReason(t *testing.T) {
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "oomkilled"},
+				{Name: "never-terminated"},
+			},
+		},
+		&corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:        "oomkilled",
+					ContainerID: containerIDWithPreifx("container-id-1"),
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"},
+					},
+				},
+				{
+					Name:        "never-terminated",
+					ContainerID: containerIDWithPreifx("container-id-2"),
+				},
+			},
+		},
+	)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerLastTerminationReason.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, pod, nil, ts)
+	m := mb.Emit()
+
+	reasons := map[string]int64{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+		if !ok {
+			continue
+		}
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() == "k8s.container.last_termination_reason" {
+				require.Equal(t, pmetric.MetricTypeGauge, ms.At(j).Type())
+				reasons[name.Str()] = ms.At(j).Gauge().DataPoints().At(0).IntValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"oomkilled": 1}, reasons)
+}
+
+func TestContainerLastExitCode(t *testing.T) {
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "completed"},
+				{Name: "killed"},
+				{Name: "never-terminated"},
+			},
+		},
+		&corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:        "completed",
+					ContainerID: containerIDWithPreifx("container-id-1"),
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "Completed", ExitCode: 0},
+					},
+				},
+				{
+					Name:        "killed",
+					ContainerID: containerIDWithPreifx("container-id-2"),
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+					},
+				},
+				{
+					Name:        "never-terminated",
+					ContainerID: containerIDWithPreifx("container-id-3"),
+				},
+			},
+		},
+	)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerLastExitCode.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, Transform(pod), nil, ts)
+	m := mb.Emit()
+
+	exitCodes := map[string]int64{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+		if !ok {
+			continue
+		}
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() == "k8s.container.last_exit_code" {
+				require.Equal(t, pmetric.MetricTypeGauge, ms.At(j).Type())
+				exitCodes[name.Str()] = ms.At(j).Gauge().DataPoints().At(0).IntValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"completed": 0, "killed": 137}, exitCodes)
+}
+
+func TestContainerStartedMetric(t *testing.T) {
+	started := true
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "started"},
+				{Name: "not-reported"},
+				{Name: "not-created"},
+			},
+		},
+		&corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:        "started",
+					ContainerID: containerIDWithPreifx("container-id-1"),
+					Started:     &started,
+				},
+				{
+					Name:        "not-reported",
+					ContainerID: containerIDWithPreifx("container-id-2"),
+				},
+			},
+		},
+	)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerStarted.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, pod, nil, ts)
+	m := mb.Emit()
+
+	values := map[string]int64{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+		if !ok {
+			continue
+		}
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() == "k8s.container.started" {
+				require.Equal(t, pmetric.MetricTypeGauge, ms.At(j).Type())
+				values[name.Str()] = ms.At(j).Gauge().DataPoints().At(0).IntValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"started": 1}, values)
+}
+
+func TestPendingPodContainerMetrics(t *testing.T) {
+	started := false
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{Containers: []corev1.Container{{Name: "creating"}}},
+		&corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					// Containers that haven't been created yet have no ID.
+					Name:    "creating",
+					Image:   "nginx:latest",
+					Started: &started,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"},
+					},
+				},
+			},
+		},
+	)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerStarted.Enabled = true
+	mbc.Metrics.K8sContainerWaiting.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, Transform(pod), nil, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 2, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(1)
+	name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+	require.True(t, ok)
+	assert.Equal(t, "creating", name.Str())
+	assert.NotContains(t, rm.Resource().Attributes().AsRaw(), "container.id")
+
+	// Only the waiting reason is reported for the container.
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, ms.Len())
+	testutils.AssertMetricInt(t, ms.At(0), "k8s.container.waiting", pmetric.MetricTypeGauge, int64(1))
+}
+
+func TestContainerWaitingMetric(t *testing.T) {
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "crash-looping"},
+				{Name: "pulling"},
+				{Name: "running"},
+			},
+		},
+		&corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:        "crash-looping",
+					ContainerID: containerIDWithPreifx("container-id-1"),
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off restarting"},
+					},
+				},
+				{
+					// Containers waiting for their image have no ID yet.
+					Name: "pulling",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+					},
+				},
+				{
+					Name:        "running",
+					ContainerID: containerIDWithPreifx("container-id-3"),
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{},
+					},
+				},
+			},
+		},
+	)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerWaiting.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, Transform(pod), nil, ts)
+	m := mb.Emit()
+
+	reasons := map[string]string{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+		if !ok {
+			continue
+		}
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() == "k8s.container.waiting" {
+				testutils.AssertMetricInt(t, ms.At(j), "k8s.container.waiting", pmetric.MetricTypeGauge, int64(1))
+				reason, ok := ms.At(j).Gauge().DataPoints().At(0).Attributes().Get("reason")
+				require.True(t, ok)
+				reasons[name.Str()] = reason.Str()
+			}
+		}
+	}
+	assert.Equal(t, map[string]string{"crash-looping": "CrashLoopBackOff", "pulling": "ImagePullBackOff"}, reasons)
+}
+
+func TestGetMetadataSkipsContainersWithoutID(t *testing.T) {
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{Containers: []corev1.Container{{Name: "pulling"}}},
+		&corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "pulling",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+					},
+				},
+			},
+		},
+	)
+	actual := GetMetadata(Transform(pod), metadata.NewStore(), zap.NewNop())
+	require.Len(t, actual, 1)
+	assert.Contains(t, actual, experimentalmetricmetadata.ResourceID("test-pod-1-uid"))
+}
+
+func TestContainerLimitRatioMetrics(t *testing.T) {
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "burstable",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100m"),
+							corev1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("250m"),
+							corev1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+					},
+				},
+				{
+					Name: "no-limits",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("100m"),
+						},
+					},
+				},
+				{
+					Name: "zero-request",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("0"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+					},
+				},
+			},
+		},
+		&corev1.PodStatus{},
+	)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sContainerCPULimitRatio.Enabled = true
+	mbc.Metrics.K8sContainerMemoryLimitRatio.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, pod, nil, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	ratios := map[string]float64{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+		if !ok {
+			continue
+		}
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			switch ms.At(j).Name() {
+			case "k8s.container.cpu_limit_ratio", "k8s.container.memory_limit_ratio":
+				require.Equal(t, pmetric.MetricTypeGauge, ms.At(j).Type())
+				ratios[name.Str()+"/"+ms.At(j).Name()] = ms.At(j).Gauge().DataPoints().At(0).DoubleValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"burstable/k8s.container.cpu_limit_ratio":    2.5,
+		"burstable/k8s.container.memory_limit_ratio": 2,
+	}, ratios)
+}
+
+func TestInitContainerMetrics(t *testing.T) {
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("64Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("200m"),
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+	}
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{
+			Containers:     []corev1.Container{{Name: "app-container", Resources: resources}},
+			InitContainers: []corev1.Container{{Name: "init-container", Resources: resources}},
+		},
+		&corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app-container", ContainerID: containerIDWithPreifx("app-container-id")},
+			},
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "init-container", ContainerID: containerIDWithPreifx("init-container-id")},
+			},
+		},
+	)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.ResourceAttributes.K8sContainerType.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, pod, nil, ts)
+	m := mb.Emit()
+
+	// One resource for the pod and one for each container.
+	require.Equal(t, 3, m.ResourceMetrics().Len())
+	containerTypes := map[string]string{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+		if !ok {
+			continue
+		}
+		containerType, ok := rm.Resource().Attributes().Get("k8s.container.type")
+		require.True(t, ok)
+		containerTypes[name.Str()] = containerType.Str()
+
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		metricNames := map[string]bool{}
+		for j := 0; j < ms.Len(); j++ {
+			metricNames[ms.At(j).Name()] = true
+		}
+		for _, expected := range []string{
+			"k8s.container.cpu_request",
+			"k8s.container.cpu_limit",
+			"k8s.container.memory_request",
+			"k8s.container.memory_limit",
+		} {
+			assert.True(t, metricNames[expected], "missing %s for container %s", expected, name.Str())
+		}
+	}
+	assert.Equal(t, map[string]string{"app-container": "app", "init-container": "init"}, containerTypes)
+}
+
+func TestContainerEphemeralStorageMetrics(t *testing.T) {
+	pod := testutils.NewPodWithContainer(
+		"1",
+		&corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "with-ephemeral-storage",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+						},
+					},
+				},
+				{
+					Name: "without-ephemeral-storage",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+					},
+				},
+			},
+		},
+		&corev1.PodStatus{},
+	)
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, pod, nil, ts)
+	m := mb.Emit()
+
+	values := map[string]map[string]int64{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("k8s.container.name")
+		if !ok {
+			continue
+		}
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			switch ms.At(j).Name() {
+			case "k8s.container.ephemeralstorage_request", "k8s.container.ephemeralstorage_limit":
+				if values[name.Str()] == nil {
+					values[name.Str()] = map[string]int64{}
+				}
+				values[name.Str()][ms.At(j).Name()] = ms.At(j).Gauge().DataPoints().At(0).IntValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]map[string]int64{
+		"with-ephemeral-storage": {
+			"k8s.container.ephemeralstorage_request": 1 << 30,
+			"k8s.container.ephemeralstorage_limit":   2 << 30,
+		},
+	}, values)
+}
+
+func TestQOSClass(t *testing.T) {
+	resources := func(cpuRequest, memoryRequest, cpuLimit, memoryLimit string) corev1.ResourceRequirements {
+		rr := corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{},
+			Limits:   corev1.ResourceList{},
+		}
+		if cpuRequest != "" {
+			rr.Requests[corev1.ResourceCPU] = resource.MustParse(cpuRequest)
+		}
+		if memoryRequest != "" {
+			rr.Requests[corev1.ResourceMemory] = resource.MustParse(memoryRequest)
+		}
+		if cpuLimit != "" {
+			rr.Limits[corev1.ResourceCPU] = resource.MustParse(cpuLimit)
+		}
+		if memoryLimit != "" {
+			rr.Limits[corev1.ResourceMemory] = resource.MustParse(memoryLimit)
+		}
+		return rr
+	}
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want corev1.PodQOSClass
+	}{
+		{
+			name: "from status",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Resources: resources("", "", "", "")}},
+				},
+				Status: corev1.PodStatus{QOSClass: corev1.PodQOSGuaranteed},
+			},
+			want: corev1.PodQOSGuaranteed,
+		},
+		{
+			name: "no requests or limits",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Resources: resources("", "", "", "")}},
+				},
+			},
+			want: corev1.PodQOSBestEffort,
+		},
+		{
+			name: "requests equal limits",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Resources: resources("1", "1Gi", "1", "1Gi")}},
+				},
+			},
+			want: corev1.PodQOSGuaranteed,
+		},
+		{
+			name: "requests lower than limits",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Resources: resources("500m", "1Gi", "1", "1Gi")}},
+				},
+			},
+			want: corev1.PodQOSBurstable,
+		},
+		{
+			name: "memory limit missing",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Resources: resources("1", "", "1", "")}},
+				},
+			},
+			want: corev1.PodQOSBurstable,
+		},
+		{
+			name: "init container without limits",
+			pod: &corev1.Pod{
+				Spec: corev1.PodSpec{
+					Containers:     []corev1.Container{{Resources: resources("1", "1Gi", "1", "1Gi")}},
+					InitContainers: []corev1.Container{{Resources: resources("100m", "", "", "")}},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodPending},
+			},
+			want: corev1.PodQOSBurstable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, qosClass(tt.pod))
+		})
+	}
+}
+
 var containerIDWithPreifx = func(containerID string) string {
 	return "docker://" + containerID
 }
 
+func TestRecordClusterMetrics(t *testing.T) {
+	var pods []*corev1.Pod
+	for i, phase := range []corev1.PodPhase{corev1.PodRunning, corev1.PodRunning, corev1.PodPending, corev1.PodSucceeded, ""} {
+		pod := testutils.NewPodWithContainer(strconv.Itoa(i), &corev1.PodSpec{}, &corev1.PodStatus{})
+		pod.Status.Phase = phase
+		pods = append(pods, pod)
+	}
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sClusterPodCount.Enabled = true
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordClusterMetrics(mb, pods, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t, 0, rm.Resource().Attributes().Len())
+	require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+	metric := rm.ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "k8s.cluster.pod.count", metric.Name())
+
+	counts := map[string]int64{}
+	dps := metric.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		phase, ok := dps.At(i).Attributes().Get("phase")
+		require.True(t, ok)
+		counts[phase.Str()] = dps.At(i).IntValue()
+	}
+	assert.Equal(t, map[string]int64{
+		"Pending":   1,
+		"Running":   2,
+		"Succeeded": 1,
+		"Failed":    0,
+		"Unknown":   1,
+	}, counts)
+}
+
 func TestPhaseToInt(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -121,6 +733,11 @@ func TestPhaseToInt(t *testing.T) {
 			phase: corev1.PodUnknown,
 			want:  5,
 		},
+		{
+			name:  "Pod phase empty",
+			phase: "",
+			want:  5,
+		},
 	}
 
 	for _, tt := range tests {
@@ -346,7 +963,93 @@ func podWithOwnerReference(kind string) *corev1.Pod {
 	).(*corev1.Pod)
 }
 
+func TestPodMetadataControllerOwner(t *testing.T) {
+	isController := true
+	pod := testutils.WithOwnerReferences(
+		[]v1.OwnerReference{
+			{
+				Kind:       "ReplicaSet",
+				Name:       "test-replicaset-0",
+				UID:        "test-replicaset-0-uid",
+				Controller: &isController,
+			},
+		}, testutils.NewPodWithContainer("0", &corev1.PodSpec{}, &corev1.PodStatus{}),
+	).(*corev1.Pod)
+
+	ms := metadata.NewStore()
+	ms.Setup(gvk.ReplicaSet, &testutils.MockStore{
+		Cache: map[string]any{
+			"test-namespace/test-replicaset-0": testutils.WithOwnerReferences(
+				[]v1.OwnerReference{
+					{
+						Kind:       "Deployment",
+						Name:       "test-deployment-0",
+						UID:        "test-deployment-0-uid",
+						Controller: &isController,
+					},
+				}, testutils.NewReplicaSet("0"),
+			),
+		},
+	})
+
+	actual := GetMetadata(pod, ms, zap.NewNop())
+	podMetadata := actual[experimentalmetricmetadata.ResourceID("test-pod-0-uid")].Metadata
+	assert.Equal(t, "ReplicaSet", podMetadata["k8s.pod.owner.kind"])
+	assert.Equal(t, "test-replicaset-0-uid", podMetadata["k8s.pod.owner.uid"])
+	assert.Equal(t, "test-deployment-0", podMetadata["k8s.deployment.name"])
+	assert.Equal(t, "test-deployment-0-uid", podMetadata["k8s.deployment.uid"])
+
+	// Orphaned pods have no owner keys.
+	actual = GetMetadata(testutils.NewPodWithContainer("0", &corev1.PodSpec{}, &corev1.PodStatus{}), ms, zap.NewNop())
+	podMetadata = actual[experimentalmetricmetadata.ResourceID("test-pod-0-uid")].Metadata
+	assert.NotContains(t, podMetadata, "k8s.pod.owner.kind")
+	assert.NotContains(t, podMetadata, "k8s.pod.owner.uid")
+}
+
+func TestPodContainerImageMetadata(t *testing.T) {
+	pod := testutils.NewPodWithContainer("0", &corev1.PodSpec{
+		Containers: []corev1.Container{
+			{Name: "pulled", Image: "docker.io/library/nginx:1.25"},
+			{Name: "pulling", Image: "redis"},
+			// The status of a container can be populated after the spec.
+			{Name: "pending", Image: "busybox"},
+		},
+	}, &corev1.PodStatus{
+		ContainerStatuses: []corev1.ContainerStatus{
+			{
+				// The status image is the one resolved by the container runtime, the spec image is reported.
+				Name:        "pulled",
+				Image:       "sha256:a8758716bb6aa4d90071160d27028fe4eaee7ce8166221a97d30440c8eac2be6",
+				ImageID:     "docker.io/library/nginx@sha256:0d17b565c37bcbd895e9d92315a05c1c3c9a29f762b011a10c54a66cd53c9b31",
+				ContainerID: "containerd://pulled-id",
+			},
+			{
+				// The image ID is not populated yet while the image is being pulled.
+				Name:        "pulling",
+				Image:       "redis",
+				ContainerID: "containerd://pulling-id",
+			},
+		},
+	})
+
+	actual := GetMetadata(pod, metadata.NewStore(), zap.NewNop())
+	assert.Equal(t, map[string]string{
+		"container.image.name": "docker.io/library/nginx",
+		"container.image.tag":  "1.25",
+		"container.image.id":   "docker.io/library/nginx@sha256:0d17b565c37bcbd895e9d92315a05c1c3c9a29f762b011a10c54a66cd53c9b31",
+	}, actual[experimentalmetricmetadata.ResourceID("pulled-id")].Metadata)
+	assert.Equal(t, map[string]string{
+		"container.image.name": "redis",
+		"container.image.tag":  "latest",
+	}, actual[experimentalmetricmetadata.ResourceID("pulling-id")].Metadata)
+	// One entity for the pod and one per container with a status.
+	assert.Len(t, actual, 3)
+}
+
 func TestTransform(t *testing.T) {
+	startTime := &v1.Time{Time: v1.Now().Add(-5 * time.Minute)}
+	scheduledTime := v1.NewTime(startTime.Add(-time.Second))
+	readyTime := v1.NewTime(startTime.Add(time.Second))
 	originalPod := &corev1.Pod{
 		ObjectMeta: v1.ObjectMeta{
 			Name:      "my-pod",
@@ -360,12 +1063,14 @@ func TestTransform(t *testing.T) {
 			},
 		},
 		Spec: corev1.PodSpec{
-			RestartPolicy: corev1.RestartPolicyAlways,
-			NodeName:      "node-1",
-			HostNetwork:   true,
-			HostIPC:       true,
-			HostPID:       true,
-			DNSPolicy:     corev1.DNSClusterFirst,
+			RestartPolicy:     corev1.RestartPolicyAlways,
+			NodeName:          "node-1",
+			HostNetwork:       true,
+			HostIPC:           true,
+			HostPID:           true,
+			DNSPolicy:         corev1.DNSClusterFirst,
+			Priority:          func() *int32 { p := int32(1000); return &p }(),
+			PriorityClassName: "high-priority",
 			TerminationGracePeriodSeconds: func() *int64 {
 				gracePeriodSeconds := int64(30)
 				return &gracePeriodSeconds
@@ -415,7 +1120,21 @@ func TestTransform(t *testing.T) {
 			Phase:     corev1.PodRunning,
 			HostIP:    "192.168.1.100",
 			PodIP:     "10.244.0.5",
-			StartTime: &v1.Time{Time: v1.Now().Add(-5 * time.Minute)},
+			StartTime: startTime,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodScheduled,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: scheduledTime,
+					Reason:             "Scheduled",
+				},
+				{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: readyTime,
+					Message:            "all containers are ready",
+				},
+			},
 			ContainerStatuses: []corev1.ContainerStatus{
 				{
 					Name:         "invalid-container",
@@ -428,6 +1147,7 @@ func TestTransform(t *testing.T) {
 					ContainerID:  "abc12345",
 					RestartCount: 2,
 					Ready:        true,
+					Started:      func() *bool { b := true; return &b }(),
 					State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: v1.Now()}},
 				},
 			},
@@ -443,10 +1163,13 @@ func TestTransform(t *testing.T) {
 			},
 		},
 		Spec: corev1.PodSpec{
-			NodeName: "node-1",
+			NodeName:          "node-1",
+			Priority:          func() *int32 { p := int32(1000); return &p }(),
+			PriorityClassName: "high-priority",
 			Containers: []corev1.Container{
 				{
-					Name: "my-container",
+					Name:  "my-container",
+					Image: "nginx:latest",
 					Resources: corev1.ResourceRequirements{
 						Requests: corev1.ResourceList{
 							corev1.ResourceCPU:    resource.MustParse("500m"),
@@ -461,7 +1184,21 @@ func TestTransform(t *testing.T) {
 			},
 		},
 		Status: corev1.PodStatus{
-			Phase: corev1.PodRunning,
+			Phase:     corev1.PodRunning,
+			StartTime: startTime,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodScheduled,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: scheduledTime,
+					Reason:             "Scheduled",
+				},
+				{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: readyTime,
+				},
+			},
 			ContainerStatuses: []corev1.ContainerStatus{
 				{
 					Name:         "my-container",
@@ -469,9 +1206,314 @@ func TestTransform(t *testing.T) {
 					ContainerID:  "abc12345",
 					RestartCount: 2,
 					Ready:        true,
+					Started:      func() *bool { b := true; return &b }(),
 				},
 			},
 		},
 	}
 	assert.Equal(t, wantPod, Transform(originalPod))
 }
+
+func TestPodSchedulingLatency(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduled := func(status corev1.ConditionStatus) []corev1.PodCondition {
+		return []corev1.PodCondition{
+			{
+				Type:               corev1.PodScheduled,
+				Status:             status,
+				LastTransitionTime: v1.NewTime(created.Add(2500 * time.Millisecond)),
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		startTime  *v1.Time
+		conditions []corev1.PodCondition
+		want       *float64
+	}{
+		{
+			name:       "scheduled_and_started",
+			startTime:  &v1.Time{Time: created.Add(3 * time.Second)},
+			conditions: scheduled(corev1.ConditionTrue),
+			want:       func() *float64 { v := 2.5; return &v }(),
+		},
+		{
+			name:       "not_started",
+			conditions: scheduled(corev1.ConditionTrue),
+		},
+		{
+			name:       "unschedulable",
+			startTime:  &v1.Time{Time: created.Add(3 * time.Second)},
+			conditions: scheduled(corev1.ConditionFalse),
+		},
+		{
+			name:      "no_conditions",
+			startTime: &v1.Time{Time: created.Add(3 * time.Second)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := testutils.NewPodWithContainer("1", &corev1.PodSpec{}, &corev1.PodStatus{})
+			pod.CreationTimestamp = v1.NewTime(created)
+			pod.Status.StartTime = tt.startTime
+			pod.Status.Conditions = tt.conditions
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sPodSchedulingLatency.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(zap.NewNop(), mb, pod, nil, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() != "k8s.pod.scheduling_latency" {
+					continue
+				}
+				found = true
+				require.NotNil(t, tt.want)
+				require.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+				assert.InDelta(t, *tt.want, ms.At(i).Gauge().DataPoints().At(0).DoubleValue(), 1e-9)
+			}
+			assert.Equal(t, tt.want != nil, found)
+		})
+	}
+}
+
+func TestPodPriority(t *testing.T) {
+	priority := int32(1000)
+	tests := []struct {
+		name              string
+		priority          *int32
+		priorityClassName string
+		wantPriority      bool
+	}{
+		{
+			name:              "priority_class",
+			priority:          &priority,
+			priorityClassName: "high-priority",
+			wantPriority:      true,
+		},
+		{
+			name:         "default_priority",
+			priority:     func() *int32 { p := int32(0); return &p }(),
+			wantPriority: true,
+		},
+		{
+			name: "not_admitted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := testutils.NewPodWithContainer("1", &corev1.PodSpec{}, &corev1.PodStatus{})
+			pod.Spec.Priority = tt.priority
+			pod.Spec.PriorityClassName = tt.priorityClassName
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sPodPriority.Enabled = true
+			mbc.ResourceAttributes.K8sPodPriorityClassName.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(zap.NewNop(), mb, Transform(pod), nil, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			rm := m.ResourceMetrics().At(0)
+			className, ok := rm.Resource().Attributes().Get("k8s.pod.priority_class_name")
+			assert.Equal(t, tt.priorityClassName != "", ok)
+			if ok {
+				assert.Equal(t, tt.priorityClassName, className.Str())
+			}
+
+			ms := rm.ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() == "k8s.pod.priority" {
+					found = true
+					testutils.AssertMetricInt(t, ms.At(i), "k8s.pod.priority", pmetric.MetricTypeGauge, int64(*tt.priority))
+				}
+			}
+			assert.Equal(t, tt.wantPriority, found)
+		})
+	}
+}
+
+func TestPodOrphaned(t *testing.T) {
+	controller := true
+	tests := []struct {
+		name        string
+		owners      []v1.OwnerReference
+		annotations map[string]string
+		want        *int64
+	}{
+		{
+			name:   "controlled",
+			owners: []v1.OwnerReference{{Kind: "ReplicaSet", Name: "rs", UID: "rs-uid", Controller: &controller}},
+			want:   func() *int64 { v := int64(0); return &v }(),
+		},
+		{
+			name:   "owner_without_controller",
+			owners: []v1.OwnerReference{{Kind: "ConfigMap", Name: "cm", UID: "cm-uid"}},
+			want:   func() *int64 { v := int64(1); return &v }(),
+		},
+		{
+			name: "no_owners",
+			want: func() *int64 { v := int64(1); return &v }(),
+		},
+		{
+			name:        "mirror_pod",
+			annotations: map[string]string{corev1.MirrorPodAnnotationKey: "mirror"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := testutils.NewPodWithContainer("1", &corev1.PodSpec{}, &corev1.PodStatus{})
+			pod.OwnerReferences = tt.owners
+			pod.Annotations = tt.annotations
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sPodOrphaned.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(zap.NewNop(), mb, Transform(pod), nil, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() == "k8s.pod.orphaned" {
+					found = true
+					require.NotNil(t, tt.want)
+					testutils.AssertMetricInt(t, ms.At(i), "k8s.pod.orphaned", pmetric.MetricTypeGauge, *tt.want)
+				}
+			}
+			assert.Equal(t, tt.want != nil, found)
+		})
+	}
+}
+
+func TestPodAge(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pod := testutils.NewPodWithContainer("1", &corev1.PodSpec{}, &corev1.PodStatus{})
+	pod.CreationTimestamp = v1.NewTime(created)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPodAge.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	// The age is computed as of the collection timestamp.
+	RecordMetrics(zap.NewNop(), mb, pod, nil, pcommon.NewTimestampFromTime(created.Add(90*time.Minute+500*time.Millisecond)))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var found bool
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == "k8s.pod.age" {
+			found = true
+			testutils.AssertMetricInt(t, ms.At(i), "k8s.pod.age", pmetric.MetricTypeGauge, 5400)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestPodConditionMetrics(t *testing.T) {
+	pod := testutils.NewPodWithContainer("1", &corev1.PodSpec{}, &corev1.PodStatus{
+		Phase: corev1.PodRunning,
+		Conditions: []corev1.PodCondition{
+			{Type: corev1.PodInitialized, Status: corev1.ConditionTrue},
+			{Type: corev1.ContainersReady, Status: corev1.ConditionFalse},
+			{Type: corev1.PodReady, Status: corev1.ConditionUnknown},
+		},
+	})
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPodCondition.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(zap.NewNop(), mb, pod, nil, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	got := map[string]int64{}
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "k8s.pod.condition" {
+			continue
+		}
+		dps := ms.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			condition, ok := dps.At(j).Attributes().Get("condition")
+			require.True(t, ok)
+			got[condition.Str()] = dps.At(j).IntValue()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"Initialized":     1,
+		"ContainersReady": 0,
+		"Ready":           -1,
+	}, got)
+}
+
+func TestPodUnschedulableMetric(t *testing.T) {
+	tests := []struct {
+		name       string
+		phase      corev1.PodPhase
+		conditions []corev1.PodCondition
+		want       string
+		found      bool
+	}{
+		{
+			name:  "unschedulable",
+			phase: corev1.PodPending,
+			conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+			},
+			want:  "Unschedulable",
+			found: true,
+		},
+		{
+			name:  "scheduled",
+			phase: corev1.PodPending,
+			conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+			},
+		},
+		{
+			name:  "pending_without_conditions",
+			phase: corev1.PodPending,
+		},
+		{
+			name:  "not_pending",
+			phase: corev1.PodFailed,
+			conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := testutils.NewPodWithContainer("1", &corev1.PodSpec{}, &corev1.PodStatus{
+				Phase:      tt.phase,
+				Conditions: tt.conditions,
+			})
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sPodUnschedulable.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(zap.NewNop(), mb, pod, nil, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() != "k8s.pod.unschedulable" {
+					continue
+				}
+				found = true
+				testutils.AssertMetricInt(t, ms.At(i), "k8s.pod.unschedulable", pmetric.MetricTypeGauge, 1)
+				reason, ok := ms.At(i).Gauge().DataPoints().At(0).Attributes().Get("reason")
+				require.True(t, ok)
+				assert.Equal(t, tt.want, reason.Str())
+			}
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package priorityclass // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/priorityclass"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pc *schedulingv1.PriorityClass, ts pcommon.Timestamp) {
+	mb.RecordK8sPriorityclassValueDataPoint(ts, int64(pc.Value))
+	var globalDefault int64
+	if pc.GlobalDefault {
+		globalDefault = 1
+	}
+	mb.RecordK8sPriorityclassGlobalDefaultDataPoint(ts, globalDefault)
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sPriorityclassUID(string(pc.UID))
+	rb.SetK8sPriorityclassName(pc.Name)
+	rb.SetK8sPriorityclassPreemptionPolicy(string(preemptionPolicy(pc)))
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// preemptionPolicy returns the preemption policy of the priority class, which the API server
+// defaults to PreemptLowerPriority if unset.
+func preemptionPolicy(pc *schedulingv1.PriorityClass) corev1.PreemptionPolicy {
+	if pc.PreemptionPolicy == nil {
+		return corev1.PreemptLowerPriority
+	}
+	return *pc.PreemptionPolicy
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package priorityclass
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func newMetricsBuilder() *metadata.MetricsBuilder {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPriorityclassValue.Enabled = true
+	mbc.Metrics.K8sPriorityclassGlobalDefault.Enabled = true
+	return metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+}
+
+func TestPriorityClassMetrics(t *testing.T) {
+	pc := testutils.NewPriorityClass("1")
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, pc, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.priorityclass.uid":               "test-priorityclass-1-uid",
+			"k8s.priorityclass.name":              "test-priorityclass-1",
+			"k8s.priorityclass.preemption_policy": "Never",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.priorityclass.global_default", pmetric.MetricTypeGauge, int64(1))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.priorityclass.value", pmetric.MetricTypeGauge, int64(1000000))
+}
+
+func TestPriorityClassDefaultPreemptionPolicy(t *testing.T) {
+	pc := testutils.NewPriorityClass("1")
+	pc.PreemptionPolicy = nil
+	pc.GlobalDefault = false
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, pc, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	policy, ok := rm.Resource().Attributes().Get("k8s.priorityclass.preemption_policy")
+	require.True(t, ok)
+	assert.Equal(t, string(corev1.PreemptLowerPriority), policy.Str())
+	sms := rm.ScopeMetrics().At(0)
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.priorityclass.global_default", pmetric.MetricTypeGauge, int64(0))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
 // Transform transforms the replica set to remove the fields that we don't use to reduce RAM utilization.
@@ -21,7 +22,8 @@ func Transform(rs *appsv1.ReplicaSet) *appsv1.ReplicaSet {
 			Replicas: rs.Spec.Replicas,
 		},
 		Status: appsv1.ReplicaSetStatus{
-			AvailableReplicas: rs.Status.AvailableReplicas,
+			AvailableReplicas:    rs.Status.AvailableReplicas,
+			FullyLabeledReplicas: rs.Status.FullyLabeledReplicas,
 		},
 	}
 }
@@ -31,11 +33,15 @@ func RecordMetrics(mb *metadata.MetricsBuilder, rs *appsv1.ReplicaSet, ts pcommo
 		mb.RecordK8sReplicasetDesiredDataPoint(ts, int64(*rs.Spec.Replicas))
 		mb.RecordK8sReplicasetAvailableDataPoint(ts, int64(rs.Status.AvailableReplicas))
 	}
+	mb.RecordK8sReplicasetFullyLabeledReplicasDataPoint(ts, int64(rs.Status.FullyLabeledReplicas))
 
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sNamespaceName(rs.Namespace)
 	rb.SetK8sReplicasetName(rs.Name)
 	rb.SetK8sReplicasetUID(string(rs.UID))
+	if deployRef := utils.FindOwnerWithKind(rs.OwnerReferences, constants.K8sKindDeployment); deployRef != nil {
+		rb.SetK8sDeploymentName(deployRef.Name)
+	}
 	mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -41,6 +42,50 @@ func TestReplicasetMetrics(t *testing.T) {
 	)
 }
 
+func TestReplicasetMetricsDeploymentOwner(t *testing.T) {
+	rs := testutils.NewReplicaSet("1")
+	rs.OwnerReferences = []metav1.OwnerReference{
+		{
+			Kind:       "Deployment",
+			Name:       "test-deployment-1",
+			UID:        "test-deployment-1-uid",
+			Controller: func() *bool { c := true; return &c }(),
+		},
+	}
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, rs, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	assert.Equal(t, map[string]any{
+		"k8s.namespace.name":  "test-namespace",
+		"k8s.replicaset.name": "test-replicaset-1",
+		"k8s.replicaset.uid":  "test-replicaset-1-uid",
+		"k8s.deployment.name": "test-deployment-1",
+	}, m.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+}
+
+func TestReplicasetFullyLabeledReplicasMetric(t *testing.T) {
+	rs := testutils.NewReplicaSet("1")
+	rs.Status.FullyLabeledReplicas = 2
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sReplicasetFullyLabeledReplicas.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, rs, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, ms.At(2), "k8s.replicaset.fully_labeled_replicas", pmetric.MetricTypeGauge, 2)
+}
+
 func TestTransform(t *testing.T) {
 	originalRS := &appsv1.ReplicaSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -100,7 +145,8 @@ func TestTransform(t *testing.T) {
 			Replicas: func() *int32 { replicas := int32(3); return &replicas }(),
 		},
 		Status: appsv1.ReplicaSetStatus{
-			AvailableReplicas: 3,
+			AvailableReplicas:    3,
+			FullyLabeledReplicas: 3,
 		},
 	}
 	assert.Equal(t, wantRS, Transform(originalRS))
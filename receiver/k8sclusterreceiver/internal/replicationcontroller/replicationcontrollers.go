@@ -4,6 +4,8 @@
 package replicationcontroller // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicationcontroller"
 
 import (
+	"strings"
+
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	corev1 "k8s.io/api/core/v1"
 
@@ -26,7 +28,7 @@ func RecordMetrics(mb *metadata.MetricsBuilder, rc *corev1.ReplicationController
 }
 
 func GetMetadata(rc *corev1.ReplicationController) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
-	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
-		experimentalmetricmetadata.ResourceID(rc.UID): metadata.GetGenericMetadata(&rc.ObjectMeta, constants.K8sKindReplicationController),
-	}
+	rm := metadata.GetGenericMetadata(&rc.ObjectMeta, constants.K8sKindReplicationController)
+	rm.Metadata[metadata.GetOTelNameFromKind(strings.ToLower(constants.K8sKindReplicationController))] = rc.Name
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(rc.UID): rm}
 }
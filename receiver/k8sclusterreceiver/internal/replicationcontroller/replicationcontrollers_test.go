@@ -8,9 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -37,3 +39,34 @@ func TestReplicationController(t *testing.T) {
 	),
 	)
 }
+
+func TestReplicationControllerMetadata(t *testing.T) {
+	rc := testutils.NewReplicationController("1")
+	rc.OwnerReferences = []metav1.OwnerReference{
+		{
+			Kind:       "DeploymentConfig",
+			Name:       "test-deploymentconfig",
+			UID:        "test-deploymentconfig-uid",
+			Controller: func() *bool { b := true; return &b }(),
+		},
+	}
+
+	md := GetMetadata(rc)
+	require.Len(t, md, 1)
+	km := md["test-replicationcontroller-1-uid"]
+	require.NotNil(t, km)
+	assert.Equal(t, "k8s.replicationcontroller", km.EntityType)
+	assert.Equal(t, "k8s.replicationcontroller.uid", km.ResourceIDKey)
+	assert.Equal(t, map[string]string{
+		"app":                            "my-app",
+		"version":                        "v1",
+		"k8s.replicationcontroller.name": "test-replicationcontroller-1",
+		"k8s.workload.kind":              "ReplicationController",
+		"k8s.workload.name":              "test-replicationcontroller-1",
+		"replicationcontroller.creation_timestamp": "0001-01-01T00:00:00Z",
+		"k8s.deploymentconfig.name":                "test-deploymentconfig",
+		"k8s.deploymentconfig.uid":                 "test-deploymentconfig-uid",
+		"k8s.replicationcontroller.owner.kind":     "DeploymentConfig",
+		"k8s.replicationcontroller.owner.uid":      "test-deploymentconfig-uid",
+	}, km.Metadata)
+}
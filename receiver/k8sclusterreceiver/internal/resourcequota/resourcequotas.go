@@ -4,14 +4,25 @@
 package resourcequota // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/resourcequota"
 
 import (
+	"sort"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
+const (
+	// Keys for resource quota metadata.
+	resourceQuotaCreationTime = "resourcequota.creation_timestamp"
+	resourceQuotaScope        = "k8s.resourcequota.scope"
+)
+
 func RecordMetrics(mb *metadata.MetricsBuilder, rq *corev1.ResourceQuota, ts pcommon.Timestamp) {
 	for k, v := range rq.Status.Hard {
 		val := v.Value()
@@ -29,9 +40,63 @@ func RecordMetrics(mb *metadata.MetricsBuilder, rq *corev1.ResourceQuota, ts pco
 		mb.RecordK8sResourceQuotaUsedDataPoint(ts, val, string(k))
 	}
 
+	for k, hard := range rq.Status.Hard {
+		used, ok := rq.Status.Used[k]
+		if !ok || hard.IsZero() {
+			continue
+		}
+		mb.RecordK8sResourceQuotaUtilizationDataPoint(ts, used.AsApproximateFloat64()/hard.AsApproximateFloat64(), string(k))
+	}
+
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sResourcequotaUID(string(rq.UID))
 	rb.SetK8sResourcequotaName(rq.Name)
 	rb.SetK8sNamespaceName(rq.Namespace)
+	if scope := GetScope(&rq.Spec); scope != "" {
+		rb.SetK8sResourcequotaScope(scope)
+	}
 	mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
+
+// GetMetadata returns the metadata of the resource quota, including its labels and scopes.
+func GetMetadata(rq *corev1.ResourceQuota) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	meta := maps.MergeStringMaps(map[string]string{}, rq.Labels)
+
+	meta[constants.K8sKeyResourceQuotaName] = rq.Name
+	meta[resourceQuotaCreationTime] = rq.GetCreationTimestamp().Format(time.RFC3339)
+	if scope := GetScope(&rq.Spec); scope != "" {
+		meta[resourceQuotaScope] = scope
+	}
+
+	rqID := experimentalmetricmetadata.ResourceID(rq.UID)
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		rqID: {
+			EntityType:    "k8s.resourcequota",
+			ResourceIDKey: constants.K8sKeyResourceQuotaUID,
+			ResourceID:    rqID,
+			Metadata:      meta,
+		},
+	}
+}
+
+// GetScope returns the sorted, comma separated scopes from both spec.scopes and spec.scopeSelector.
+func GetScope(spec *corev1.ResourceQuotaSpec) string {
+	seen := map[corev1.ResourceQuotaScope]bool{}
+	var scopes []string
+	add := func(scope corev1.ResourceQuotaScope) {
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, string(scope))
+		}
+	}
+	for _, scope := range spec.Scopes {
+		add(scope)
+	}
+	if spec.ScopeSelector != nil {
+		for _, expr := range spec.ScopeSelector.MatchExpressions {
+			add(expr.ScopeName)
+		}
+	}
+	sort.Strings(scopes)
+	return strings.Join(scopes, ",")
+}
@@ -8,10 +8,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
@@ -36,3 +41,115 @@ func TestRequestQuotaMetrics(t *testing.T) {
 	),
 	)
 }
+
+func TestRequestQuotaUtilization(t *testing.T) {
+	rq := testutils.NewResourceQuota("1")
+	// Resources with a zero limit or without usage have no utilization.
+	rq.Status.Hard["pods"] = *resource.NewQuantity(0, resource.DecimalSI)
+	rq.Status.Used["pods"] = *resource.NewQuantity(0, resource.DecimalSI)
+	rq.Status.Hard["services"] = *resource.NewQuantity(10, resource.DecimalSI)
+
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sResourceQuotaUtilization.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, rq, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var found bool
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "k8s.resource_quota.utilization" {
+			continue
+		}
+		found = true
+		require.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+		dps := ms.At(i).Gauge().DataPoints()
+		require.Equal(t, 1, dps.Len())
+		resourceAttr, ok := dps.At(0).Attributes().Get("resource")
+		require.True(t, ok)
+		assert.Equal(t, "requests.cpu", resourceAttr.Str())
+		assert.Equal(t, 0.5, dps.At(0).DoubleValue())
+	}
+	assert.True(t, found)
+}
+
+func TestRequestQuotaScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      corev1.ResourceQuotaSpec
+		wantScope string
+	}{
+		{
+			name: "no scopes",
+		},
+		{
+			name: "scopes",
+			spec: corev1.ResourceQuotaSpec{
+				Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeNotTerminating, corev1.ResourceQuotaScopeBestEffort},
+			},
+			wantScope: "BestEffort,NotTerminating",
+		},
+		{
+			name: "scopes and scope selector",
+			spec: corev1.ResourceQuotaSpec{
+				Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort},
+				ScopeSelector: &corev1.ScopeSelector{
+					MatchExpressions: []corev1.ScopedResourceSelectorRequirement{
+						{
+							ScopeName: corev1.ResourceQuotaScopePriorityClass,
+							Operator:  corev1.ScopeSelectorOpIn,
+							Values:    []string{"high"},
+						},
+						{
+							ScopeName: corev1.ResourceQuotaScopeBestEffort,
+							Operator:  corev1.ScopeSelectorOpExists,
+						},
+					},
+				},
+			},
+			wantScope: "BestEffort,PriorityClass",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rq := testutils.NewResourceQuota("1")
+			rq.Spec = tt.spec
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.ResourceAttributes.K8sResourcequotaScope.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, rq, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			scope, ok := m.ResourceMetrics().At(0).Resource().Attributes().Get("k8s.resourcequota.scope")
+			if tt.wantScope == "" {
+				// Unscoped quotas are reported as before.
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.wantScope, scope.Str())
+		})
+	}
+}
+
+func TestRequestQuotaMetadata(t *testing.T) {
+	rq := testutils.NewResourceQuota("1")
+	rq.Spec.Scopes = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeTerminating}
+
+	actual := GetMetadata(rq)
+	require.Len(t, actual, 1)
+	assert.Equal(t, &metadata.KubernetesMetadata{
+		EntityType:    "k8s.resourcequota",
+		ResourceIDKey: "k8s.resourcequota.uid",
+		ResourceID:    "test-resourcequota-1-uid",
+		Metadata: map[string]string{
+			"foo":                              "bar",
+			"foo1":                             "",
+			"k8s.resourcequota.name":           "test-resourcequota-1",
+			"k8s.resourcequota.scope":          "Terminating",
+			"resourcequota.creation_timestamp": "0001-01-01T00:00:00Z",
+		},
+	}, actual[experimentalmetricmetadata.ResourceID("test-resourcequota-1-uid")])
+}
@@ -5,30 +5,81 @@ package service // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"fmt"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
+const (
+	// Keys for service metadata.
+	serviceKeyType           = "service_type"
+	serviceKeyClusterIP      = "cluster_ip"
+	serviceKeySelectorPrefix = "selector."
+)
+
 // Transform transforms the pod to remove the fields that we don't use to reduce RAM utilization.
 // IMPORTANT: Make sure to update this function before using new service fields.
 func Transform(service *corev1.Service) *corev1.Service {
 	return &corev1.Service{
 		ObjectMeta: metadata.TransformObjectMeta(service.ObjectMeta),
 		Spec: corev1.ServiceSpec{
-			Selector: service.Spec.Selector,
+			Selector:  service.Spec.Selector,
+			Ports:     service.Spec.Ports,
+			Type:      service.Spec.Type,
+			ClusterIP: service.Spec.ClusterIP,
 		},
 	}
 }
 
+func RecordMetrics(mb *metadata.MetricsBuilder, svc *corev1.Service, ts pcommon.Timestamp) {
+	mb.RecordK8sServicePortCountDataPoint(ts, int64(len(svc.Spec.Ports)))
+	mb.RecordK8sServiceTypeDataPoint(ts, int64(typeToInt(svc.Spec.Type)))
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(svc.Namespace)
+	rb.SetK8sServiceName(svc.Name)
+	rb.SetK8sServiceUID(string(svc.UID))
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func typeToInt(serviceType corev1.ServiceType) int32 {
+	switch serviceType {
+	case corev1.ServiceTypeClusterIP:
+		return 1
+	case corev1.ServiceTypeNodePort:
+		return 2
+	case corev1.ServiceTypeLoadBalancer:
+		return 3
+	case corev1.ServiceTypeExternalName:
+		return 4
+	default:
+		// An unset type defaults to ClusterIP in the API server.
+		return 1
+	}
+}
+
+// GetMetadata returns all metadata associated with the service.
+func GetMetadata(svc *corev1.Service) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	km := metadata.GetGenericMetadata(&svc.ObjectMeta, constants.K8sKindService)
+	for k, v := range svc.Spec.Selector {
+		km.Metadata[serviceKeySelectorPrefix+k] = v
+	}
+	km.Metadata[serviceKeyType] = string(svc.Spec.Type)
+	km.Metadata[serviceKeyClusterIP] = svc.Spec.ClusterIP
+
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{experimentalmetricmetadata.ResourceID(svc.UID): km}
+}
+
 // GetPodServiceTags returns a set of services associated with the pod.
 func GetPodServiceTags(pod *corev1.Pod, services cache.Store) map[string]string {
 	properties := map[string]string{}
 
-	for _, ser := range services.List() {
+	for _, ser := range namespaceServices(pod.Namespace, services) {
 		serObj := ser.(*corev1.Service)
 		if serObj.Namespace == pod.Namespace &&
 			labels.Set(serObj.Spec.Selector).AsSelectorPreValidated().Matches(labels.Set(pod.Labels)) {
@@ -38,3 +89,15 @@ func GetPodServiceTags(pod *corev1.Pod, services cache.Store) map[string]string
 
 	return properties
 }
+
+// namespaceServices returns the services of the given namespace from the namespace index of the
+// store if it has one. Otherwise all services are returned. Only the services of the namespace
+// are copied while the store is locked, rather than the services of the whole cluster.
+func namespaceServices(namespace string, services cache.Store) []any {
+	if indexer, ok := services.(cache.Indexer); ok {
+		if objs, err := indexer.ByIndex(cache.NamespaceIndex, namespace); err == nil {
+			return objs
+		}
+	}
+	return services.List()
+}
@@ -5,10 +5,19 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
 )
 
 func TestTransform(t *testing.T) {
@@ -49,7 +58,119 @@ func TestTransform(t *testing.T) {
 			Selector: map[string]string{
 				"app": "my-app",
 			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "http",
+					Port:     80,
+					Protocol: corev1.ProtocolTCP,
+				},
+			},
+			Type: corev1.ServiceTypeClusterIP,
 		},
 	}
 	assert.EqualValues(t, wantService, Transform(originalService))
 }
+
+func TestServiceMetrics(t *testing.T) {
+	svc := testutils.NewService("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sServicePortCount.Enabled = true
+	mbc.Metrics.K8sServiceType.Enabled = true
+	mbc.ResourceAttributes.K8sServiceUID.Enabled = true
+	mbc.ResourceAttributes.K8sServiceName.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+	RecordMetrics(mb, svc, ts)
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.service.uid":    "test-service-1-uid",
+			"k8s.service.name":   "test-service-1",
+			"k8s.namespace.name": "test-namespace",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 2, sms.Metrics().Len())
+	sms.Metrics().Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.service.port.count", pmetric.MetricTypeGauge, int64(2))
+	testutils.AssertMetricInt(t, sms.Metrics().At(1), "k8s.service.type", pmetric.MetricTypeGauge, int64(2))
+}
+
+func TestGetMetadata(t *testing.T) {
+	svc := testutils.NewService("1")
+	actualMetadata := GetMetadata(svc)
+	require.Len(t, actualMetadata, 1)
+
+	km := actualMetadata["test-service-1-uid"]
+	require.NotNil(t, km)
+	assert.Equal(t, "k8s.service", km.EntityType)
+	assert.Equal(t, "k8s.service.uid", km.ResourceIDKey)
+	assert.Equal(t, map[string]string{
+		"foo":                        "bar",
+		"k8s.workload.kind":          "Service",
+		"k8s.workload.name":          "test-service-1",
+		"service.creation_timestamp": "0001-01-01T00:00:00Z",
+		"selector.app":               "my-app",
+		"service_type":               "NodePort",
+		"cluster_ip":                 "10.0.0.1",
+	}, km.Metadata)
+}
+
+func TestGetMetadataWithoutSelector(t *testing.T) {
+	svc := testutils.NewService("1")
+	svc.Spec.Selector = nil
+	svc.Spec.Type = corev1.ServiceTypeExternalName
+	svc.Spec.ClusterIP = ""
+	svc.Spec.ExternalName = "example.com"
+
+	km := GetMetadata(svc)["test-service-1-uid"]
+	require.NotNil(t, km)
+	assert.Equal(t, "ExternalName", km.Metadata[serviceKeyType])
+	assert.Equal(t, "", km.Metadata[serviceKeyClusterIP])
+	assert.NotContains(t, km.Metadata, "selector.app")
+}
+
+func TestGetPodServiceTags(t *testing.T) {
+	newService := func(name, namespace string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "my-app"}},
+		}
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pod",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "my-app"},
+		},
+	}
+	tests := []struct {
+		name  string
+		store cache.Store
+	}{
+		{
+			name:  "indexer",
+			store: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+		},
+		{
+			name:  "store",
+			store: cache.NewStore(cache.MetaNamespaceKeyFunc),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.store.Add(newService("my-service", "default")))
+			require.NoError(t, tt.store.Add(newService("other-service", "other")))
+			assert.Equal(t, map[string]string{"k8s.service.my-service": ""}, GetPodServiceTags(pod, tt.store))
+		})
+	}
+}
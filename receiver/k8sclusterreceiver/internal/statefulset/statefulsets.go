@@ -11,6 +11,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	imetadata "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
 const (
@@ -22,17 +23,24 @@ const (
 // Transform transforms the pod to remove the fields that we don't use to reduce RAM utilization.
 // IMPORTANT: Make sure to update this function before using new statefulset fields.
 func Transform(statefulset *appsv1.StatefulSet) *appsv1.StatefulSet {
-	return &appsv1.StatefulSet{
+	newStatefulSet := &appsv1.StatefulSet{
 		ObjectMeta: metadata.TransformObjectMeta(statefulset.ObjectMeta),
 		Spec: appsv1.StatefulSetSpec{
-			Replicas: statefulset.Spec.Replicas,
+			Replicas:       statefulset.Spec.Replicas,
+			UpdateStrategy: statefulset.Spec.UpdateStrategy,
 		},
 		Status: appsv1.StatefulSetStatus{
-			ReadyReplicas:   statefulset.Status.ReadyReplicas,
-			CurrentReplicas: statefulset.Status.CurrentReplicas,
-			UpdatedReplicas: statefulset.Status.UpdatedReplicas,
+			ReadyReplicas:      statefulset.Status.ReadyReplicas,
+			CurrentReplicas:    statefulset.Status.CurrentReplicas,
+			UpdatedReplicas:    statefulset.Status.UpdatedReplicas,
+			CurrentRevision:    statefulset.Status.CurrentRevision,
+			UpdateRevision:     statefulset.Status.UpdateRevision,
+			CollisionCount:     statefulset.Status.CollisionCount,
+			ObservedGeneration: statefulset.Status.ObservedGeneration,
 		},
 	}
+	newStatefulSet.Generation = statefulset.Generation
+	return newStatefulSet
 }
 
 func RecordMetrics(mb *imetadata.MetricsBuilder, ss *appsv1.StatefulSet, ts pcommon.Timestamp) {
@@ -43,6 +51,14 @@ func RecordMetrics(mb *imetadata.MetricsBuilder, ss *appsv1.StatefulSet, ts pcom
 	mb.RecordK8sStatefulsetReadyPodsDataPoint(ts, int64(ss.Status.ReadyReplicas))
 	mb.RecordK8sStatefulsetCurrentPodsDataPoint(ts, int64(ss.Status.CurrentReplicas))
 	mb.RecordK8sStatefulsetUpdatedPodsDataPoint(ts, int64(ss.Status.UpdatedReplicas))
+	mb.RecordK8sStatefulsetRevisionMismatchDataPoint(ts, revisionMismatch(ss))
+	mb.RecordK8sStatefulsetGenerationSkewDataPoint(ts, utils.GenerationSkew(ss.Generation, ss.Status.ObservedGeneration))
+	if ss.Status.CollisionCount != nil {
+		mb.RecordK8sStatefulsetCollisionCountDataPoint(ts, int64(*ss.Status.CollisionCount))
+	}
+	if partition, ok := updatePartition(ss); ok {
+		mb.RecordK8sStatefulsetUpdatePartitionDataPoint(ts, int64(partition))
+	}
 	rb := mb.NewResourceBuilder()
 	rb.SetK8sStatefulsetUID(string(ss.UID))
 	rb.SetK8sStatefulsetName(ss.Name)
@@ -50,6 +66,30 @@ func RecordMetrics(mb *imetadata.MetricsBuilder, ss *appsv1.StatefulSet, ts pcom
 	mb.EmitForResource(imetadata.WithResource(rb.Emit()))
 }
 
+// revisionMismatch returns 1 if the stateful set is being rolled out to a new revision.
+// Revisions are not set yet for newly created stateful sets, they are not considered mismatched.
+func revisionMismatch(ss *appsv1.StatefulSet) int64 {
+	if ss.Status.CurrentRevision == "" || ss.Status.UpdateRevision == "" {
+		return 0
+	}
+	if ss.Status.CurrentRevision != ss.Status.UpdateRevision {
+		return 1
+	}
+	return 0
+}
+
+// updatePartition returns the rolling update partition of the stateful set, if any.
+func updatePartition(ss *appsv1.StatefulSet) (int32, bool) {
+	if ss.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		return 0, false
+	}
+	ru := ss.Spec.UpdateStrategy.RollingUpdate
+	if ru == nil || ru.Partition == nil {
+		return 0, false
+	}
+	return *ru.Partition, true
+}
+
 func GetMetadata(ss *appsv1.StatefulSet) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
 	km := metadata.GetGenericMetadata(&ss.ObjectMeta, constants.K8sStatefulSet)
 	km.Metadata[statefulSetCurrentVersion] = ss.Status.CurrentRevision
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -51,6 +52,207 @@ func TestStatefulsetMetrics(t *testing.T) {
 	assert.Equal(t, "k8s.statefulset.updated_pods", m4.Name())
 }
 
+func TestRevisionMismatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		currentRevision string
+		updateRevision  string
+		want            int64
+	}{
+		{
+			name:            "same revision",
+			currentRevision: "rev-1",
+			updateRevision:  "rev-1",
+			want:            0,
+		},
+		{
+			name:            "rollout in progress",
+			currentRevision: "rev-1",
+			updateRevision:  "rev-2",
+			want:            1,
+		},
+		{
+			name: "new statefulset",
+			want: 0,
+		},
+		{
+			name:           "current revision not set",
+			updateRevision: "rev-1",
+			want:           0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := testutils.NewStatefulset("1")
+			ss.Status.CurrentRevision = tt.currentRevision
+			ss.Status.UpdateRevision = tt.updateRevision
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sStatefulsetRevisionMismatch.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, ss, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			require.Equal(t, 5, m.MetricCount())
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() == "k8s.statefulset.revision_mismatch" {
+					found = true
+					testutils.AssertMetricInt(t, ms.At(i), "k8s.statefulset.revision_mismatch", pmetric.MetricTypeGauge, tt.want)
+				}
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestCollisionCount(t *testing.T) {
+	tests := []struct {
+		name           string
+		collisionCount *int32
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name:           "no collisions",
+			collisionCount: func() *int32 { i := int32(0); return &i }(),
+		},
+		{
+			name:           "collisions",
+			collisionCount: func() *int32 { i := int32(2); return &i }(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := testutils.NewStatefulset("1")
+			ss.Status.CollisionCount = tt.collisionCount
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sStatefulsetCollisionCount.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, ss, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() == "k8s.statefulset.collision_count" {
+					found = true
+					testutils.AssertMetricInt(t, ms.At(i), "k8s.statefulset.collision_count", pmetric.MetricTypeGauge, *tt.collisionCount)
+				}
+			}
+			assert.Equal(t, tt.collisionCount != nil, found)
+		})
+	}
+}
+
+func TestGenerationSkew(t *testing.T) {
+	tests := []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		want               int64
+	}{
+		{
+			name:               "observed",
+			generation:         2,
+			observedGeneration: 2,
+			want:               0,
+		},
+		{
+			name:               "not observed",
+			generation:         3,
+			observedGeneration: 2,
+			want:               1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := testutils.NewStatefulset("1")
+			ss.Generation = tt.generation
+			ss.Status.ObservedGeneration = tt.observedGeneration
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sStatefulsetGenerationSkew.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, ss, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() == "k8s.statefulset.generation_skew" {
+					found = true
+					testutils.AssertMetricInt(t, ms.At(i), "k8s.statefulset.generation_skew", pmetric.MetricTypeGauge, tt.want)
+				}
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestUpdatePartition(t *testing.T) {
+	partition := int32(3)
+	tests := []struct {
+		name           string
+		updateStrategy appsv1.StatefulSetUpdateStrategy
+		want           *int32
+	}{
+		{
+			name: "rolling update with partition",
+			updateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+			want: &partition,
+		},
+		{
+			name: "rolling update without partition",
+			updateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{},
+			},
+		},
+		{
+			name: "rolling update without parameters",
+			updateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type: appsv1.RollingUpdateStatefulSetStrategyType,
+			},
+		},
+		{
+			name: "on delete",
+			updateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.OnDeleteStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := testutils.NewStatefulset("1")
+			ss.Spec.UpdateStrategy = tt.updateStrategy
+
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			mbc.Metrics.K8sStatefulsetUpdatePartition.Enabled = true
+			mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+			RecordMetrics(mb, ss, pcommon.Timestamp(time.Now().UnixNano()))
+			m := mb.Emit()
+
+			ms := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			var found bool
+			for i := 0; i < ms.Len(); i++ {
+				if ms.At(i).Name() == "k8s.statefulset.update_partition" {
+					found = true
+					testutils.AssertMetricInt(t, ms.At(i), "k8s.statefulset.update_partition", pmetric.MetricTypeGauge, *tt.want)
+				}
+			}
+			assert.Equal(t, tt.want != nil, found)
+		})
+	}
+}
+
 func TestStatefulsetMetadata(t *testing.T) {
 	ss := testutils.NewStatefulset("1")
 
@@ -80,14 +282,21 @@ func TestStatefulsetMetadata(t *testing.T) {
 func TestTransform(t *testing.T) {
 	orig := &appsv1.StatefulSet{
 		ObjectMeta: v1.ObjectMeta{
-			Name:      "my-statefulset",
-			Namespace: "default",
+			Name:       "my-statefulset",
+			Namespace:  "default",
+			Generation: 2,
 			Labels: map[string]string{
 				"app": "my-app",
 			},
 		},
 		Spec: appsv1.StatefulSetSpec{
 			Replicas: func() *int32 { i := int32(3); return &i }(),
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type: appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+					Partition: func() *int32 { i := int32(2); return &i }(),
+				},
+			},
 			Selector: &v1.LabelSelector{
 				MatchLabels: map[string]string{
 					"app": "my-app",
@@ -118,10 +327,13 @@ func TestTransform(t *testing.T) {
 			},
 		},
 		Status: appsv1.StatefulSetStatus{
-			Replicas:        3,
-			ReadyReplicas:   3,
-			CurrentReplicas: 3,
-			UpdatedReplicas: 3,
+			Replicas:           3,
+			ReadyReplicas:      3,
+			CurrentReplicas:    3,
+			UpdatedReplicas:    3,
+			CurrentRevision:    "my-statefulset-1",
+			UpdateRevision:     "my-statefulset-2",
+			ObservedGeneration: 1,
 			Conditions: []appsv1.StatefulSetCondition{
 				{
 					Type:   "Ready",
@@ -132,19 +344,29 @@ func TestTransform(t *testing.T) {
 	}
 	want := &appsv1.StatefulSet{
 		ObjectMeta: v1.ObjectMeta{
-			Name:      "my-statefulset",
-			Namespace: "default",
+			Name:       "my-statefulset",
+			Namespace:  "default",
+			Generation: 2,
 			Labels: map[string]string{
 				"app": "my-app",
 			},
 		},
 		Spec: appsv1.StatefulSetSpec{
 			Replicas: func() *int32 { i := int32(3); return &i }(),
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type: appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+					Partition: func() *int32 { i := int32(2); return &i }(),
+				},
+			},
 		},
 		Status: appsv1.StatefulSetStatus{
-			ReadyReplicas:   3,
-			CurrentReplicas: 3,
-			UpdatedReplicas: 3,
+			ReadyReplicas:      3,
+			CurrentReplicas:    3,
+			UpdatedReplicas:    3,
+			CurrentRevision:    "my-statefulset-1",
+			UpdateRevision:     "my-statefulset-2",
+			ObservedGeneration: 1,
 		},
 	}
 	assert.Equal(t, want, Transform(orig))
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storageclass // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/storageclass"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+const (
+	// Annotations marking the default storage class of the cluster.
+	isDefaultClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaIsDefaultClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+func RecordMetrics(mb *metadata.MetricsBuilder, sc *storagev1.StorageClass, ts pcommon.Timestamp) {
+	mb.RecordK8sStorageclassInfoDataPoint(ts, 1)
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sStorageclassUID(string(sc.UID))
+	rb.SetK8sStorageclassName(sc.Name)
+	rb.SetK8sStorageclassProvisioner(sc.Provisioner)
+	if sc.ReclaimPolicy != nil {
+		rb.SetK8sStorageclassReclaimPolicy(string(*sc.ReclaimPolicy))
+	}
+	if sc.VolumeBindingMode != nil {
+		rb.SetK8sStorageclassVolumeBindingMode(string(*sc.VolumeBindingMode))
+	}
+	rb.SetK8sStorageclassIsDefault(isDefault(sc))
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// isDefault returns whether the storage class is annotated as the default class of the cluster.
+func isDefault(sc *storagev1.StorageClass) bool {
+	return sc.Annotations[isDefaultClassAnnotation] == "true" || sc.Annotations[betaIsDefaultClassAnnotation] == "true"
+}
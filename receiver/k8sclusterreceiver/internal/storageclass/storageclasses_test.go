@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storageclass
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func newMetricsBuilder() *metadata.MetricsBuilder {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sStorageclassInfo.Enabled = true
+	return metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+}
+
+func TestStorageClassMetrics(t *testing.T) {
+	sc := testutils.NewStorageClass("1")
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, sc, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.storageclass.uid":                 "test-storageclass-1-uid",
+			"k8s.storageclass.name":                "test-storageclass-1",
+			"k8s.storageclass.provisioner":         "ebs.csi.aws.com",
+			"k8s.storageclass.reclaim_policy":      "Retain",
+			"k8s.storageclass.volume_binding_mode": "WaitForFirstConsumer",
+			"k8s.storageclass.is_default":          true,
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sms := rm.ScopeMetrics().At(0)
+	require.Equal(t, 1, sms.Metrics().Len())
+	testutils.AssertMetricInt(t, sms.Metrics().At(0), "k8s.storageclass.info", pmetric.MetricTypeGauge, int64(1))
+}
+
+func TestIsDefault(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name:        "default",
+			annotations: map[string]string{isDefaultClassAnnotation: "true"},
+			want:        true,
+		},
+		{
+			name:        "beta_default",
+			annotations: map[string]string{betaIsDefaultClassAnnotation: "true"},
+			want:        true,
+		},
+		{
+			name:        "not_default",
+			annotations: map[string]string{isDefaultClassAnnotation: "false"},
+		},
+		{
+			name: "no_annotation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := testutils.NewStorageClass("1")
+			sc.Annotations = tt.annotations
+			assert.Equal(t, tt.want, isDefault(sc))
+		})
+	}
+}
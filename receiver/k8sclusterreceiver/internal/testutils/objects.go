@@ -7,10 +7,18 @@ import (
 	quotav1 "github.com/openshift/api/quota/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -33,6 +41,41 @@ func NewHPA(id string) *autoscalingv2.HorizontalPodAutoscaler {
 	}
 }
 
+func NewHPABeta(id string) *autoscalingv2beta2.HorizontalPodAutoscaler {
+	minReplicas := int32(2)
+	return &autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-hpa-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-hpa-" + id + "-uid"),
+		},
+		Status: autoscalingv2beta2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 5,
+			DesiredReplicas: 7,
+		},
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			MinReplicas: &minReplicas,
+			MaxReplicas: 10,
+		},
+	}
+}
+
+func NewPodDisruptionBudget(id string) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-pdb-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-pdb-" + id + "-uid"),
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			CurrentHealthy:     3,
+			DesiredHealthy:     3,
+			ExpectedPods:       4,
+			DisruptionsAllowed: 0,
+		},
+	}
+}
+
 func NewJob(id string) *batchv1.Job {
 	p := int32(2)
 	c := int32(10)
@@ -355,6 +398,40 @@ func NewResourceQuota(id string) *corev1.ResourceQuota {
 	}
 }
 
+func NewLimitRange(id string) *corev1.LimitRange {
+	return &corev1.LimitRange{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-limitrange-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-limitrange-" + id + "-uid"),
+		},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{
+				{
+					Type: corev1.LimitTypeContainer,
+					Max: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					DefaultRequest: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("64Mi"),
+					},
+				},
+				{
+					Type: corev1.LimitTypePersistentVolumeClaim,
+					Max: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("10Gi"),
+					},
+					Min: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		},
+	}
+}
+
 func NewStatefulset(id string) *appsv1.StatefulSet {
 	desired := int32(10)
 	return &appsv1.StatefulSet{
@@ -400,3 +477,241 @@ func NewCronJob(id string) *batchv1.CronJob {
 		},
 	}
 }
+
+func NewCronJobBeta(id string) *batchv1beta1.CronJob {
+	return &batchv1beta1.CronJob{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-cronjob-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-cronjob-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo":  "bar",
+				"foo1": "",
+			},
+		},
+		Spec: batchv1beta1.CronJobSpec{
+			Schedule:          "schedule",
+			ConcurrencyPolicy: "concurrency_policy",
+		},
+		Status: batchv1beta1.CronJobStatus{
+			Active: []corev1.ObjectReference{{}, {}},
+		},
+	}
+}
+
+func NewPersistentVolume(id string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-persistentvolume-" + id,
+			UID:  types.UID("test-persistentvolume-" + id + "-uid"),
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: *resource.NewQuantity(1024, resource.BinarySI),
+			},
+			StorageClassName: "standard",
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: corev1.VolumeBound,
+		},
+	}
+}
+
+func NewPersistentVolumeClaim(id string) *corev1.PersistentVolumeClaim {
+	storageClassName := "standard"
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-persistentvolumeclaim-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-persistentvolumeclaim-" + id + "-uid"),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *resource.NewQuantity(1024, resource.BinarySI),
+				},
+			},
+			VolumeName:       "test-persistentvolume-" + id,
+			StorageClassName: &storageClassName,
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase: corev1.ClaimBound,
+		},
+	}
+}
+
+func NewService(id string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-service-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-service-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"app": "my-app",
+			},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80},
+				{Name: "https", Port: 443},
+			},
+			Type:      corev1.ServiceTypeNodePort,
+			ClusterIP: "10.0.0.1",
+		},
+	}
+}
+
+func NewEndpointSlice(id string) *discoveryv1.EndpointSlice {
+	ready := true
+	notReady := false
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-endpointslice-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-endpointslice-" + id + "-uid"),
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: "test-service-" + id,
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+			},
+			{
+				Addresses:  []string{"10.0.0.2", "10.0.0.3"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &notReady},
+			},
+		},
+	}
+}
+
+func NewEndpoints(id string) *corev1.Endpoints {
+	return &corev1.Endpoints{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-service-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-service-" + id + "-uid"),
+		},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses:         []corev1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+				NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.3"}},
+			},
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.1.1"}},
+			},
+		},
+	}
+}
+
+func NewPriorityClass(id string) *schedulingv1.PriorityClass {
+	preemptionPolicy := corev1.PreemptNever
+	return &schedulingv1.PriorityClass{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-priorityclass-" + id,
+			UID:  types.UID("test-priorityclass-" + id + "-uid"),
+		},
+		Value:            1000000,
+		GlobalDefault:    true,
+		PreemptionPolicy: &preemptionPolicy,
+	}
+}
+
+func NewStorageClass(id string) *storagev1.StorageClass {
+	reclaimPolicy := corev1.PersistentVolumeReclaimRetain
+	bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+	return &storagev1.StorageClass{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-storageclass-" + id,
+			UID:  types.UID("test-storageclass-" + id + "-uid"),
+			Annotations: map[string]string{
+				"storageclass.kubernetes.io/is-default-class": "true",
+			},
+		},
+		Provisioner:       "ebs.csi.aws.com",
+		ReclaimPolicy:     &reclaimPolicy,
+		VolumeBindingMode: &bindingMode,
+	}
+}
+
+func NewIngress(id string) *networkingv1.Ingress {
+	className := "nginx"
+	return &networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-ingress-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-ingress-" + id + "-uid"),
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: &className,
+			Rules: []networkingv1.IngressRule{
+				{Host: "b.example.com"},
+				{Host: "a.example.com"},
+			},
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"a.example.com"}, SecretName: "test-tls-" + id},
+			},
+		},
+	}
+}
+
+func NewVPA(id string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "autoscaling.k8s.io/v1",
+		"kind":       "VerticalPodAutoscaler",
+		"metadata": map[string]any{
+			"name":      "test-vpa-" + id,
+			"namespace": "test-namespace",
+			"uid":       "test-vpa-" + id + "-uid",
+			"labels":    map[string]any{"app": "my-app"},
+		},
+		"spec": map[string]any{
+			"targetRef": map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"name":       "test-deployment-" + id,
+			},
+			"updatePolicy": map[string]any{"updateMode": "Off"},
+		},
+		"status": map[string]any{
+			"recommendation": map[string]any{
+				"containerRecommendations": []any{
+					map[string]any{
+						"containerName": "container-name",
+						"lowerBound":    map[string]any{"cpu": "10m", "memory": "64Mi"},
+						"target":        map[string]any{"cpu": "250m", "memory": "256Mi"},
+						"upperBound":    map[string]any{"cpu": "1", "memory": "1Gi"},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func NewDeploymentConfig(id string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps.openshift.io/v1",
+		"kind":       "DeploymentConfig",
+		"metadata": map[string]any{
+			"name":      "test-deploymentconfig-" + id,
+			"namespace": "test-namespace",
+			"uid":       "test-deploymentconfig-" + id + "-uid",
+			"labels":    map[string]any{"app": "my-app"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"selector": map[string]any{"app": "my-app"},
+		},
+		"status": map[string]any{
+			"replicas":          int64(3),
+			"availableReplicas": int64(2),
+			"updatedReplicas":   int64(3),
+			"readyReplicas":     int64(2),
+		},
+	}}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -47,3 +48,27 @@ var re = regexp.MustCompile(`^[\w_-]+://`)
 func StripContainerID(id string) string {
 	return re.ReplaceAllString(id, "")
 }
+
+// GenerationSkew returns 1 if the controller hasn't observed the latest generation
+// of an object yet, i.e. its spec changes are not reflected in its status.
+func GenerationSkew(generation, observedGeneration int64) int64 {
+	if generation != observedGeneration {
+		return 1
+	}
+	return 0
+}
+
+var conditionValues = map[corev1.ConditionStatus]int64{
+	corev1.ConditionTrue:    1,
+	corev1.ConditionFalse:   0,
+	corev1.ConditionUnknown: -1,
+}
+
+// ConditionStatusValue returns the value reported for a condition status: 1 for True, 0 for
+// False and -1 for Unknown. Any other status is reported as Unknown.
+func ConditionStatusValue(status corev1.ConditionStatus) int64 {
+	if v, ok := conditionValues[status]; ok {
+		return v
+	}
+	return conditionValues[corev1.ConditionUnknown]
+}
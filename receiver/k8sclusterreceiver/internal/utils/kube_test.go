@@ -46,3 +46,16 @@ func TestGetIDForCache(t *testing.T) {
 
 	require.Equal(t, ns+"/"+resName, actual)
 }
+
+func TestGenerationSkew(t *testing.T) {
+	require.EqualValues(t, 0, GenerationSkew(3, 3))
+	require.EqualValues(t, 1, GenerationSkew(4, 3))
+	require.EqualValues(t, 1, GenerationSkew(1, 0))
+}
+
+func TestConditionStatusValue(t *testing.T) {
+	require.EqualValues(t, 1, ConditionStatusValue(corev1.ConditionTrue))
+	require.EqualValues(t, 0, ConditionStatusValue(corev1.ConditionFalse))
+	require.EqualValues(t, -1, ConditionStatusValue(corev1.ConditionUnknown))
+	require.EqualValues(t, -1, ConditionStatusValue("Other"))
+}
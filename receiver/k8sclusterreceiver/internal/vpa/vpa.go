@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vpa // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/vpa"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// Transform transforms the vertical pod autoscaler to remove the fields that we don't use to reduce RAM utilization.
+// IMPORTANT: Make sure to update this function before using new vertical pod autoscaler fields.
+func Transform(vpa *unstructured.Unstructured) *unstructured.Unstructured {
+	newVPA := &unstructured.Unstructured{}
+	newVPA.SetAPIVersion(vpa.GetAPIVersion())
+	newVPA.SetKind(vpa.GetKind())
+	newVPA.SetNamespace(vpa.GetNamespace())
+	newVPA.SetName(vpa.GetName())
+	newVPA.SetUID(vpa.GetUID())
+	for _, field := range []string{"kind", "name"} {
+		if v, ok, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", field); ok {
+			_ = unstructured.SetNestedField(newVPA.Object, v, "spec", "targetRef", field)
+		}
+	}
+	var recommendations []any
+	for _, r := range containerRecommendations(vpa) {
+		recommendation := map[string]any{"containerName": r["containerName"]}
+		if target, ok := r["target"]; ok {
+			recommendation["target"] = target
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	if recommendations != nil {
+		_ = unstructured.SetNestedSlice(newVPA.Object, recommendations, "status", "recommendation", "containerRecommendations")
+	}
+	return newVPA
+}
+
+// RecordMetrics records the target recommendations of the vertical pod autoscaler per container.
+// Vertical pod autoscalers that don't provide a recommendation yet are skipped.
+func RecordMetrics(mb *metadata.MetricsBuilder, vpa *unstructured.Unstructured, ts pcommon.Timestamp) {
+	recommendations := containerRecommendations(vpa)
+	if len(recommendations) == 0 {
+		return
+	}
+	for _, r := range recommendations {
+		containerName, _, _ := unstructured.NestedString(r, "containerName")
+		target, _, _ := unstructured.NestedStringMap(r, "target")
+		if q, ok := parseQuantity(target[string(corev1.ResourceCPU)]); ok {
+			mb.RecordK8sVpaTargetCPUDataPoint(ts, float64(q.MilliValue())/1000.0, containerName)
+		}
+		if q, ok := parseQuantity(target[string(corev1.ResourceMemory)]); ok {
+			mb.RecordK8sVpaTargetMemoryDataPoint(ts, q.Value(), containerName)
+		}
+	}
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sVpaUID(string(vpa.GetUID()))
+	rb.SetK8sVpaName(vpa.GetName())
+	rb.SetK8sNamespaceName(vpa.GetNamespace())
+	if kind, ok, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "kind"); ok {
+		rb.SetK8sVpaTargetKind(kind)
+	}
+	if name, ok, _ := unstructured.NestedString(vpa.Object, "spec", "targetRef", "name"); ok {
+		rb.SetK8sVpaTargetName(name)
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// containerRecommendations returns the container recommendations from the status of the vertical pod autoscaler.
+func containerRecommendations(vpa *unstructured.Unstructured) []map[string]any {
+	items, _, _ := unstructured.NestedSlice(vpa.Object, "status", "recommendation", "containerRecommendations")
+	var out []map[string]any
+	for _, item := range items {
+		if r, ok := item.(map[string]any); ok {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func parseQuantity(s string) (resource.Quantity, bool) {
+	if s == "" {
+		return resource.Quantity{}, false
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+	return q, true
+}
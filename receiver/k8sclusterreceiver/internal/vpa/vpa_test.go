@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vpa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func newMetricsBuilder() *metadata.MetricsBuilder {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sVpaTargetCPU.Enabled = true
+	mbc.Metrics.K8sVpaTargetMemory.Enabled = true
+	return metadata.NewMetricsBuilder(mbc, receivertest.NewNopCreateSettings())
+}
+
+func TestVPAMetrics(t *testing.T) {
+	vpa := testutils.NewVPA("1")
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, vpa, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.ResourceMetrics().Len())
+	rm := m.ResourceMetrics().At(0)
+	assert.Equal(t,
+		map[string]any{
+			"k8s.vpa.uid":         "test-vpa-1-uid",
+			"k8s.vpa.name":        "test-vpa-1",
+			"k8s.vpa.target.kind": "Deployment",
+			"k8s.vpa.target.name": "test-deployment-1",
+			"k8s.namespace.name":  "test-namespace",
+		},
+		rm.Resource().Attributes().AsRaw(),
+	)
+
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	ms.Sort(func(a, b pmetric.Metric) bool {
+		return a.Name() < b.Name()
+	})
+	assert.Equal(t, "k8s.vpa.target_cpu", ms.At(0).Name())
+	cpu := ms.At(0).Gauge().DataPoints().At(0)
+	assert.InDelta(t, 0.25, cpu.DoubleValue(), 1e-9)
+	assert.Equal(t, map[string]any{"vpa.container.name": "container-name"}, cpu.Attributes().AsRaw())
+	testutils.AssertMetricInt(t, ms.At(1), "k8s.vpa.target_memory", pmetric.MetricTypeGauge, 256*1024*1024)
+	assert.Equal(t, map[string]any{"vpa.container.name": "container-name"},
+		ms.At(1).Gauge().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestVPAWithoutRecommendation(t *testing.T) {
+	vpa := testutils.NewVPA("1")
+	unstructured.RemoveNestedField(vpa.Object, "status")
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, vpa, pcommon.Timestamp(time.Now().UnixNano()))
+	assert.Equal(t, 0, mb.Emit().ResourceMetrics().Len())
+}
+
+func TestVPAPartialTarget(t *testing.T) {
+	vpa := testutils.NewVPA("1")
+	require.NoError(t, unstructured.SetNestedSlice(vpa.Object, []any{
+		map[string]any{
+			"containerName": "cpu-only",
+			"target":        map[string]any{"cpu": "2"},
+		},
+	}, "status", "recommendation", "containerRecommendations"))
+
+	mb := newMetricsBuilder()
+	RecordMetrics(mb, vpa, pcommon.Timestamp(time.Now().UnixNano()))
+	m := mb.Emit()
+
+	require.Equal(t, 1, m.MetricCount())
+	metric := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "k8s.vpa.target_cpu", metric.Name())
+	assert.InDelta(t, 2.0, metric.Gauge().DataPoints().At(0).DoubleValue(), 1e-9)
+}
+
+func TestTransform(t *testing.T) {
+	want := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "autoscaling.k8s.io/v1",
+		"kind":       "VerticalPodAutoscaler",
+		"metadata": map[string]any{
+			"name":      "test-vpa-1",
+			"namespace": "test-namespace",
+			"uid":       "test-vpa-1-uid",
+		},
+		"spec": map[string]any{
+			"targetRef": map[string]any{
+				"kind": "Deployment",
+				"name": "test-deployment-1",
+			},
+		},
+		"status": map[string]any{
+			"recommendation": map[string]any{
+				"containerRecommendations": []any{
+					map[string]any{
+						"containerName": "container-name",
+						"target":        map[string]any{"cpu": "250m", "memory": "256Mi"},
+					},
+				},
+			},
+		},
+	}}
+	assert.Equal(t, want, Transform(testutils.NewVPA("1")))
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
+
+import (
+	quotav1 "github.com/openshift/api/quota/v1"
+	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
+	quotainformersv1 "github.com/openshift/client-go/quota/informers/externalversions"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/clusterresourcequota"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deploymentconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// openShiftClients holds the clients of the OpenShift APIs, which are only created for the
+// openshift distribution.
+type openShiftClients struct {
+	osQuotaClient quotaclientset.Interface
+
+	// For mocking.
+	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
+}
+
+// registerOpenShiftKinds registers the kinds served by the OpenShift APIs with dc and watches them
+// with rw when they are served.
+func (rw *resourceWatcher) registerOpenShiftKinds(dc *collection.DataCollector) {
+	dc.RegisterKind(gvk.ClusterResourceQuota, collection.Kind{
+		Informer: func(collection.InformerFactories) cache.SharedIndexInformer {
+			var opts []quotainformersv1.SharedInformerOption
+			if tweak := rw.labelSelectorTweak(gvk.ClusterResourceQuota.Kind); tweak != nil {
+				opts = append(opts, quotainformersv1.WithTweakListOptions(tweak))
+			}
+			quotaFactory := quotainformersv1.NewSharedInformerFactoryWithOptions(rw.osQuotaClient, 0, opts...)
+			rw.informerFactories = append(rw.informerFactories, quotaFactory)
+			return quotaFactory.Quota().V1().ClusterResourceQuotas().Informer()
+		},
+		Metadata: func(o any, _ *metadata.Store, _ *zap.Logger) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+			return clusterresourcequota.GetMetadata(o.(*quotav1.ClusterResourceQuota))
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			clusterresourcequota.RecordMetrics(mb, o.(*quotav1.ClusterResourceQuota), ts)
+		},
+	})
+	dc.RegisterKind(gvk.DeploymentConfig, collection.Kind{
+		Informer: func(f collection.InformerFactories) cache.SharedIndexInformer {
+			return f.Dynamic.ForResource(f.Resource).Informer()
+		},
+		Record: func(mb *metadata.MetricsBuilder, _ pmetric.ResourceMetricsSlice, o any, ts pcommon.Timestamp) {
+			deploymentconfig.RecordMetrics(mb, o.(*unstructured.Unstructured), ts)
+		},
+	})
+	rw.extensionKinds = append(rw.extensionKinds,
+		extensionKind{kind: gvk.ClusterResourceQuota},
+		extensionKind{kind: gvk.DeploymentConfig, enabled: rw.deploymentConfigMetricsEnabled, dynamic: true},
+	)
+}
+
+// deploymentConfigMetricsEnabled returns whether any of the deployment config metrics is enabled.
+func (rw *resourceWatcher) deploymentConfigMetricsEnabled() bool {
+	metrics := rw.config.MetricsBuilderConfig.Metrics
+	return metrics.OpenshiftDeploymentconfigDesired.Enabled || metrics.OpenshiftDeploymentconfigAvailable.Enabled ||
+		metrics.OpenshiftDeploymentconfigUpdated.Enabled || metrics.OpenshiftDeploymentconfigReady.Enabled
+}
@@ -6,12 +6,17 @@ package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
@@ -25,62 +30,120 @@ const (
 
 var _ receiver.Metrics = (*kubernetesReceiver)(nil)
 
-type kubernetesReceiver struct {
+// cluster holds the data collector and resource watcher of one of the watched clusters.
+type cluster struct {
+	// name is reported as the k8s.cluster.name resource attribute, unless empty.
+	name            string
 	dataCollector   *collection.DataCollector
 	resourceWatcher *resourceWatcher
+}
+
+type kubernetesReceiver struct {
+	// clusters holds the watched clusters, a single one named after cluster_name unless clusters
+	// are configured.
+	clusters []*cluster
 
 	config          *Config
 	settings        receiver.CreateSettings
 	metricsConsumer consumer.Metrics
 	cancel          context.CancelFunc
 	obsrecv         *receiverhelper.ObsReport
+	telemetry       []metric.Registration
 }
 
 func (kr *kubernetesReceiver) Start(ctx context.Context, host component.Host) error {
 	ctx, kr.cancel = context.WithCancel(ctx)
 
-	if err := kr.resourceWatcher.initialize(); err != nil {
-		return err
-	}
-
 	exporters := host.GetExporters() //nolint:staticcheck
-	if err := kr.resourceWatcher.setupMetadataExporters(
-		exporters[component.DataTypeMetrics], kr.config.MetadataExporters); err != nil {
-		return err
+	for _, c := range kr.clusters {
+		if err := c.resourceWatcher.initialize(); err != nil {
+			return err
+		}
+
+		telemetry, err := c.resourceWatcher.registerTelemetry(metadata.Meter(kr.settings.TelemetrySettings), c.name)
+		if err != nil {
+			return err
+		}
+		kr.telemetry = append(kr.telemetry, telemetry)
+
+		if err := c.resourceWatcher.setupMetadataExporters(
+			exporters[component.DataTypeMetrics], kr.config.MetadataExporters); err != nil {
+			return err
+		}
 	}
 
 	go func() {
 		kr.settings.Logger.Info("Starting shared informers and wait for initial cache sync.")
-		for _, informer := range kr.resourceWatcher.informerFactories {
-			if informer == nil {
+		// The clusters are synced concurrently, so that the initial sync timeout applies to all of
+		// them at once. Each cluster gets its own context, so that the informers of a cluster that
+		// fails to sync can be stopped.
+		errs := make([]error, len(kr.clusters))
+		cancels := make([]context.CancelFunc, len(kr.clusters))
+		var wg sync.WaitGroup
+		for i, c := range kr.clusters {
+			var clusterCtx context.Context
+			clusterCtx, cancels[i] = context.WithCancel(ctx)
+			wg.Add(1)
+			go func(i int, c *cluster) {
+				defer wg.Done()
+				errs[i] = c.resourceWatcher.startWatchingResources(clusterCtx)
+			}(i, c)
+		}
+		wg.Wait()
+		if ctx.Err() != nil {
+			return
+		}
+
+		// A cluster whose initial sync fails, because it times out after initial_sync_timeout,
+		// 10 minutes by default, or a kind is not allowed to be listed or watched, is not collected
+		// rather than collecting data of a partial cluster. The other clusters keep being collected.
+		var synced []*cluster
+		var failures []error
+		for i, c := range kr.clusters {
+			if errs[i] == nil {
+				c.resourceWatcher.initialSyncDone.Store(true)
+				synced = append(synced, c)
 				continue
 			}
-			timedContextForInitialSync := kr.resourceWatcher.startWatchingResources(ctx, informer)
-
-			// Wait till either the initial cache sync times out or until the cancel method
-			// corresponding to this context is called.
-			<-timedContextForInitialSync.Done()
-
-			// If the context times out, set initialSyncTimedOut and report a fatal error. Currently
-			// this timeout is 10 minutes, which appears to be long enough.
-			if errors.Is(timedContextForInitialSync.Err(), context.DeadlineExceeded) {
-				kr.resourceWatcher.initialSyncTimedOut.Store(true)
-				kr.settings.Logger.Error("Timed out waiting for initial cache sync.")
-				kr.settings.TelemetrySettings.ReportStatus(component.NewFatalErrorEvent(errors.New("failed to start receiver")))
-				return
+			cancels[i]()
+			c.resourceWatcher.initialSyncTimedOut.Store(true)
+			kr.settings.Logger.Error("Initial cache sync failed, the cluster is not collected.",
+				zap.String("cluster", c.name), zap.Error(errs[i]))
+			if c.name != "" {
+				errs[i] = fmt.Errorf("cluster %q: %w", c.name, errs[i])
 			}
+			failures = append(failures, errs[i])
+		}
+		if len(synced) == 0 {
+			kr.settings.TelemetrySettings.ReportStatus(component.NewFatalErrorEvent(
+				fmt.Errorf("failed to start receiver: %w", errors.Join(failures...))))
+			return
+		}
+		if len(failures) > 0 {
+			kr.settings.TelemetrySettings.ReportStatus(component.NewRecoverableErrorEvent(errors.Join(failures...)))
 		}
-
 		kr.settings.Logger.Info("Completed syncing shared informer caches.")
-		kr.resourceWatcher.initialSyncDone.Store(true)
 
 		ticker := time.NewTicker(kr.config.CollectionInterval)
 		defer ticker.Stop()
 
+		// Entity events are only sent when objects change, so the state of all objects is sent
+		// again every metadata_collection_interval, unless it's 0.
+		var entityStates <-chan time.Time
+		if kr.config.MetadataCollectionInterval > 0 {
+			entityTicker := time.NewTicker(kr.config.MetadataCollectionInterval)
+			defer entityTicker.Stop()
+			entityStates = entityTicker.C
+		}
+
 		for {
 			select {
 			case <-ticker.C:
-				kr.dispatchMetrics(ctx)
+				kr.dispatchMetrics(ctx, synced)
+			case <-entityStates:
+				for _, c := range synced {
+					c.resourceWatcher.syncEntityStates()
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -95,16 +158,32 @@ func (kr *kubernetesReceiver) Shutdown(context.Context) error {
 		return nil
 	}
 	kr.cancel()
-	return nil
+	// Send the metadata updates still waiting for metadata_debounce_interval to pass.
+	for _, c := range kr.clusters {
+		c.resourceWatcher.flushMetadataUpdates()
+	}
+	var errs error
+	for _, telemetry := range kr.telemetry {
+		errs = errors.Join(errs, telemetry.Unregister())
+	}
+	return errs
 }
 
-func (kr *kubernetesReceiver) dispatchMetrics(ctx context.Context) {
+// dispatchMetrics collects the metrics of the given clusters and passes them to the metrics consumer.
+func (kr *kubernetesReceiver) dispatchMetrics(ctx context.Context, clusters []*cluster) {
 	if kr.metricsConsumer == nil {
 		// Metric collection is not enabled.
 		return
 	}
 
-	mds := kr.dataCollector.CollectMetricData(time.Now())
+	now := time.Now()
+	mds := pmetric.NewMetrics()
+	for _, c := range clusters {
+		c.dataCollector.CollectMetricData(now).ResourceMetrics().MoveAndAppendTo(mds.ResourceMetrics())
+	}
+	if kr.config.MetricPrefix != "" {
+		prefixMetricNames(mds, kr.config.MetricPrefix)
+	}
 
 	c := kr.obsrecv.StartMetricsOp(ctx)
 
@@ -113,6 +192,20 @@ func (kr *kubernetesReceiver) dispatchMetrics(ctx context.Context) {
 	kr.obsrecv.EndMetricsOp(c, metadata.Type, numPoints, err)
 }
 
+// prefixMetricNames prepends prefix to the names of all metrics in md.
+func prefixMetricNames(md pmetric.Metrics, prefix string) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				ms.At(k).SetName(prefix + ms.At(k).Name())
+			}
+		}
+	}
+}
+
 // newMetricsReceiver creates the Kubernetes cluster receiver with the given configuration.
 func newMetricsReceiver(
 	ctx context.Context, set receiver.CreateSettings, cfg component.Config, consumer consumer.Metrics,
@@ -147,7 +240,9 @@ func newLogsReceiver(
 	if err != nil {
 		return nil, err
 	}
-	r.Unwrap().(*kubernetesReceiver).resourceWatcher.entityLogConsumer = consumer
+	for _, c := range r.Unwrap().(*kubernetesReceiver).clusters {
+		c.resourceWatcher.entityLogConsumer = consumer
+	}
 	return r, nil
 }
 
@@ -164,13 +259,42 @@ func newReceiver(_ context.Context, set receiver.CreateSettings, cfg component.C
 	if err != nil {
 		return nil, err
 	}
-	ms := metadata.NewStore()
+	var clusters []*cluster
+	if len(rCfg.Clusters) == 0 {
+		clusters = append(clusters, newCluster(set, rCfg, rCfg.ClusterName))
+	}
+	for _, cc := range rCfg.Clusters {
+		// Every cluster is watched with its own API config and the other settings of the receiver.
+		clusterCfg := *rCfg
+		clusterCfg.APIConfig = cc.APIConfig
+		clusterCfg.Clusters = nil
+		clusters = append(clusters, newCluster(set, &clusterCfg, cc.Name))
+	}
 	return &kubernetesReceiver{
-		dataCollector: collection.NewDataCollector(set, ms, rCfg.MetricsBuilderConfig,
-			rCfg.NodeConditionTypesToReport, rCfg.AllocatableTypesToReport),
-		resourceWatcher: newResourceWatcher(set, rCfg, ms),
-		settings:        set,
-		config:          rCfg,
-		obsrecv:         obsrecv,
+		clusters: clusters,
+		settings: set,
+		config:   rCfg,
+		obsrecv:  obsrecv,
 	}, nil
 }
+
+// newCluster creates the data collector and resource watcher of a cluster with its own metadata store.
+func newCluster(set receiver.CreateSettings, cfg *Config, name string) *cluster {
+	ms := metadata.NewStore()
+	ms.SetNamespaceFilter(metadata.NewNamespaceFilter(cfg.NamespaceInclude, cfg.NamespaceExclude))
+	dc := collection.NewDataCollector(set, ms, cfg.MetricsBuilderConfig,
+		cfg.NodeConditionTypesToReport, cfg.AllocatableTypesToReport, cfg.collectionsPerKind())
+	for _, cr := range cfg.CustomResources {
+		dc.RegisterCustomResource(cr.groupVersionKind())
+	}
+	dc.SetClusterName(name)
+	rw := newResourceWatcher(set, cfg, ms, dc)
+	if cfg.Distribution == distributionOpenShift {
+		rw.registerOpenShiftKinds(dc)
+	}
+	return &cluster{
+		name:            name,
+		dataCollector:   dc,
+		resourceWatcher: rw,
+	}
+}
@@ -5,12 +5,16 @@ package k8sclusterreceiver
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
 	fakeQuota "github.com/openshift/client-go/quota/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -18,10 +22,13 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
@@ -78,6 +85,226 @@ func TestReceiver(t *testing.T) {
 	require.NoError(t, r.Shutdown(ctx))
 }
 
+func TestReceiverMetricPrefix(t *testing.T) {
+	tt, err := componenttest.SetupTelemetry(component.NewID(metadata.Type))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tt.Shutdown(context.Background()))
+	}()
+
+	client := newFakeClientWithAllResources()
+	sink := new(consumertest.MetricsSink)
+
+	r := setupReceiver(client, nil, sink, nil, 10*time.Second, tt)
+	r.config.MetricPrefix = "infra."
+
+	createPods(t, client, 1)
+	createNodes(t, client, 1)
+
+	ctx := context.Background()
+	require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() > 0
+	}, 10*time.Second, 100*time.Millisecond,
+		"metrics not collected")
+	require.NoError(t, r.Shutdown(ctx))
+
+	for _, md := range sink.AllMetrics() {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			sms := md.ResourceMetrics().At(i).ScopeMetrics()
+			for j := 0; j < sms.Len(); j++ {
+				for k := 0; k < sms.At(j).Metrics().Len(); k++ {
+					require.True(t, strings.HasPrefix(sms.At(j).Metrics().At(k).Name(), "infra.k8s."),
+						sms.At(j).Metrics().At(k).Name())
+				}
+			}
+		}
+	}
+}
+
+func TestReceiverClusterName(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClusterName = "prod"
+	r := newTestReceiver(t, cfg)
+	require.Len(t, r.clusters, 1)
+	require.Equal(t, "prod", r.clusters[0].name)
+
+	client := newFakeClientWithAllResources()
+	createPods(t, client, 1)
+	r.clusters[0].resourceWatcher.makeClient = func(_ k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return client, nil
+	}
+	require.NoError(t, r.clusters[0].resourceWatcher.initialize())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, r.clusters[0].resourceWatcher.startWatchingResources(ctx))
+
+	// The name is set on every resource emitted by the data collector.
+	md := r.clusters[0].dataCollector.CollectMetricData(time.Now())
+	require.Greater(t, md.ResourceMetrics().Len(), 0)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		name, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.cluster.name")
+		require.True(t, ok)
+		require.Equal(t, "prod", name.Str())
+	}
+}
+
+func TestReceiverMultipleClusters(t *testing.T) {
+	tt, err := componenttest.SetupTelemetry(component.NewID(metadata.Type))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tt.Shutdown(context.Background()))
+	}()
+
+	config := &Config{
+		CollectionInterval:   1 * time.Second,
+		Distribution:         distributionKubernetes,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Clusters: []ClusterConfig{
+			{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "eu"}, Name: "eu"},
+			{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "us"}, Name: "us"},
+		},
+	}
+	r, err := newReceiver(context.Background(), receiver.CreateSettings{ID: component.NewID(metadata.Type), TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()}, config)
+	require.NoError(t, err)
+	kr := r.(*kubernetesReceiver)
+	sink := new(consumertest.MetricsSink)
+	kr.metricsConsumer = sink
+	require.Len(t, kr.clusters, 2)
+
+	// Every cluster gets its own client, created from its own API config.
+	podsPerCluster := map[string]int{"eu": 1, "us": 2}
+	for _, c := range kr.clusters {
+		c := c
+		client := newFakeClientWithAllResources()
+		createPods(t, client, podsPerCluster[c.name])
+		c.resourceWatcher.makeClient = func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error) {
+			require.Equal(t, c.name, apiConf.Context)
+			return client, nil
+		}
+	}
+
+	ctx := context.Background()
+	require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() > 0
+	}, 10*time.Second, 100*time.Millisecond,
+		"metrics not collected")
+	require.NoError(t, r.Shutdown(ctx))
+
+	// The metrics of both clusters are merged, every resource carrying its cluster name.
+	md := sink.AllMetrics()[0]
+	pods := map[string]int{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		attrs := md.ResourceMetrics().At(i).Resource().Attributes()
+		name, ok := attrs.Get("k8s.cluster.name")
+		require.True(t, ok)
+		if _, ok := attrs.Get("k8s.pod.uid"); ok {
+			pods[name.Str()]++
+		}
+	}
+	require.Equal(t, podsPerCluster, pods)
+}
+
+func TestReceiverMultipleClustersInitialSyncFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		listErr     error
+		timeout     time.Duration
+		maxWait     time.Duration
+		expectedErr string
+	}{
+		{
+			// The failing clusters time out at once, not one after the other.
+			name:        "timeout",
+			listErr:     errors.New("unavailable"),
+			timeout:     time.Second,
+			maxWait:     2 * time.Second,
+			expectedErr: "initial cache sync of /v1, Kind=Pod timed out after 1s",
+		},
+		{
+			// The initial sync fails right away, long before it times out.
+			name:        "forbidden",
+			listErr:     apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New("denied")),
+			timeout:     time.Hour,
+			maxWait:     10 * time.Second,
+			expectedErr: "not allowed to list or watch /v1, Kind=Pod",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			telemetry, err := componenttest.SetupTelemetry(component.NewID(metadata.Type))
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, telemetry.Shutdown(context.Background()))
+			}()
+			settings := receiver.CreateSettings{ID: component.NewID(metadata.Type), TelemetrySettings: telemetry.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()}
+			statuses := make(chan *component.StatusEvent, 10)
+			settings.ReportStatus = func(event *component.StatusEvent) {
+				statuses <- event
+			}
+
+			config := &Config{
+				CollectionInterval:   100 * time.Millisecond,
+				Distribution:         distributionKubernetes,
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+				InitialSyncTimeout:   tt.timeout,
+				Clusters: []ClusterConfig{
+					{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "ok"}, Name: "ok"},
+					{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "eu"}, Name: "eu"},
+					{APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "us"}, Name: "us"},
+				},
+			}
+			r, err := newReceiver(context.Background(), settings, config)
+			require.NoError(t, err)
+			kr := r.(*kubernetesReceiver)
+			sink := new(consumertest.MetricsSink)
+			kr.metricsConsumer = sink
+			for _, c := range kr.clusters {
+				client := newFakeClientWithAllResources()
+				createPods(t, client, 1)
+				if c.name != "ok" {
+					client.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+						return true, nil, tt.listErr
+					})
+				}
+				c.resourceWatcher.makeClient = func(k8sconfig.APIConfig) (kubernetes.Interface, error) {
+					return client, nil
+				}
+			}
+
+			ctx := context.Background()
+			start := time.Now()
+			require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
+			var event *component.StatusEvent
+			select {
+			case event = <-statuses:
+			case <-time.After(tt.maxWait):
+				require.Fail(t, "initial sync failure not reported in time")
+			}
+			assert.Less(t, time.Since(start), tt.maxWait)
+			// The failed clusters are reported, and the synced one keeps being collected.
+			assert.Equal(t, component.StatusRecoverableError, event.Status())
+			assert.ErrorContains(t, event.Err(), fmt.Sprintf("cluster \"eu\": %s", tt.expectedErr))
+			assert.ErrorContains(t, event.Err(), fmt.Sprintf("cluster \"us\": %s", tt.expectedErr))
+			require.Eventually(t, func() bool {
+				return sink.DataPointCount() > 0
+			}, 10*time.Second, 100*time.Millisecond,
+				"metrics not collected")
+			require.NoError(t, r.Shutdown(ctx))
+
+			for _, md := range sink.AllMetrics() {
+				for i := 0; i < md.ResourceMetrics().Len(); i++ {
+					name, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.cluster.name")
+					require.True(t, ok)
+					assert.Equal(t, "ok", name.Str())
+				}
+			}
+		})
+	}
+}
+
 func TestReceiverTimesOutAfterStartup(t *testing.T) {
 	tt, err := componenttest.SetupTelemetry(component.NewID(metadata.Type))
 	require.NoError(t, err)
@@ -94,7 +321,7 @@ func TestReceiverTimesOutAfterStartup(t *testing.T) {
 	ctx := context.Background()
 	require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
 	require.Eventually(t, func() bool {
-		return r.resourceWatcher.initialSyncTimedOut.Load()
+		return r.clusters[0].resourceWatcher.initialSyncTimedOut.Load()
 	}, 10*time.Second, 100*time.Millisecond)
 	require.NoError(t, r.Shutdown(ctx))
 }
@@ -163,26 +390,24 @@ func TestReceiverWithMetadata(t *testing.T) {
 	// does not pass on events for updates to resources.
 	require.Len(t, pods, 1)
 	updatedPod := getUpdatedPod(pods[0])
-	r.resourceWatcher.onUpdate(pods[0], updatedPod)
+	r.clusters[0].resourceWatcher.onUpdate(gvk.Pod, pods[0], updatedPod)
 
-	// Should not result in ConsumerKubernetesMetadata invocation since the pod
-	// is not changed. Should result in entity event because they are emitted even
-	// if the entity is not changed.
-	r.resourceWatcher.onUpdate(updatedPod, updatedPod)
+	// Should not result in ConsumerKubernetesMetadata invocation or entity event
+	// since the pod is not changed.
+	r.clusters[0].resourceWatcher.onUpdate(gvk.Pod, updatedPod, updatedPod)
 
 	deletePods(t, client, 1)
 
 	// Ensure ConsumeKubernetesMetadata is called twice, once for the add and
 	// then for the update. Note the second update does not result in metatada call
-	// since the pod is not changed.
+	// since the pod is not changed, and neither does the delete.
 	require.Eventually(t, func() bool {
 		return int(numCalls.Load()) == 2
 	}, 10*time.Second, 100*time.Millisecond,
 		"metadata not collected")
 
-	// Must have 3 entity events: once for the add, followed by an update and
-	// then another update, which unlike metadata calls actually happens since
-	// even unchanged entities trigger an event.
+	// Must have 3 entity events: once for the add, followed by the update and
+	// then the delete.
 	require.Eventually(t, func() bool {
 		return logsConsumer.LogRecordCount() == 3
 	}, 10*time.Second, 100*time.Millisecond,
@@ -228,14 +453,14 @@ func setupReceiver(
 	r, _ := newReceiver(context.Background(), receiver.CreateSettings{ID: component.NewID(metadata.Type), TelemetrySettings: tt.TelemetrySettings(), BuildInfo: component.NewDefaultBuildInfo()}, config)
 	kr := r.(*kubernetesReceiver)
 	kr.metricsConsumer = metricsConsumer
-	kr.resourceWatcher.makeClient = func(_ k8sconfig.APIConfig) (kubernetes.Interface, error) {
+	kr.clusters[0].resourceWatcher.makeClient = func(_ k8sconfig.APIConfig) (kubernetes.Interface, error) {
 		return client, nil
 	}
-	kr.resourceWatcher.makeOpenShiftQuotaClient = func(_ k8sconfig.APIConfig) (quotaclientset.Interface, error) {
+	kr.clusters[0].resourceWatcher.makeOpenShiftQuotaClient = func(_ k8sconfig.APIConfig) (quotaclientset.Interface, error) {
 		return osQuotaClient, nil
 	}
-	kr.resourceWatcher.initialTimeout = initialSyncTimeout
-	kr.resourceWatcher.entityLogConsumer = logsConsumer
+	kr.clusters[0].resourceWatcher.initialTimeout = initialSyncTimeout
+	kr.clusters[0].resourceWatcher.entityLogConsumer = logsConsumer
 	return kr
 }
 
@@ -251,6 +476,9 @@ func newFakeClientWithAllResources() *fake.Clientset {
 				gvkToAPIResource(gvk.ReplicationController),
 				gvkToAPIResource(gvk.ResourceQuota),
 				gvkToAPIResource(gvk.Service),
+				gvkToAPIResource(gvk.PersistentVolume),
+				gvkToAPIResource(gvk.PersistentVolumeClaim),
+				gvkToAPIResource(gvk.LimitRange),
 			},
 		},
 		{
@@ -275,6 +503,30 @@ func newFakeClientWithAllResources() *fake.Clientset {
 				gvkToAPIResource(gvk.HorizontalPodAutoscaler),
 			},
 		},
+		{
+			GroupVersion: "discovery.k8s.io/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.EndpointSlice),
+			},
+		},
+		{
+			GroupVersion: "policy/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.PodDisruptionBudget),
+			},
+		},
+		{
+			GroupVersion: "networking.k8s.io/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.Ingress),
+			},
+		},
+		{
+			GroupVersion: "quota.openshift.io/v1",
+			APIResources: []v1.APIResource{
+				gvkToAPIResource(gvk.ClusterResourceQuota),
+			},
+		},
 	}
 	return client
 }
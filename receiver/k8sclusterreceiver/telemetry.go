@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
+
+import (
+	"context"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// informerStatus is the state of the informer cache of a watched kind.
+type informerStatus struct {
+	kind      schema.GroupVersionKind
+	synced    bool
+	cacheSize int
+}
+
+// informerStatuses returns the state of the informer caches of all watched kinds, sorted by kind.
+func (rw *resourceWatcher) informerStatuses() []informerStatus {
+	statuses := make([]informerStatus, 0, len(rw.informersSynced))
+	for kind, synced := range rw.informersSynced {
+		status := informerStatus{kind: kind, synced: synced()}
+		if store := rw.metadataStore.Get(kind); store != nil {
+			status.cacheSize = len(store.ListKeys())
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].kind.String() < statuses[j].kind.String()
+	})
+	return statuses
+}
+
+// registerTelemetry registers the internal metrics reporting whether the informer cache of each
+// watched kind is synced and how many objects it holds, so stale or partially synced caches are
+// visible in the telemetry of the collector. The cluster name is added as an attribute unless empty.
+func (rw *resourceWatcher) registerTelemetry(meter metric.Meter, clusterName string) (metric.Registration, error) {
+	synced, err := meter.Int64ObservableGauge(
+		"k8scluster_informer_synced",
+		metric.WithDescription("Whether the informer cache of the kind has completed its initial sync (0 for no, 1 for yes)"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	cacheSize, err := meter.Int64ObservableGauge(
+		"k8scluster_cache_size",
+		metric.WithDescription("Number of objects in the informer cache of the kind"),
+		metric.WithUnit("{object}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, status := range rw.informerStatuses() {
+			kvs := []attribute.KeyValue{
+				attribute.String("group_version", status.kind.GroupVersion().String()),
+				attribute.String("kind", status.kind.Kind),
+			}
+			if clusterName != "" {
+				kvs = append(kvs, attribute.String("cluster", clusterName))
+			}
+			attrs := metric.WithAttributes(kvs...)
+			var value int64
+			if status.synced {
+				value = 1
+			}
+			o.ObserveInt64(synced, value, attrs)
+			o.ObserveInt64(cacheSize, int64(status.cacheSize), attrs)
+		}
+		return nil
+	}, synced, cacheSize)
+}
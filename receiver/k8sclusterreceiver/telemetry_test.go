@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sclusterreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestInformerStatuses(t *testing.T) {
+	client := fake.NewSimpleClientset(testutils.NewPodWithContainer("1",
+		testutils.NewPodSpecWithContainer("container-name"),
+		testutils.NewPodStatusWithContainer("container-name", "container-id")))
+	rw := &resourceWatcher{
+		kinds:         newTestKinds(),
+		client:        client,
+		logger:        zap.NewNop(),
+		metadataStore: metadata.NewStore(),
+		config:        &Config{},
+	}
+	factory := informers.NewSharedInformerFactory(client, 0)
+	rw.setupInformerForKind(gvk.Pod, collection.InformerFactories{Kubernetes: factory})
+	rw.setupInformerForKind(gvk.Node, collection.InformerFactories{Kubernetes: factory})
+
+	assert.Equal(t, []informerStatus{
+		{kind: gvk.Node},
+		{kind: gvk.Pod},
+	}, rw.informerStatuses())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	assert.Equal(t, []informerStatus{
+		{kind: gvk.Node, synced: true},
+		{kind: gvk.Pod, synced: true, cacheSize: 1},
+	}, rw.informerStatuses())
+}
+
+func TestUnsyncedKinds(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	rw := &resourceWatcher{
+		kinds:         newTestKinds(),
+		client:        client,
+		logger:        zap.NewNop(),
+		metadataStore: metadata.NewStore(),
+		config:        &Config{},
+	}
+	factory := informers.NewSharedInformerFactory(client, 0)
+	rw.setupInformerForKind(gvk.Pod, collection.InformerFactories{Kubernetes: factory})
+	rw.setupInformerForKind(gvk.Node, collection.InformerFactories{Kubernetes: factory})
+	assert.Equal(t, []string{gvk.Node.String(), gvk.Pod.String()}, rw.unsyncedKinds())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+	assert.Empty(t, rw.unsyncedKinds())
+}
+
+func TestRegisterTelemetry(t *testing.T) {
+	rw := &resourceWatcher{metadataStore: metadata.NewStore()}
+	registration, err := rw.registerTelemetry(noop.NewMeterProvider().Meter("test"), "")
+	require.NoError(t, err)
+	assert.NoError(t, registration.Unregister())
+}
@@ -5,42 +5,37 @@ package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
-	quotaclientset "github.com/openshift/client-go/quota/clientset/versioned"
-	quotainformersv1 "github.com/openshift/client-go/quota/informers/externalversions"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
-	appsv1 "k8s.io/api/apps/v1"
-	autoscalingv2 "k8s.io/api/autoscaling/v2"
-	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/cronjob"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/demonset"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/hpa"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pod"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicaset"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicationcontroller"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/statefulset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
@@ -49,11 +44,40 @@ type sharedInformer interface {
 	WaitForCacheSync(<-chan struct{}) map[reflect.Type]bool
 }
 
+// dynamicInformerFactory adapts a DynamicSharedInformerFactory, which reports the synced
+// informers by resource instead of by type, to sharedInformer.
+type dynamicInformerFactory struct {
+	dynamicinformer.DynamicSharedInformerFactory
+}
+
+func (f dynamicInformerFactory) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	f.DynamicSharedInformerFactory.WaitForCacheSync(stopCh)
+	return nil
+}
+
+// kindRegistry looks up the functions a kind was registered with.
+type kindRegistry interface {
+	Kind(kind schema.GroupVersionKind) (collection.Kind, bool)
+}
+
+// extensionKind is a kind served by an API extension of the distribution, which is watched in
+// addition to the built-in kinds when it is served.
+type extensionKind struct {
+	kind schema.GroupVersionKind
+	// enabled returns whether the kind is watched, e.g. because one of its metrics is enabled.
+	// Kinds without it are always watched.
+	enabled func() bool
+	// dynamic is whether the informer of the kind is created with the dynamic factory.
+	dynamic bool
+}
+
 type resourceWatcher struct {
+	openShiftClients
 	client              kubernetes.Interface
-	osQuotaClient       quotaclientset.Interface
+	dynamicClient       dynamic.Interface
 	informerFactories   []sharedInformer
 	metadataStore       *metadata.Store
+	kinds               kindRegistry
 	logger              *zap.Logger
 	metadataConsumers   []metadataConsumer
 	initialTimeout      time.Duration
@@ -61,25 +85,55 @@ type resourceWatcher struct {
 	initialSyncTimedOut *atomic.Bool
 	config              *Config
 	entityLogConsumer   consumer.Logs
+	// The kinds served by API extensions of the distribution, registered with the informer and
+	// metadata functions they are set up with.
+	extensionKinds []extensionKind
+	// Reports whether the informer of each watched kind has synced, set up with the informers.
+	informersSynced map[schema.GroupVersionKind]cache.InformerSynced
+	// Ends the initial cache sync with the given error, set up before the informers are started.
+	failInitialSync context.CancelCauseFunc
+	// Metadata updates waiting for metadata_debounce_interval to pass, keyed by object UID, and
+	// the timer sending them.
+	pendingMu      sync.Mutex
+	pendingUpdates map[types.UID]*pendingMetadataUpdate
+	pendingTimer   *time.Timer
 
 	// For mocking.
-	makeClient               func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
-	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
+	makeClient        func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
+	makeDynamicClient func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
+}
+
+// initialSyncTimeout returns the configured initial sync timeout, or the default one if not set.
+func initialSyncTimeout(cfg *Config) time.Duration {
+	if cfg.InitialSyncTimeout > 0 {
+		return cfg.InitialSyncTimeout
+	}
+	return defaultInitialSyncTimeout
 }
 
 type metadataConsumer func(metadata []*experimentalmetricmetadata.MetadataUpdate) error
 
-// newResourceWatcher creates a Kubernetes resource watcher.
-func newResourceWatcher(set receiver.CreateSettings, cfg *Config, metadataStore *metadata.Store) *resourceWatcher {
+// pendingMetadataUpdate is the metadata of an object before its first and after its last change
+// within the debounce window.
+type pendingMetadataUpdate struct {
+	oldMetadata map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata
+	newMetadata map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata
+}
+
+// newResourceWatcher creates a Kubernetes resource watcher. The informers and metadata of the watched
+// kinds are set up with the functions they are registered with in kinds.
+func newResourceWatcher(set receiver.CreateSettings, cfg *Config, metadataStore *metadata.Store, kinds kindRegistry) *resourceWatcher {
 	return &resourceWatcher{
-		logger:                   set.Logger,
-		metadataStore:            metadataStore,
-		initialSyncDone:          &atomic.Bool{},
-		initialSyncTimedOut:      &atomic.Bool{},
-		initialTimeout:           defaultInitialSyncTimeout,
-		config:                   cfg,
-		makeClient:               k8sconfig.MakeClient,
-		makeOpenShiftQuotaClient: k8sconfig.MakeOpenShiftQuotaClient,
+		logger:              set.Logger,
+		metadataStore:       metadataStore,
+		kinds:               kinds,
+		initialSyncDone:     &atomic.Bool{},
+		initialSyncTimedOut: &atomic.Bool{},
+		initialTimeout:      initialSyncTimeout(cfg),
+		config:              cfg,
+		makeClient:          k8sconfig.MakeClient,
+		openShiftClients:    openShiftClients{makeOpenShiftQuotaClient: k8sconfig.MakeOpenShiftQuotaClient},
+		makeDynamicClient:   k8sconfig.MakeDynamicClient,
 	}
 }
 
@@ -97,6 +151,13 @@ func (rw *resourceWatcher) initialize() error {
 		}
 	}
 
+	if len(rw.config.CustomResources) > 0 || rw.vpaMetricsEnabled() || rw.dynamicExtensionKindsEnabled() {
+		rw.dynamicClient, err = rw.makeDynamicClient(rw.config.APIConfig)
+		if err != nil {
+			return fmt.Errorf("Failed to create Kubernetes dynamic client: %w", err)
+		}
+	}
+
 	err = rw.prepareSharedInformerFactory()
 	if err != nil {
 		return err
@@ -106,9 +167,20 @@ func (rw *resourceWatcher) initialize() error {
 }
 
 func (rw *resourceWatcher) prepareSharedInformerFactory() error {
-	factory := informers.NewSharedInformerFactoryWithOptions(rw.client, rw.config.MetadataCollectionInterval)
+	// When a single namespace is watched, namespaced kinds are only listed in that namespace
+	// and cluster-scoped kinds are skipped, so that namespace-scoped RBAC permissions suffice.
+	var factoryOpts []informers.SharedInformerOption
+	namespaced := rw.config.Namespace != ""
+	if namespaced {
+		rw.logger.Info("Watching a single namespace, cluster-scoped kinds are not collected",
+			zap.String("namespace", rw.config.Namespace))
+		factoryOpts = append(factoryOpts, informers.WithNamespace(rw.config.Namespace))
+	}
+	factory := informers.NewSharedInformerFactoryWithOptions(rw.client, rw.config.MetadataCollectionInterval, factoryOpts...)
 
 	// Map of supported group version kinds by name of a kind.
+	// Group versions are listed in order of preference, only the first one supported
+	// by k8s server is watched for a specific kind.
 	// If none of the group versions are supported by k8s server for a specific kind,
 	// informer for that kind won't be set and a warning message is thrown.
 	// This map should be kept in sync with what can be provided by the supported k8s server versions.
@@ -119,16 +191,60 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 		"ReplicationController":   {gvk.ReplicationController},
 		"ResourceQuota":           {gvk.ResourceQuota},
 		"Service":                 {gvk.Service},
+		"PersistentVolume":        {gvk.PersistentVolume},
+		"PersistentVolumeClaim":   {gvk.PersistentVolumeClaim},
+		"LimitRange":              {gvk.LimitRange},
 		"DaemonSet":               {gvk.DaemonSet},
 		"Deployment":              {gvk.Deployment},
 		"ReplicaSet":              {gvk.ReplicaSet},
 		"StatefulSet":             {gvk.StatefulSet},
 		"Job":                     {gvk.Job},
-		"CronJob":                 {gvk.CronJob},
-		"HorizontalPodAutoscaler": {gvk.HorizontalPodAutoscaler},
+		"CronJob":                 {gvk.CronJob, gvk.CronJobBeta},
+		"HorizontalPodAutoscaler": {gvk.HorizontalPodAutoscaler, gvk.HorizontalPodAutoscalerBeta},
+		"EndpointSlice":           {gvk.EndpointSlice, gvk.Endpoints}, // Endpoints are a fallback for clusters without EndpointSlices.
+		"PodDisruptionBudget":     {gvk.PodDisruptionBudget},
+		"Ingress":                 {gvk.Ingress},
+	}
+
+	clusterScopedKinds := map[string]bool{
+		"Node":             true,
+		"Namespace":        true,
+		"PersistentVolume": true,
+	}
+
+	// Group version kinds whose metrics are disabled by default are only watched when one of
+	// their metrics is enabled, since they require additional permissions.
+	metrics := rw.config.MetricsBuilderConfig.Metrics
+	optInKinds := map[schema.GroupVersionKind]bool{
+		gvk.PersistentVolume: metrics.K8sPersistentvolumeCapacity.Enabled || metrics.K8sPersistentvolumePhase.Enabled,
+		gvk.PersistentVolumeClaim: metrics.K8sPersistentvolumeclaimPhase.Enabled || metrics.K8sPersistentvolumeclaimRequestedStorage.Enabled ||
+			metrics.K8sPersistentvolumeclaimActualCapacity.Enabled,
+		gvk.EndpointSlice: metrics.K8sEndpointsliceAddressCount.Enabled || metrics.K8sEndpointsliceReadyCount.Enabled,
+		gvk.Endpoints:     metrics.K8sEndpointsAddressCount.Enabled || metrics.K8sEndpointsNotReadyAddressCount.Enabled,
+		gvk.LimitRange: metrics.K8sLimitrangeDefaultCPURequest.Enabled || metrics.K8sLimitrangeDefaultMemoryRequest.Enabled ||
+			metrics.K8sLimitrangeMax.Enabled || metrics.K8sLimitrangeMin.Enabled,
+		gvk.PodDisruptionBudget: metrics.K8sPdbCurrentHealthy.Enabled || metrics.K8sPdbDesiredHealthy.Enabled ||
+			metrics.K8sPdbExpectedPods.Enabled || metrics.K8sPdbDisruptionsAllowed.Enabled,
+		gvk.Ingress: metrics.K8sIngressRuleCount.Enabled || metrics.K8sIngressTLSCount.Enabled,
+	}
+
+	// watched returns whether a group version kind is watched when it's served.
+	watched := func(kind schema.GroupVersionKind) bool {
+		enabled, ok := optInKinds[kind]
+		return rw.config.collectsKind(kind.Kind) && (!ok || enabled)
 	}
 
 	for kind, gvks := range supportedKinds {
+		if namespaced && clusterScopedKinds[kind] {
+			continue
+		}
+		anyWatched := false
+		for _, kindGVK := range gvks {
+			anyWatched = anyWatched || watched(kindGVK)
+		}
+		if !anyWatched {
+			continue
+		}
 		anySupported := false
 		for _, gvk := range gvks {
 			supported, err := rw.isKindSupported(gvk)
@@ -137,7 +253,14 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 			}
 			if supported {
 				anySupported = true
-				rw.setupInformerForKind(gvk, factory)
+				// A fallback group version kind is not watched instead of a preferred one that is
+				// served but not watched.
+				if watched(gvk) {
+					rw.setupInformerForKind(gvk, collection.InformerFactories{
+						Kubernetes: rw.factoryForKind(gvk.Kind, factory, factoryOpts),
+					})
+				}
+				break
 			}
 		}
 		if !anySupported {
@@ -146,104 +269,391 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 		}
 	}
 
-	if rw.osQuotaClient != nil {
-		quotaFactory := quotainformersv1.NewSharedInformerFactory(rw.osQuotaClient, 0)
-		rw.setupInformer(gvk.ClusterResourceQuota, quotaFactory.Quota().V1().ClusterResourceQuotas().Informer())
-		rw.informerFactories = append(rw.informerFactories, quotaFactory)
+	// Secrets and service accounts are only watched when their metric is enabled, since they
+	// require additional permissions.
+	for kind, enabled := range map[schema.GroupVersionKind]bool{
+		gvk.Secret:         rw.config.MetricsBuilderConfig.Metrics.K8sNamespaceSecretCount.Enabled,
+		gvk.ServiceAccount: rw.config.MetricsBuilderConfig.Metrics.K8sNamespaceServiceaccountCount.Enabled,
+	} {
+		if !enabled || !rw.config.collectsKind(kind.Kind) {
+			continue
+		}
+		supported, err := rw.isKindSupported(kind)
+		if err != nil {
+			return err
+		}
+		if !supported {
+			rw.logger.Warn("Server doesn't support any of the group versions defined for the kind",
+				zap.String("kind", kind.Kind))
+			continue
+		}
+		rw.setupInformerForKind(kind, collection.InformerFactories{
+			Kubernetes: rw.factoryForKind(kind.Kind, factory, factoryOpts),
+		})
 	}
+
 	rw.informerFactories = append(rw.informerFactories, factory)
 
+	// Priority classes are only watched when one of their metrics is enabled, since they require
+	// additional permissions.
+	if rw.priorityClassMetricsEnabled() && !namespaced && rw.config.collectsKind(gvk.PriorityClass.Kind) {
+		supported, err := rw.isKindSupported(gvk.PriorityClass)
+		if err != nil {
+			return err
+		}
+		if supported {
+			rw.setupInformerForKind(gvk.PriorityClass, collection.InformerFactories{
+				Kubernetes: rw.factoryForKind(gvk.PriorityClass.Kind, factory, factoryOpts),
+			})
+		} else {
+			rw.logger.Warn("Server doesn't support any of the group versions defined for the kind",
+				zap.String("kind", gvk.PriorityClass.Kind))
+		}
+	}
+
+	// Storage classes are only watched when their metric is enabled, since they require
+	// additional permissions.
+	if metrics.K8sStorageclassInfo.Enabled && !namespaced && rw.config.collectsKind(gvk.StorageClass.Kind) {
+		supported, err := rw.isKindSupported(gvk.StorageClass)
+		if err != nil {
+			return err
+		}
+		if supported {
+			rw.setupInformerForKind(gvk.StorageClass, collection.InformerFactories{
+				Kubernetes: rw.factoryForKind(gvk.StorageClass.Kind, factory, factoryOpts),
+			})
+		} else {
+			rw.logger.Warn("Server doesn't support any of the group versions defined for the kind",
+				zap.String("kind", gvk.StorageClass.Kind))
+		}
+	}
+
+	// Node leases are only watched when their metric is enabled, since they require
+	// additional permissions. Only the leases in the kube-node-lease namespace are watched.
+	if metrics.K8sNodeLeaseRenewAge.Enabled && !namespaced && rw.config.collectsKind(gvk.Lease.Kind) {
+		supported, err := rw.isKindSupported(gvk.Lease)
+		if err != nil {
+			return err
+		}
+		if supported {
+			leaseOpts := []informers.SharedInformerOption{informers.WithNamespace(corev1.NamespaceNodeLease)}
+			if tweak := rw.labelSelectorTweak(gvk.Lease.Kind); tweak != nil {
+				leaseOpts = append(leaseOpts, informers.WithTweakListOptions(tweak))
+			}
+			leaseFactory := informers.NewSharedInformerFactoryWithOptions(rw.client, rw.config.MetadataCollectionInterval, leaseOpts...)
+			rw.setupInformerForKind(gvk.Lease, collection.InformerFactories{Kubernetes: leaseFactory})
+			rw.informerFactories = append(rw.informerFactories, leaseFactory)
+		} else {
+			rw.logger.Warn("Server doesn't support any of the group versions defined for the kind",
+				zap.String("kind", gvk.Lease.Kind))
+		}
+	}
+
+	// Custom resources are watched with a dynamic informer per configured kind, looking up
+	// the resource name of the kind with discovery. Vertical pod autoscalers are served by a CRD
+	// as well, so they are watched the same way when their metrics are enabled.
+	var dynamicFactory dynamicinformer.DynamicSharedInformerFactory
+	if rw.dynamicClient != nil {
+		dynamicFactory = dynamicinformer.NewFilteredDynamicSharedInformerFactory(rw.dynamicClient,
+			rw.config.MetadataCollectionInterval, rw.config.Namespace, nil)
+		var kinds []schema.GroupVersionKind
+		for _, cr := range rw.config.CustomResources {
+			kinds = append(kinds, cr.groupVersionKind())
+		}
+		if rw.vpaMetricsEnabled() {
+			kinds = append(kinds, gvk.VerticalPodAutoscaler)
+		}
+		for _, kind := range kinds {
+			if !rw.config.collectsKind(kind.Kind) {
+				continue
+			}
+			resource, err := rw.findResource(kind)
+			if err != nil {
+				return err
+			}
+			if resource == nil {
+				rw.logger.Warn("Server doesn't support the group version defined for the custom resource",
+					zap.String("kind", kind.String()))
+				continue
+			}
+			if namespaced && !resource.Namespaced {
+				continue
+			}
+			kindFactory := rw.dynamicFactoryForKind(kind.Kind, dynamicFactory)
+			gvr := kind.GroupVersion().WithResource(resource.Name)
+			// Custom resources are only counted, so they aren't registered kinds.
+			if _, ok := rw.kinds.Kind(kind); !ok {
+				rw.setupInformer(kind, kindFactory.ForResource(gvr).Informer())
+				continue
+			}
+			rw.setupInformerForKind(kind, collection.InformerFactories{Dynamic: kindFactory, Resource: gvr})
+		}
+	}
+
+	// Kinds served by API extensions are only set up when they are enabled and served, so that
+	// the receiver keeps working on clusters configured with the distribution but without the
+	// extension, e.g. the OpenShift quota API.
+	for _, ext := range rw.extensionKinds {
+		if (ext.enabled != nil && !ext.enabled()) || !rw.config.collectsKind(ext.kind.Kind) {
+			continue
+		}
+		resource, err := rw.findResource(ext.kind)
+		if err != nil {
+			return err
+		}
+		if resource == nil {
+			rw.logger.Warn("Server doesn't support any of the group versions defined for the kind",
+				zap.String("kind", ext.kind.Kind))
+			continue
+		}
+		if namespaced && !resource.Namespaced {
+			continue
+		}
+		factories := collection.InformerFactories{
+			Kubernetes: factory,
+			Resource:   ext.kind.GroupVersion().WithResource(resource.Name),
+		}
+		if ext.dynamic {
+			factories.Dynamic = rw.dynamicFactoryForKind(ext.kind.Kind, dynamicFactory)
+		}
+		rw.setupInformerForKind(ext.kind, factories)
+	}
+
+	if dynamicFactory != nil {
+		rw.informerFactories = append(rw.informerFactories, dynamicInformerFactory{dynamicFactory})
+	}
+
 	return nil
 }
 
+// labelSelectorTweak returns a function setting the label selector configured for the kind on the
+// list options of its informer, or nil if no label selector is configured for the kind.
+func (rw *resourceWatcher) labelSelectorTweak(kind string) func(*metav1.ListOptions) {
+	selector, ok := rw.config.LabelSelectors[strings.ToLower(kind)]
+	if !ok {
+		return nil
+	}
+	return func(opts *metav1.ListOptions) {
+		opts.LabelSelector = selector
+	}
+}
+
+// factoryForKind returns the factory to set up the informer of the kind with. Informers of a
+// factory share the list options, so kinds with a label selector get a factory of their own.
+func (rw *resourceWatcher) factoryForKind(kind string, factory informers.SharedInformerFactory,
+	factoryOpts []informers.SharedInformerOption) informers.SharedInformerFactory {
+	tweak := rw.labelSelectorTweak(kind)
+	if tweak == nil {
+		return factory
+	}
+	kindOpts := append([]informers.SharedInformerOption{informers.WithTweakListOptions(tweak)}, factoryOpts...)
+	kindFactory := informers.NewSharedInformerFactoryWithOptions(rw.client, rw.config.MetadataCollectionInterval, kindOpts...)
+	rw.informerFactories = append(rw.informerFactories, kindFactory)
+	return kindFactory
+}
+
+// dynamicFactoryForKind returns the dynamic factory to set up the informer of the kind with. Like
+// with factoryForKind, kinds with a label selector get a factory of their own.
+func (rw *resourceWatcher) dynamicFactoryForKind(kind string,
+	factory dynamicinformer.DynamicSharedInformerFactory) dynamicinformer.DynamicSharedInformerFactory {
+	tweak := rw.labelSelectorTweak(kind)
+	if tweak == nil {
+		return factory
+	}
+	kindFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(rw.dynamicClient,
+		rw.config.MetadataCollectionInterval, rw.config.Namespace, tweak)
+	rw.informerFactories = append(rw.informerFactories, dynamicInformerFactory{kindFactory})
+	return kindFactory
+}
+
+// priorityClassMetricsEnabled returns whether any of the priority class metrics is enabled.
+func (rw *resourceWatcher) priorityClassMetricsEnabled() bool {
+	metrics := rw.config.MetricsBuilderConfig.Metrics
+	return metrics.K8sPriorityclassValue.Enabled || metrics.K8sPriorityclassGlobalDefault.Enabled
+}
+
+// vpaMetricsEnabled returns whether any of the vertical pod autoscaler metrics is enabled.
+func (rw *resourceWatcher) vpaMetricsEnabled() bool {
+	metrics := rw.config.MetricsBuilderConfig.Metrics
+	return metrics.K8sVpaTargetCPU.Enabled || metrics.K8sVpaTargetMemory.Enabled
+}
+
+// dynamicExtensionKindsEnabled returns whether any of the enabled extension kinds is watched with
+// the dynamic factory.
+func (rw *resourceWatcher) dynamicExtensionKindsEnabled() bool {
+	for _, ext := range rw.extensionKinds {
+		if ext.dynamic && (ext.enabled == nil || ext.enabled()) {
+			return true
+		}
+	}
+	return false
+}
+
 func (rw *resourceWatcher) isKindSupported(gvk schema.GroupVersionKind) (bool, error) {
+	resource, err := rw.findResource(gvk)
+	return resource != nil, err
+}
+
+// findResource returns the API resource serving the given kind, or nil if the server doesn't support it.
+// Subresources, which are listed with the kind of their parent resource, are skipped.
+func (rw *resourceWatcher) findResource(gvk schema.GroupVersionKind) (*metav1.APIResource, error) {
 	resources, err := rw.client.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
 		if apierrors.IsNotFound(err) { // if the discovery endpoint isn't present, assume group version is not supported
 			rw.logger.Debug("Group version is not supported", zap.String("group", gvk.GroupVersion().String()))
-			return false, nil
-		}
-		return false, fmt.Errorf("failed to fetch group version details: %w", err)
-	}
-
-	for _, r := range resources.APIResources {
-		if r.Kind == gvk.Kind {
-			return true, nil
-		}
-	}
-	return false, nil
-}
-
-func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, factory informers.SharedInformerFactory) {
-	switch kind {
-	case gvk.Pod:
-		rw.setupInformer(kind, factory.Core().V1().Pods().Informer())
-	case gvk.Node:
-		rw.setupInformer(kind, factory.Core().V1().Nodes().Informer())
-	case gvk.Namespace:
-		rw.setupInformer(kind, factory.Core().V1().Namespaces().Informer())
-	case gvk.ReplicationController:
-		rw.setupInformer(kind, factory.Core().V1().ReplicationControllers().Informer())
-	case gvk.ResourceQuota:
-		rw.setupInformer(kind, factory.Core().V1().ResourceQuotas().Informer())
-	case gvk.Service:
-		rw.setupInformer(kind, factory.Core().V1().Services().Informer())
-	case gvk.DaemonSet:
-		rw.setupInformer(kind, factory.Apps().V1().DaemonSets().Informer())
-	case gvk.Deployment:
-		rw.setupInformer(kind, factory.Apps().V1().Deployments().Informer())
-	case gvk.ReplicaSet:
-		rw.setupInformer(kind, factory.Apps().V1().ReplicaSets().Informer())
-	case gvk.StatefulSet:
-		rw.setupInformer(kind, factory.Apps().V1().StatefulSets().Informer())
-	case gvk.Job:
-		rw.setupInformer(kind, factory.Batch().V1().Jobs().Informer())
-	case gvk.CronJob:
-		rw.setupInformer(kind, factory.Batch().V1().CronJobs().Informer())
-	case gvk.HorizontalPodAutoscaler:
-		rw.setupInformer(kind, factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer())
-	default:
+			return nil, nil
+		}
+		if apierrors.IsForbidden(err) {
+			return nil, fmt.Errorf("not allowed to fetch group version details of %q, check the RBAC permissions "+
+				"of the service account or impersonated user: %w", gvk.GroupVersion().String(), err)
+		}
+		return nil, fmt.Errorf("failed to fetch group version details: %w", err)
+	}
+
+	for i, r := range resources.APIResources {
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return &resources.APIResources[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, factories collection.InformerFactories) {
+	k, ok := rw.kinds.Kind(kind)
+	if !ok || k.Informer == nil {
 		rw.logger.Error("Could not setup an informer for provided group version kind",
 			zap.String("group version kind", kind.String()))
+		return
 	}
+	rw.setupInformer(kind, k.Informer(factories))
 }
 
-// startWatchingResources starts up all informers.
-func (rw *resourceWatcher) startWatchingResources(ctx context.Context, inf sharedInformer) context.Context {
-	var cancel context.CancelFunc
-	timedContextForInitialSync, cancel := context.WithTimeout(ctx, rw.initialTimeout)
+// startWatchingResources starts up all informers and waits for their initial cache sync. It returns
+// an error if the initial sync times out or one of the kinds is not allowed to be listed or watched,
+// or if ctx is done first.
+func (rw *resourceWatcher) startWatchingResources(ctx context.Context) error {
+	syncContext, failInitialSync := context.WithCancelCause(ctx)
+	defer failInitialSync(nil)
+	rw.failInitialSync = failInitialSync
+	timedContextForInitialSync, cancel := context.WithTimeout(syncContext, rw.initialTimeout)
+	defer cancel()
 
-	// Start off individual informers in the factory.
-	inf.Start(ctx.Done())
+	// Start off the informers of all factories before waiting for any of them, so that the
+	// initial sync timeout applies to all of them at once and the kinds that are not synced when
+	// it expires are the ones that timed out.
+	for _, inf := range rw.informerFactories {
+		if inf != nil {
+			inf.Start(ctx.Done())
+		}
+	}
 
 	// Ensure cache is synced with initial state, once informers are started up.
 	// Note that the event handler can start receiving events as soon as the informers
 	// are started. So it's required to ensure that the receiver does not start
 	// collecting data before the cache sync since all data may not be available.
 	// This method will block either till the timeout set on the context, until
-	// the initial sync is complete or the parent context is cancelled.
-	inf.WaitForCacheSync(timedContextForInitialSync.Done())
-	defer cancel()
-	return timedContextForInitialSync
+	// the initial sync is complete or fails, or the parent context is cancelled.
+	for _, inf := range rw.informerFactories {
+		if inf != nil {
+			inf.WaitForCacheSync(timedContextForInitialSync.Done())
+		}
+	}
+
+	kinds := rw.unsyncedKinds()
+	if len(kinds) == 0 {
+		return nil
+	}
+	err := context.Cause(timedContextForInitialSync)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("initial cache sync of %s timed out after %s", strings.Join(kinds, ", "), rw.initialTimeout)
+	}
+	return err
+}
+
+// unsyncedKinds returns the watched kinds whose informer cache hasn't completed its initial sync, sorted by kind.
+func (rw *resourceWatcher) unsyncedKinds() []string {
+	var kinds []string
+	for _, status := range rw.informerStatuses() {
+		if !status.synced {
+			kinds = append(kinds, status.kind.String())
+		}
+	}
+	return kinds
 }
 
 // setupInformer adds event handlers to informers and setups a metadataStore.
 func (rw *resourceWatcher) setupInformer(gvk schema.GroupVersionKind, informer cache.SharedIndexInformer) {
-	err := informer.SetTransform(transformObject)
+	err := informer.SetTransform(rw.transform)
 	if err != nil {
 		rw.logger.Error("error setting informer transform function", zap.Error(err))
 	}
 	_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    rw.onAdd,
-		UpdateFunc: rw.onUpdate,
+		AddFunc: func(obj any) {
+			rw.onAdd(gvk, obj)
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			rw.onUpdate(gvk, oldObj, newObj)
+		},
+		DeleteFunc: func(obj any) {
+			rw.onDelete(gvk, obj)
+		},
 	})
 	if err != nil {
 		rw.logger.Error("error adding event handler to informer", zap.Error(err))
 	}
+	err = informer.SetWatchErrorHandler(rw.watchErrorHandler(gvk))
+	if err != nil {
+		rw.logger.Error("error setting informer watch error handler", zap.Error(err))
+	}
 	rw.metadataStore.Setup(gvk, informer.GetStore())
+	if rw.informersSynced == nil {
+		rw.informersSynced = map[schema.GroupVersionKind]cache.InformerSynced{}
+	}
+	rw.informersSynced[gvk] = informer.HasSynced
+}
+
+// watchErrorHandler returns a handler that reports missing RBAC permissions to list or watch
+// the kind as errors, since the informer would otherwise keep retrying without any metrics
+// being emitted for the kind. Other errors are handled the default way.
+func (rw *resourceWatcher) watchErrorHandler(gvk schema.GroupVersionKind) cache.WatchErrorHandler {
+	return func(r *cache.Reflector, err error) {
+		if apierrors.IsForbidden(err) {
+			rw.logger.Error("Not allowed to list or watch the kind, check the RBAC permissions of the service account or impersonated user",
+				zap.String("kind", gvk.String()), zap.Error(err))
+			// The initial sync can't complete without the permissions, so it fails right away
+			// instead of when it times out. This is a no-op once the initial sync has ended.
+			if rw.failInitialSync != nil {
+				rw.failInitialSync(fmt.Errorf("not allowed to list or watch %s: %w", gvk.String(), err))
+			}
+			return
+		}
+		cache.DefaultWatchErrorHandler(r, err)
+	}
+}
+
+// transform reduces the object with transformObject while keeping the annotations
+// selected by metadata_annotations, which are otherwise dropped.
+func (rw *resourceWatcher) transform(obj any) (any, error) {
+	newObj, err := transformObject(obj)
+	if err != nil || len(rw.config.MetadataAnnotations) == 0 {
+		return newObj, err
+	}
+	om, ok := obj.(metav1.Object)
+	if !ok {
+		return newObj, nil
+	}
+	if newOM, ok := newObj.(metav1.Object); ok {
+		annotations := metadata.FilterKeys(om.GetAnnotations(), rw.config.MetadataAnnotations)
+		// Keep the annotations that the transformation itself retained.
+		if kept := newOM.GetAnnotations(); len(kept) > 0 {
+			annotations = maps.MergeStringMaps(kept, annotations)
+		}
+		newOM.SetAnnotations(annotations)
+	}
+	return newObj, nil
 }
 
-func (rw *resourceWatcher) onAdd(obj any) {
+func (rw *resourceWatcher) onAdd(kind schema.GroupVersionKind, obj any) {
 	rw.waitForInitialInformerSync()
 
 	// Sync metadata only if there's at least one destination for it to sent.
@@ -251,14 +661,25 @@ func (rw *resourceWatcher) onAdd(obj any) {
 		return
 	}
 
-	rw.syncMetadataUpdate(map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{}, rw.objMetadata(obj))
+	rw.queueMetadataUpdate(obj, map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{}, rw.objMetadata(kind, obj))
 }
 
 func (rw *resourceWatcher) hasDestination() bool {
 	return len(rw.metadataConsumers) != 0 || rw.entityLogConsumer != nil
 }
 
-func (rw *resourceWatcher) onUpdate(oldObj, newObj any) {
+func (rw *resourceWatcher) onUpdate(kind schema.GroupVersionKind, oldObj, newObj any) {
+	rw.waitForInitialInformerSync()
+
+	// Sync metadata only if there's at least one destination for it to sent.
+	if !rw.hasDestination() {
+		return
+	}
+
+	rw.queueMetadataUpdate(newObj, rw.objMetadata(kind, oldObj), rw.objMetadata(kind, newObj))
+}
+
+func (rw *resourceWatcher) onDelete(kind schema.GroupVersionKind, obj any) {
 	rw.waitForInitialInformerSync()
 
 	// Sync metadata only if there's at least one destination for it to sent.
@@ -266,34 +687,85 @@ func (rw *resourceWatcher) onUpdate(oldObj, newObj any) {
 		return
 	}
 
-	rw.syncMetadataUpdate(rw.objMetadata(oldObj), rw.objMetadata(newObj))
+	// The informer passes the last known state of objects whose deletion was missed while
+	// the watch was disconnected.
+	if deleted, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = deleted.Obj
+	}
+	rw.queueMetadataUpdate(obj, rw.objMetadata(kind, obj), map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{})
+}
+
+// queueMetadataUpdate syncs the metadata update of the given object once metadata_debounce_interval
+// has passed, coalesced with the other updates of the object within the interval. The update is
+// synced right away if no interval is set.
+func (rw *resourceWatcher) queueMetadataUpdate(obj any, oldMetadata, newMetadata map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata) {
+	interval := rw.config.MetadataDebounceInterval
+	accessor, err := meta.Accessor(obj)
+	if interval <= 0 || err != nil {
+		rw.syncMetadataUpdate(oldMetadata, newMetadata)
+		return
+	}
+
+	rw.pendingMu.Lock()
+	defer rw.pendingMu.Unlock()
+	if pending, ok := rw.pendingUpdates[accessor.GetUID()]; ok {
+		pending.newMetadata = newMetadata
+		return
+	}
+	if rw.pendingUpdates == nil {
+		rw.pendingUpdates = map[types.UID]*pendingMetadataUpdate{}
+	}
+	rw.pendingUpdates[accessor.GetUID()] = &pendingMetadataUpdate{oldMetadata: oldMetadata, newMetadata: newMetadata}
+	if rw.pendingTimer == nil {
+		rw.pendingTimer = time.AfterFunc(interval, rw.flushMetadataUpdates)
+	}
+}
+
+// flushMetadataUpdates syncs the queued metadata updates of all objects at once.
+func (rw *resourceWatcher) flushMetadataUpdates() {
+	rw.pendingMu.Lock()
+	pending := rw.pendingUpdates
+	rw.pendingUpdates = nil
+	if rw.pendingTimer != nil {
+		rw.pendingTimer.Stop()
+		rw.pendingTimer = nil
+	}
+	rw.pendingMu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	// The resource IDs of different objects don't overlap, so their metadata can be merged.
+	oldMetadata := map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{}
+	newMetadata := map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{}
+	for _, update := range pending {
+		for id, md := range update.oldMetadata {
+			oldMetadata[id] = md
+		}
+		for id, md := range update.newMetadata {
+			newMetadata[id] = md
+		}
+	}
+	rw.syncMetadataUpdate(oldMetadata, newMetadata)
 }
 
 // objMetadata returns the metadata for the given object.
-func (rw *resourceWatcher) objMetadata(obj any) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
-	switch o := obj.(type) {
-	case *corev1.Pod:
-		return pod.GetMetadata(o, rw.metadataStore, rw.logger)
-	case *corev1.Node:
-		return node.GetMetadata(o)
-	case *corev1.ReplicationController:
-		return replicationcontroller.GetMetadata(o)
-	case *appsv1.Deployment:
-		return deployment.GetMetadata(o)
-	case *appsv1.ReplicaSet:
-		return replicaset.GetMetadata(o)
-	case *appsv1.DaemonSet:
-		return demonset.GetMetadata(o)
-	case *appsv1.StatefulSet:
-		return statefulset.GetMetadata(o)
-	case *batchv1.Job:
-		return jobs.GetMetadata(o)
-	case *batchv1.CronJob:
-		return cronjob.GetMetadata(o)
-	case *autoscalingv2.HorizontalPodAutoscaler:
-		return hpa.GetMetadata(o)
+func (rw *resourceWatcher) objMetadata(kind schema.GroupVersionKind, obj any) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	if !rw.metadataStore.Accepts(obj) {
+		return nil
 	}
-	return nil
+	k, ok := rw.kinds.Kind(kind)
+	if !ok || k.Metadata == nil {
+		return nil
+	}
+	md := k.Metadata(obj, rw.metadataStore, rw.logger)
+
+	if om, ok := obj.(metav1.Object); ok {
+		if km, ok := md[experimentalmetricmetadata.ResourceID(om.GetUID())]; ok {
+			metadata.AddLabelsAndAnnotations(km, om, rw.config.MetadataLabels, rw.config.MetadataAnnotations)
+		}
+	}
+	return md
 }
 
 func (rw *resourceWatcher) waitForInitialInformerSync() {
@@ -366,26 +838,50 @@ func (rw *resourceWatcher) syncMetadataUpdate(oldMetadata, newMetadata map[exper
 
 	if rw.entityLogConsumer != nil {
 		// Represent metadata update as entity events.
-		entityEvents := metadata.GetEntityEvents(oldMetadata, newMetadata, timestamp)
+		rw.consumeEntityEvents(metadata.GetEntityEvents(oldMetadata, newMetadata, timestamp))
+	}
+}
 
-		// Convert entity events to log representation.
-		logs := entityEvents.ConvertAndMoveToLogs()
+// syncEntityStates sends the state of all watched objects as entity events, whether they changed
+// or not. Object updates only produce entity events for changed objects, so this is done every
+// metadata_collection_interval to re-deliver the state of the objects.
+func (rw *resourceWatcher) syncEntityStates() {
+	if rw.entityLogConsumer == nil {
+		return
+	}
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
 
-		if logs.LogRecordCount() != 0 {
-			err := rw.entityLogConsumer.ConsumeLogs(context.Background(), logs)
-			if err != nil {
-				rw.logger.Error("Error sending entity events to the consumer", zap.Error(err))
-
-				// Note: receiver contract says that we need to retry sending if the
-				// returned error is not Permanent. However, we are not doing it here.
-				// Instead, we rely on the fact the metadata is collected periodically
-				// and the entity events will be delivered on the next cycle. This is
-				// fine because we deliver cumulative entity state.
-				// This allows us to avoid stressing the Collector or its destination
-				// unnecessarily (typically non-Permanent errors happen in stressed conditions).
-				// The periodic collection will be implemented later, see
-				// https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/24413
+	// The resource IDs of different objects don't overlap, so their metadata can be merged.
+	md := map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{}
+	for kind := range rw.informersSynced {
+		rw.metadataStore.ForEach(kind, func(o any) {
+			for id, km := range rw.objMetadata(kind, o) {
+				md[id] = km
 			}
+		})
+	}
+	rw.consumeEntityEvents(metadata.GetEntityEvents(nil, md, timestamp))
+}
+
+// consumeEntityEvents sends the entity events to the entity log consumer.
+func (rw *resourceWatcher) consumeEntityEvents(entityEvents experimentalmetricmetadata.EntityEventsSlice) {
+	// Convert entity events to log representation.
+	logs := entityEvents.ConvertAndMoveToLogs()
+
+	if logs.LogRecordCount() != 0 {
+		err := rw.entityLogConsumer.ConsumeLogs(context.Background(), logs)
+		if err != nil {
+			rw.logger.Error("Error sending entity events to the consumer", zap.Error(err))
+
+			// Note: receiver contract says that we need to retry sending if the
+			// returned error is not Permanent. However, we are not doing it here.
+			// Instead, we rely on the fact the state of all objects is sent every
+			// metadata_collection_interval by syncEntityStates, so the entity events
+			// will be delivered on the next cycle. This is fine because we deliver
+			// cumulative entity state. Delete events are not re-delivered, the
+			// deleted objects are simply missing from the next cycle.
+			// This allows us to avoid stressing the Collector or its destination
+			// unnecessarily (typically non-Permanent errors happen in stressed conditions).
 		}
 	}
 }
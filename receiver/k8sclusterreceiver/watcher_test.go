@@ -4,9 +4,15 @@
 package k8sclusterreceiver
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	fakeQuota "github.com/openshift/client-go/quota/clientset/versioned/fake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -16,14 +22,23 @@ import (
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
@@ -35,6 +50,12 @@ var commonPodMetadata = map[string]string{
 	"pod.creation_timestamp": "0001-01-01T00:00:00Z",
 }
 
+// newTestKinds returns a registry of the built-in kinds.
+func newTestKinds() *collection.DataCollector {
+	return collection.NewDataCollector(receivertest.NewNopCreateSettings(), metadata.NewStore(),
+		metadata.DefaultMetricsBuilderConfig(), nil, nil, nil)
+}
+
 func TestSetupMetadataExporters(t *testing.T) {
 	type fields struct {
 		metadataConsumers []metadataConsumer
@@ -88,6 +109,7 @@ func TestSetupMetadataExporters(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rw := &resourceWatcher{
+				kinds:  newTestKinds(),
 				logger: zap.NewNop(),
 			}
 			if err := rw.setupMetadataExporters(tt.args.exporters, tt.args.metadataExportersFromConfig); (err != nil) != tt.wantErr {
@@ -122,6 +144,7 @@ func TestIsKindSupported(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rw := &resourceWatcher{
+				kinds:  newTestKinds(),
 				client: tt.client,
 				logger: zap.NewNop(),
 			}
@@ -155,6 +178,9 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 							gvkToAPIResource(gvk.ReplicationController),
 							gvkToAPIResource(gvk.ResourceQuota),
 							gvkToAPIResource(gvk.Service),
+							gvkToAPIResource(gvk.PersistentVolume),
+							gvkToAPIResource(gvk.PersistentVolumeClaim),
+							gvkToAPIResource(gvk.LimitRange),
 						},
 					},
 					{
@@ -172,12 +198,36 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 							gvkToAPIResource(gvk.Job),
 						},
 					},
+					{
+						GroupVersion: "batch/v1beta1",
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(gvk.CronJobBeta),
+						},
+					},
 					{
 						GroupVersion: "autoscaling/v2",
 						APIResources: []metav1.APIResource{
 							gvkToAPIResource(gvk.HorizontalPodAutoscaler),
 						},
 					},
+					{
+						GroupVersion: "discovery.k8s.io/v1",
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(gvk.EndpointSlice),
+						},
+					},
+					{
+						GroupVersion: "policy/v1",
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(gvk.PodDisruptionBudget),
+						},
+					},
+					{
+						GroupVersion: "networking.k8s.io/v1",
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(gvk.Ingress),
+						},
+					},
 				}
 				return client
 			}(),
@@ -189,7 +239,8 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 			obs, logs := observer.New(zap.WarnLevel)
 			obsLogger := zap.New(obs)
 			rw := &resourceWatcher{
-				client:        newFakeClientWithAllResources(),
+				kinds:         newTestKinds(),
+				client:        tt.client,
 				logger:        obsLogger,
 				metadataStore: metadata.NewStore(),
 				config:        &Config{},
@@ -203,16 +254,743 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 	}
 }
 
+func TestPrepareSharedInformerFactoryNamespace(t *testing.T) {
+	podA := testutils.NewPodWithContainer("a", &corev1.PodSpec{}, &corev1.PodStatus{})
+	podA.Namespace = "team-a"
+	podB := testutils.NewPodWithContainer("b", &corev1.PodSpec{}, &corev1.PodStatus{})
+	podB.Namespace = "team-b"
+	client := fake.NewSimpleClientset(podA, podB)
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				gvkToAPIResource(gvk.Pod),
+				gvkToAPIResource(gvk.Node),
+				gvkToAPIResource(gvk.Namespace),
+				gvkToAPIResource(gvk.PersistentVolume),
+			},
+		},
+	}
+	obs, logs := observer.New(zap.InfoLevel)
+	cfg := &Config{Namespace: "team-a", MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.MetricsBuilderConfig.Metrics.K8sPersistentvolumePhase.Enabled = true
+	rw := &resourceWatcher{
+		kinds:         newTestKinds(),
+		client:        client,
+		logger:        zap.New(obs),
+		metadataStore: metadata.NewStore(),
+		config:        cfg,
+	}
+
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	assert.Equal(t, 1, logs.FilterMessage("Watching a single namespace, cluster-scoped kinds are not collected").Len())
+	assert.Nil(t, rw.metadataStore.Get(gvk.Node))
+	assert.Nil(t, rw.metadataStore.Get(gvk.Namespace))
+	assert.Nil(t, rw.metadataStore.Get(gvk.PersistentVolume))
+	require.NotNil(t, rw.metadataStore.Get(gvk.Pod))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for _, factory := range rw.informerFactories {
+		factory.Start(ctx.Done())
+		factory.WaitForCacheSync(ctx.Done())
+	}
+
+	var namespaces []string
+	rw.metadataStore.ForEach(gvk.Pod, func(o any) {
+		namespaces = append(namespaces, o.(*corev1.Pod).Namespace)
+	})
+	assert.Equal(t, []string{"team-a"}, namespaces)
+}
+
+func TestPrepareSharedInformerFactoryCollectedKinds(t *testing.T) {
+	widget := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	tests := []struct {
+		collectedKinds []string
+		expected       []schema.GroupVersionKind
+	}{
+		{
+			collectedKinds: []string{"pod"},
+			expected:       []schema.GroupVersionKind{gvk.Pod},
+		},
+		{
+			collectedKinds: []string{"node", "pod"},
+			expected:       []schema.GroupVersionKind{gvk.Node, gvk.Pod},
+		},
+		{
+			collectedKinds: []string{"secret", "storageclass", "lease", "verticalpodautoscaler", "widget"},
+			expected:       []schema.GroupVersionKind{gvk.Secret, gvk.StorageClass, gvk.Lease, gvk.VerticalPodAutoscaler, widget},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.collectedKinds, ","), func(t *testing.T) {
+			client := newFakeClientWithAllResources()
+			client.Resources[0].APIResources = append(client.Resources[0].APIResources,
+				gvkToAPIResource(gvk.Endpoints),
+				gvkToAPIResource(gvk.Secret),
+				gvkToAPIResource(gvk.ServiceAccount),
+			)
+			for _, kind := range []schema.GroupVersionKind{gvk.PriorityClass, gvk.StorageClass, gvk.Lease} {
+				client.Resources = append(client.Resources, &metav1.APIResourceList{
+					GroupVersion: kind.GroupVersion().String(),
+					APIResources: []metav1.APIResource{gvkToAPIResource(kind)},
+				})
+			}
+			client.Resources = append(client.Resources,
+				&metav1.APIResourceList{
+					GroupVersion: gvk.VerticalPodAutoscaler.GroupVersion().String(),
+					APIResources: []metav1.APIResource{{Name: "verticalpodautoscalers", Kind: gvk.VerticalPodAutoscaler.Kind, Namespaced: true}},
+				},
+				&metav1.APIResourceList{
+					GroupVersion: gvk.DeploymentConfig.GroupVersion().String(),
+					APIResources: []metav1.APIResource{{Name: "deploymentconfigs", Kind: gvk.DeploymentConfig.Kind, Namespaced: true}},
+				},
+				&metav1.APIResourceList{
+					GroupVersion: widget.GroupVersion().String(),
+					APIResources: []metav1.APIResource{{Name: "widgets", Kind: widget.Kind, Namespaced: true}},
+				},
+			)
+
+			// All the metrics of the kinds that are only watched when their metrics are enabled.
+			mbc := metadata.DefaultMetricsBuilderConfig()
+			for _, m := range []*metadata.MetricConfig{
+				&mbc.Metrics.K8sPersistentvolumePhase,
+				&mbc.Metrics.K8sPersistentvolumeclaimPhase,
+				&mbc.Metrics.K8sEndpointsliceAddressCount,
+				&mbc.Metrics.K8sEndpointsAddressCount,
+				&mbc.Metrics.K8sLimitrangeMax,
+				&mbc.Metrics.K8sPdbCurrentHealthy,
+				&mbc.Metrics.K8sIngressRuleCount,
+				&mbc.Metrics.K8sNamespaceSecretCount,
+				&mbc.Metrics.K8sNamespaceServiceaccountCount,
+				&mbc.Metrics.K8sPriorityclassValue,
+				&mbc.Metrics.K8sStorageclassInfo,
+				&mbc.Metrics.K8sNodeLeaseRenewAge,
+				&mbc.Metrics.K8sVpaTargetCPU,
+				&mbc.Metrics.OpenshiftDeploymentconfigReady,
+			} {
+				m.Enabled = true
+			}
+			dc := newTestKinds()
+			rw := &resourceWatcher{
+				openShiftClients: openShiftClients{osQuotaClient: fakeQuota.NewSimpleClientset()},
+				kinds:            dc,
+				client:           client,
+				dynamicClient:    dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+				logger:           zap.NewNop(),
+				metadataStore:    metadata.NewStore(),
+				config: &Config{
+					Distribution:         distributionOpenShift,
+					MetricsBuilderConfig: mbc,
+					CollectedKinds:       tt.collectedKinds,
+					CustomResources:      []CustomResourceConfig{{Group: widget.Group, Version: widget.Version, Kind: widget.Kind}},
+				},
+			}
+			rw.registerOpenShiftKinds(dc)
+
+			require.NoError(t, rw.prepareSharedInformerFactory())
+			var kinds []schema.GroupVersionKind
+			for kind := range rw.informersSynced {
+				kinds = append(kinds, kind)
+			}
+			assert.ElementsMatch(t, tt.expected, kinds)
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryPrefersNewestVersion(t *testing.T) {
+	var tests = []struct {
+		name        string
+		hpaVersions []string
+		expected    schema.GroupVersionKind
+		notExpected schema.GroupVersionKind
+	}{
+		{
+			name:        "v2_and_v2beta2",
+			hpaVersions: []string{"autoscaling/v2", "autoscaling/v2beta2"},
+			expected:    gvk.HorizontalPodAutoscaler,
+			notExpected: gvk.HorizontalPodAutoscalerBeta,
+		},
+		{
+			name:        "v2beta2_only",
+			hpaVersions: []string{"autoscaling/v2beta2"},
+			expected:    gvk.HorizontalPodAutoscalerBeta,
+			notExpected: gvk.HorizontalPodAutoscaler,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			for _, gv := range tt.hpaVersions {
+				client.Resources = append(client.Resources, &metav1.APIResourceList{
+					GroupVersion: gv,
+					APIResources: []metav1.APIResource{
+						{Kind: "HorizontalPodAutoscaler"},
+					},
+				})
+			}
+			rw := &resourceWatcher{
+				kinds:         newTestKinds(),
+				client:        client,
+				logger:        zap.NewNop(),
+				metadataStore: metadata.NewStore(),
+				config:        &Config{},
+			}
+
+			assert.NoError(t, rw.prepareSharedInformerFactory())
+			assert.NotNil(t, rw.metadataStore.Get(tt.expected))
+			assert.Nil(t, rw.metadataStore.Get(tt.notExpected))
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryEndpointsFallback(t *testing.T) {
+	for _, served := range []bool{false, true} {
+		t.Run(fmt.Sprintf("endpointslices_served=%v", served), func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						gvkToAPIResource(gvk.Endpoints),
+					},
+				},
+			}
+			if served {
+				client.Resources = append(client.Resources, &metav1.APIResourceList{
+					GroupVersion: "discovery.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						gvkToAPIResource(gvk.EndpointSlice),
+					},
+				})
+			}
+			cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+			cfg.MetricsBuilderConfig.Metrics.K8sEndpointsliceAddressCount.Enabled = true
+			cfg.MetricsBuilderConfig.Metrics.K8sEndpointsAddressCount.Enabled = true
+			rw := &resourceWatcher{
+				kinds:         newTestKinds(),
+				client:        client,
+				logger:        zap.NewNop(),
+				metadataStore: metadata.NewStore(),
+				config:        cfg,
+			}
+
+			assert.NoError(t, rw.prepareSharedInformerFactory())
+			assert.Equal(t, served, rw.metadataStore.Get(gvk.EndpointSlice) != nil)
+			assert.Equal(t, !served, rw.metadataStore.Get(gvk.Endpoints) != nil)
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryEndpointsNoFallbackWhenDisabled(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				gvkToAPIResource(gvk.Endpoints),
+			},
+		},
+		{
+			GroupVersion: "discovery.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				gvkToAPIResource(gvk.EndpointSlice),
+			},
+		},
+	}
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.MetricsBuilderConfig.Metrics.K8sEndpointsAddressCount.Enabled = true
+	rw := &resourceWatcher{
+		kinds:         newTestKinds(),
+		client:        client,
+		logger:        zap.NewNop(),
+		metadataStore: metadata.NewStore(),
+		config:        cfg,
+	}
+
+	// Endpoints are only a fallback for clusters that don't serve EndpointSlices.
+	assert.NoError(t, rw.prepareSharedInformerFactory())
+	assert.Nil(t, rw.metadataStore.Get(gvk.EndpointSlice))
+	assert.Nil(t, rw.metadataStore.Get(gvk.Endpoints))
+}
+
+func TestWatchErrorHandlerForbidden(t *testing.T) {
+	obs, logs := observer.New(zap.ErrorLevel)
+	rw := &resourceWatcher{logger: zap.New(obs)}
+
+	handler := rw.watchErrorHandler(gvk.Pod)
+	handler(nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New("denied")))
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Contains(t, entry.Message, "check the RBAC permissions")
+	assert.Equal(t, gvk.Pod.String(), entry.ContextMap()["kind"])
+}
+
+func TestStartWatchingResourcesForbidden(t *testing.T) {
+	client := newFakeClientWithAllResources()
+	client.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New("denied"))
+	})
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		InitialSyncTimeout:   time.Hour,
+	}, metadata.NewStore(), newTestKinds())
+	rw.makeClient = func(k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return client, nil
+	}
+	require.NoError(t, rw.initialize())
+
+	// The initial sync fails as soon as the kind is forbidden, long before it times out.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := rw.startWatchingResources(ctx)
+	require.ErrorContains(t, err, "not allowed to list or watch /v1, Kind=Pod")
+	assert.NoError(t, ctx.Err())
+}
+
+func TestPrepareSharedInformerFactoryClusterResourceQuota(t *testing.T) {
+	for _, served := range []bool{false, true} {
+		t.Run(fmt.Sprintf("served=%v", served), func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			if served {
+				client.Resources = []*metav1.APIResourceList{
+					{
+						GroupVersion: "quota.openshift.io/v1",
+						APIResources: []metav1.APIResource{
+							{Kind: "ClusterResourceQuota"},
+						},
+					},
+				}
+			}
+			dc := newTestKinds()
+			rw := &resourceWatcher{
+				openShiftClients: openShiftClients{osQuotaClient: fakeQuota.NewSimpleClientset()},
+				kinds:            dc,
+				client:           client,
+				logger:           zap.NewNop(),
+				metadataStore:    metadata.NewStore(),
+				config:           &Config{},
+			}
+			rw.registerOpenShiftKinds(dc)
+
+			assert.NoError(t, rw.prepareSharedInformerFactory())
+			assert.Equal(t, served, rw.metadataStore.Get(gvk.ClusterResourceQuota) != nil)
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryCustomResources(t *testing.T) {
+	certificate := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+	issuer := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Issuer"}
+	client := fake.NewSimpleClientset()
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "cert-manager.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "certificates/status", Kind: "Certificate"},
+				{Name: "certificates", Kind: "Certificate"},
+			},
+		},
+	}
+	cert := &unstructured.Unstructured{}
+	cert.SetAPIVersion("cert-manager.io/v1")
+	cert.SetKind("Certificate")
+	cert.SetNamespace("default")
+	cert.SetName("my-cert")
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			certificate.GroupVersion().WithResource("certificates"): "CertificateList",
+		}, cert)
+	initialSyncDone := &atomic.Bool{}
+	initialSyncDone.Store(true)
+	rw := &resourceWatcher{
+		kinds:               newTestKinds(),
+		client:              client,
+		dynamicClient:       dynamicClient,
+		logger:              zap.NewNop(),
+		metadataStore:       metadata.NewStore(),
+		initialSyncDone:     initialSyncDone,
+		initialSyncTimedOut: &atomic.Bool{},
+		config: &Config{
+			CustomResources: []CustomResourceConfig{
+				{Group: certificate.Group, Version: certificate.Version, Kind: certificate.Kind},
+				{Group: issuer.Group, Version: issuer.Version, Kind: issuer.Kind},
+			},
+		},
+	}
+
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	assert.Nil(t, rw.metadataStore.Get(issuer))
+	require.NotNil(t, rw.metadataStore.Get(certificate))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dynamicFactory := rw.informerFactories[len(rw.informerFactories)-1]
+	dynamicFactory.Start(ctx.Done())
+	dynamicFactory.WaitForCacheSync(ctx.Done())
+
+	var names []string
+	rw.metadataStore.ForEach(certificate, func(o any) {
+		names = append(names, o.(*unstructured.Unstructured).GetName())
+	})
+	assert.Equal(t, []string{"my-cert"}, names)
+}
+
+func TestPrepareSharedInformerFactoryVerticalPodAutoscalers(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "autoscaling.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "verticalpodautoscalers", Kind: "VerticalPodAutoscaler"},
+			},
+		},
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			gvk.VerticalPodAutoscaler.GroupVersion().WithResource("verticalpodautoscalers"): "VerticalPodAutoscalerList",
+		}, testutils.NewVPA("1"))
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sVpaTargetCPU.Enabled = true
+	initialSyncDone := &atomic.Bool{}
+	initialSyncDone.Store(true)
+	rw := &resourceWatcher{
+		kinds:               newTestKinds(),
+		client:              client,
+		dynamicClient:       dynamicClient,
+		logger:              zap.NewNop(),
+		metadataStore:       metadata.NewStore(),
+		initialSyncDone:     initialSyncDone,
+		initialSyncTimedOut: &atomic.Bool{},
+		config:              &Config{MetricsBuilderConfig: mbc},
+	}
+
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	require.NotNil(t, rw.metadataStore.Get(gvk.VerticalPodAutoscaler))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dynamicFactory := rw.informerFactories[len(rw.informerFactories)-1]
+	dynamicFactory.Start(ctx.Done())
+	dynamicFactory.WaitForCacheSync(ctx.Done())
+
+	var names []string
+	rw.metadataStore.ForEach(gvk.VerticalPodAutoscaler, func(o any) {
+		names = append(names, o.(*unstructured.Unstructured).GetName())
+	})
+	assert.Equal(t, []string{"test-vpa-1"}, names)
+}
+
+func TestPrepareSharedInformerFactoryDeploymentConfigs(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		client := fake.NewSimpleClientset()
+		client.Resources = []*metav1.APIResourceList{
+			{
+				GroupVersion: "apps.openshift.io/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "deploymentconfigs", Kind: "DeploymentConfig", Namespaced: true},
+				},
+			},
+		}
+		dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{
+				gvk.DeploymentConfig.GroupVersion().WithResource("deploymentconfigs"): "DeploymentConfigList",
+			}, testutils.NewDeploymentConfig("1"))
+		mbc := metadata.DefaultMetricsBuilderConfig()
+		mbc.Metrics.OpenshiftDeploymentconfigAvailable.Enabled = enabled
+		initialSyncDone := &atomic.Bool{}
+		initialSyncDone.Store(true)
+		dc := newTestKinds()
+		rw := &resourceWatcher{
+			kinds:               dc,
+			client:              client,
+			dynamicClient:       dynamicClient,
+			logger:              zap.NewNop(),
+			metadataStore:       metadata.NewStore(),
+			initialSyncDone:     initialSyncDone,
+			initialSyncTimedOut: &atomic.Bool{},
+			config:              &Config{MetricsBuilderConfig: mbc, Distribution: distributionOpenShift},
+		}
+		rw.registerOpenShiftKinds(dc)
+		assert.Equal(t, enabled, rw.dynamicExtensionKindsEnabled())
+
+		require.NoError(t, rw.prepareSharedInformerFactory())
+		if !enabled {
+			assert.Nil(t, rw.metadataStore.Get(gvk.DeploymentConfig))
+			continue
+		}
+		require.NotNil(t, rw.metadataStore.Get(gvk.DeploymentConfig))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		dynamicFactory := rw.informerFactories[len(rw.informerFactories)-1]
+		dynamicFactory.Start(ctx.Done())
+		dynamicFactory.WaitForCacheSync(ctx.Done())
+
+		var names []string
+		rw.metadataStore.ForEach(gvk.DeploymentConfig, func(o any) {
+			names = append(names, o.(*unstructured.Unstructured).GetName())
+		})
+		assert.Equal(t, []string{"test-deploymentconfig-1"}, names)
+		cancel()
+	}
+}
+
+func TestOpenShiftKindsRequireOpenShift(t *testing.T) {
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.OpenshiftDeploymentconfigDesired.Enabled = true
+	c := newCluster(receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc, Distribution: distributionKubernetes}, "")
+	assert.Empty(t, c.resourceWatcher.extensionKinds)
+	assert.False(t, c.resourceWatcher.dynamicExtensionKindsEnabled())
+	_, ok := c.dataCollector.Kind(gvk.DeploymentConfig)
+	assert.False(t, ok)
+
+	c = newCluster(receivertest.NewNopCreateSettings(), &Config{MetricsBuilderConfig: mbc, Distribution: distributionOpenShift}, "")
+	assert.True(t, c.resourceWatcher.dynamicExtensionKindsEnabled())
+	for _, kind := range []schema.GroupVersionKind{gvk.ClusterResourceQuota, gvk.DeploymentConfig} {
+		_, ok = c.dataCollector.Kind(kind)
+		assert.True(t, ok)
+	}
+}
+
+func TestPrepareSharedInformerFactoryNodeLeases(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("enabled=%v", enabled), func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "coordination.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						{Kind: "Lease"},
+					},
+				},
+			}
+			cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+			cfg.MetricsBuilderConfig.Metrics.K8sNodeLeaseRenewAge.Enabled = enabled
+			rw := &resourceWatcher{
+				kinds:         newTestKinds(),
+				client:        client,
+				logger:        zap.NewNop(),
+				metadataStore: metadata.NewStore(),
+				config:        cfg,
+			}
+
+			assert.NoError(t, rw.prepareSharedInformerFactory())
+			assert.Equal(t, enabled, rw.metadataStore.Get(gvk.Lease) != nil)
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryPriorityClasses(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("enabled=%v", enabled), func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "scheduling.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						gvkToAPIResource(gvk.PriorityClass),
+					},
+				},
+			}
+			cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+			cfg.MetricsBuilderConfig.Metrics.K8sPriorityclassValue.Enabled = enabled
+			rw := &resourceWatcher{
+				kinds:         newTestKinds(),
+				client:        client,
+				logger:        zap.NewNop(),
+				metadataStore: metadata.NewStore(),
+				config:        cfg,
+			}
+
+			assert.NoError(t, rw.prepareSharedInformerFactory())
+			assert.Equal(t, enabled, rw.metadataStore.Get(gvk.PriorityClass) != nil)
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryStorageClasses(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("enabled=%v", enabled), func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "storage.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						gvkToAPIResource(gvk.StorageClass),
+					},
+				},
+			}
+			cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+			cfg.MetricsBuilderConfig.Metrics.K8sStorageclassInfo.Enabled = enabled
+			rw := &resourceWatcher{
+				kinds:         newTestKinds(),
+				client:        client,
+				logger:        zap.NewNop(),
+				metadataStore: metadata.NewStore(),
+				config:        cfg,
+			}
+
+			assert.NoError(t, rw.prepareSharedInformerFactory())
+			assert.Equal(t, enabled, rw.metadataStore.Get(gvk.StorageClass) != nil)
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryOptInKinds(t *testing.T) {
+	tests := []struct {
+		kind   schema.GroupVersionKind
+		enable func(metrics *metadata.MetricsConfig, enabled bool)
+	}{
+		{
+			kind: gvk.PersistentVolume,
+			enable: func(metrics *metadata.MetricsConfig, enabled bool) {
+				metrics.K8sPersistentvolumePhase.Enabled = enabled
+			},
+		},
+		{
+			kind: gvk.PersistentVolumeClaim,
+			enable: func(metrics *metadata.MetricsConfig, enabled bool) {
+				metrics.K8sPersistentvolumeclaimActualCapacity.Enabled = enabled
+			},
+		},
+		{
+			kind: gvk.EndpointSlice,
+			enable: func(metrics *metadata.MetricsConfig, enabled bool) {
+				metrics.K8sEndpointsliceReadyCount.Enabled = enabled
+			},
+		},
+		{
+			kind: gvk.Endpoints,
+			enable: func(metrics *metadata.MetricsConfig, enabled bool) {
+				metrics.K8sEndpointsNotReadyAddressCount.Enabled = enabled
+			},
+		},
+		{
+			kind: gvk.LimitRange,
+			enable: func(metrics *metadata.MetricsConfig, enabled bool) {
+				metrics.K8sLimitrangeMax.Enabled = enabled
+			},
+		},
+		{
+			kind: gvk.PodDisruptionBudget,
+			enable: func(metrics *metadata.MetricsConfig, enabled bool) {
+				metrics.K8sPdbDisruptionsAllowed.Enabled = enabled
+			},
+		},
+		{
+			kind: gvk.Ingress,
+			enable: func(metrics *metadata.MetricsConfig, enabled bool) {
+				metrics.K8sIngressTLSCount.Enabled = enabled
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, enabled := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/enabled=%v", tt.kind.Kind, enabled), func(t *testing.T) {
+				client := fake.NewSimpleClientset()
+				client.Resources = []*metav1.APIResourceList{
+					{
+						GroupVersion: tt.kind.GroupVersion().String(),
+						APIResources: []metav1.APIResource{
+							gvkToAPIResource(tt.kind),
+						},
+					},
+				}
+				obs, logs := observer.New(zap.WarnLevel)
+				cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+				tt.enable(&cfg.MetricsBuilderConfig.Metrics, enabled)
+				rw := &resourceWatcher{
+					kinds:         newTestKinds(),
+					client:        client,
+					logger:        zap.New(obs),
+					metadataStore: metadata.NewStore(),
+					config:        cfg,
+				}
+
+				assert.NoError(t, rw.prepareSharedInformerFactory())
+				assert.Equal(t, enabled, rw.metadataStore.Get(tt.kind) != nil)
+				// A kind that is not watched because its metrics are disabled is not reported as unsupported.
+				assert.Equal(t, 0, logs.FilterField(zap.String("kind", tt.kind.Kind)).Len())
+			})
+		}
+	}
+}
+
+func TestPrepareSharedInformerFactorySecretsAndServiceAccounts(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("enabled=%v", enabled), func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						gvkToAPIResource(gvk.Secret),
+						gvkToAPIResource(gvk.ServiceAccount),
+					},
+				},
+			}
+			cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+			cfg.MetricsBuilderConfig.Metrics.K8sNamespaceSecretCount.Enabled = enabled
+			cfg.MetricsBuilderConfig.Metrics.K8sNamespaceServiceaccountCount.Enabled = enabled
+			rw := &resourceWatcher{
+				kinds:         newTestKinds(),
+				client:        client,
+				logger:        zap.NewNop(),
+				metadataStore: metadata.NewStore(),
+				config:        cfg,
+			}
+
+			assert.NoError(t, rw.prepareSharedInformerFactory())
+			assert.Equal(t, enabled, rw.metadataStore.Get(gvk.Secret) != nil)
+			assert.Equal(t, enabled, rw.metadataStore.Get(gvk.ServiceAccount) != nil)
+		})
+	}
+}
+
+func TestPrepareSharedInformerFactoryLabelSelectors(t *testing.T) {
+	client := newFakeClientWithAllResources()
+	for _, p := range []*corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "monitored", Namespace: "test", UID: "pod-monitored", Labels: map[string]string{"monitoring": "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test", UID: "pod-other"}},
+	} {
+		_, err := client.CoreV1().Pods(p.Namespace).Create(context.Background(), p, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	rw := &resourceWatcher{
+		kinds:         newTestKinds(),
+		client:        client,
+		logger:        zap.NewNop(),
+		metadataStore: metadata.NewStore(),
+		config:        &Config{LabelSelectors: map[string]string{"pod": "monitoring=true"}},
+	}
+
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	// The pod informer is set up with a dedicated factory.
+	assert.Len(t, rw.informerFactories, 2)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	for _, factory := range rw.informerFactories {
+		factory.Start(stopCh)
+		factory.WaitForCacheSync(stopCh)
+	}
+
+	keys := rw.metadataStore.Get(gvk.Pod).ListKeys()
+	assert.Equal(t, []string{"test/monitored"}, keys)
+}
+
 func TestSetupInformerForKind(t *testing.T) {
 	obs, logs := observer.New(zap.WarnLevel)
 	obsLogger := zap.New(obs)
 	rw := &resourceWatcher{
+		kinds:  newTestKinds(),
 		client: newFakeClientWithAllResources(),
 		logger: obsLogger,
 	}
 
 	factory := informers.NewSharedInformerFactoryWithOptions(rw.client, 0)
-	rw.setupInformerForKind(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "WrongKind"}, factory)
+	rw.setupInformerForKind(schema.GroupVersionKind{Group: "", Version: "v1", Kind: "WrongKind"}, collection.InformerFactories{Kubernetes: factory})
 
 	assert.Equal(t, 1, logs.Len())
 	assert.Equal(t, "Could not setup an informer for provided group version kind", logs.All()[0].Entry.Message)
@@ -229,7 +1007,7 @@ func TestSyncMetadataAndEmitEntityEvents(t *testing.T) {
 	origPod := pods[0]
 	updatedPod := getUpdatedPod(origPod)
 
-	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{}, metadata.NewStore())
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{}, metadata.NewStore(), newTestKinds())
 	rw.entityLogConsumer = logsConsumer
 
 	step1 := time.Now()
@@ -238,29 +1016,28 @@ func TestSyncMetadataAndEmitEntityEvents(t *testing.T) {
 	// as a log record.
 
 	// Pod is created.
-	rw.syncMetadataUpdate(nil, rw.objMetadata(origPod))
+	rw.syncMetadataUpdate(nil, rw.objMetadata(gvk.Pod, origPod))
 	step2 := time.Now()
 
 	// Pod is updated.
-	rw.syncMetadataUpdate(rw.objMetadata(origPod), rw.objMetadata(updatedPod))
+	rw.syncMetadataUpdate(rw.objMetadata(gvk.Pod, origPod), rw.objMetadata(gvk.Pod, updatedPod))
 	step3 := time.Now()
 
 	// Pod is updated again, but nothing changed in the pod.
-	// Should still result in entity event because they are emitted even
-	// if the entity is not changed.
-	rw.syncMetadataUpdate(rw.objMetadata(updatedPod), rw.objMetadata(updatedPod))
+	// Should not result in an entity event because the entity is not changed.
+	rw.syncMetadataUpdate(rw.objMetadata(gvk.Pod, updatedPod), rw.objMetadata(gvk.Pod, updatedPod))
 	step4 := time.Now()
 
 	// Change pod's state back to original
-	rw.syncMetadataUpdate(rw.objMetadata(updatedPod), rw.objMetadata(origPod))
+	rw.syncMetadataUpdate(rw.objMetadata(gvk.Pod, updatedPod), rw.objMetadata(gvk.Pod, origPod))
 	step5 := time.Now()
 
 	// Delete the pod
-	rw.syncMetadataUpdate(rw.objMetadata(origPod), nil)
+	rw.syncMetadataUpdate(rw.objMetadata(gvk.Pod, origPod), nil)
 	step6 := time.Now()
 
-	// Must have 5 entity events.
-	require.EqualValues(t, 5, logsConsumer.LogRecordCount())
+	// Must have 4 entity events.
+	require.EqualValues(t, 4, logsConsumer.LogRecordCount())
 
 	// Event 1 should contain the initial state of the pod.
 	lr := logsConsumer.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
@@ -280,20 +1057,16 @@ func TestSyncMetadataAndEmitEntityEvents(t *testing.T) {
 	assert.EqualValues(t, expected, lr.Attributes().AsRaw())
 	assert.WithinRange(t, lr.Timestamp().AsTime(), step2, step3)
 
-	// Event 3 should be identical to the previous one since pod state didn't change.
+	// Event 3 should contain the reverted state of the pod. No event was emitted
+	// for the update that didn't change the pod.
 	lr = logsConsumer.AllLogs()[2].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
-	assert.EqualValues(t, expected, lr.Attributes().AsRaw())
-	assert.WithinRange(t, lr.Timestamp().AsTime(), step3, step4)
-
-	// Event 4 should contain the reverted state of the pod.
-	lr = logsConsumer.AllLogs()[3].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
 	attrs = expected["otel.entity.attributes"].(map[string]any)
 	delete(attrs, "key")
 	assert.EqualValues(t, expected, lr.Attributes().AsRaw())
 	assert.WithinRange(t, lr.Timestamp().AsTime(), step4, step5)
 
-	// Event 5 should indicate pod deletion.
-	lr = logsConsumer.AllLogs()[4].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	// Event 4 should indicate pod deletion.
+	lr = logsConsumer.AllLogs()[3].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
 	expected = map[string]any{
 		"otel.entity.event.type": "entity_delete",
 		"otel.entity.id":         map[string]any{"k8s.pod.uid": "pod0"},
@@ -302,16 +1075,161 @@ func TestSyncMetadataAndEmitEntityEvents(t *testing.T) {
 	assert.WithinRange(t, lr.Timestamp().AsTime(), step5, step6)
 }
 
+func TestOnUpdateResyncIsNoop(t *testing.T) {
+	client := newFakeClientWithAllResources()
+	pods := createPods(t, client, 1)
+
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{}, metadata.NewStore(), newTestKinds())
+	rw.initialSyncDone.Store(true)
+	logsConsumer := new(consumertest.LogsSink)
+	rw.entityLogConsumer = logsConsumer
+	var updates int
+	rw.metadataConsumers = []metadataConsumer{func([]*experimentalmetricmetadata.MetadataUpdate) error {
+		updates++
+		return nil
+	}}
+
+	rw.onAdd(gvk.Pod, pods[0])
+	require.Equal(t, 1, updates)
+	require.Equal(t, 1, logsConsumer.LogRecordCount())
+
+	// A resync delivers the cached object as both the old and the new revision.
+	rw.onUpdate(gvk.Pod, pods[0], pods[0])
+
+	assert.Equal(t, 1, updates)
+	assert.Equal(t, 1, logsConsumer.LogRecordCount())
+}
+
+func TestOnDeleteEmitsEntityDelete(t *testing.T) {
+	client := newFakeClientWithAllResources()
+	pods := createPods(t, client, 1)
+
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{}, metadata.NewStore(), newTestKinds())
+	rw.client = client
+	rw.initialSyncDone.Store(true)
+	logsConsumer := new(consumertest.LogsSink)
+	rw.entityLogConsumer = logsConsumer
+
+	factory := informers.NewSharedInformerFactoryWithOptions(client, 0)
+	rw.setupInformerForKind(gvk.Pod, collection.InformerFactories{Kubernetes: factory})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+	require.Eventually(t, func() bool {
+		return logsConsumer.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	deletePods(t, client, 1)
+	require.Eventually(t, func() bool {
+		return logsConsumer.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	expected := map[string]any{
+		"otel.entity.event.type": "entity_delete",
+		"otel.entity.id":         map[string]any{"k8s.pod.uid": "pod0"},
+	}
+	lr := logsConsumer.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.EqualValues(t, expected, lr.Attributes().AsRaw())
+
+	// Deletions missed while the watch was disconnected are delivered with the last known state.
+	rw.onDelete(gvk.Pod, cache.DeletedFinalStateUnknown{Key: "test/0", Obj: pods[0]})
+	require.Equal(t, 3, logsConsumer.LogRecordCount())
+	lr = logsConsumer.AllLogs()[2].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.EqualValues(t, expected, lr.Attributes().AsRaw())
+}
+
+func TestSyncEntityStates(t *testing.T) {
+	client := newFakeClientWithAllResources()
+	createPods(t, client, 2)
+
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{}, metadata.NewStore(), newTestKinds())
+	rw.client = client
+	rw.initialSyncDone.Store(true)
+	logsConsumer := new(consumertest.LogsSink)
+	rw.entityLogConsumer = logsConsumer
+
+	factory := informers.NewSharedInformerFactoryWithOptions(client, 0)
+	rw.setupInformerForKind(gvk.Pod, collection.InformerFactories{Kubernetes: factory})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+	require.Eventually(t, func() bool {
+		return logsConsumer.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The state of the unchanged pods is sent again.
+	rw.syncEntityStates()
+	require.Equal(t, 4, logsConsumer.LogRecordCount())
+	lrs := logsConsumer.AllLogs()[2].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+	for i := 0; i < lrs.Len(); i++ {
+		eventType, ok := lrs.At(i).Attributes().Get("otel.entity.event.type")
+		require.True(t, ok)
+		assert.Equal(t, "entity_state", eventType.Str())
+	}
+}
+
+func TestOnUpdateDebounced(t *testing.T) {
+	client := newFakeClientWithAllResources()
+	pods := createPods(t, client, 2)
+	updatedPod := getUpdatedPod(pods[0])
+
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{MetadataDebounceInterval: 100 * time.Millisecond}, metadata.NewStore(), newTestKinds())
+	rw.initialSyncDone.Store(true)
+	logsConsumer := new(consumertest.LogsSink)
+	rw.entityLogConsumer = logsConsumer
+	var updates atomic.Int32
+	var updated atomic.Int32
+	rw.metadataConsumers = []metadataConsumer{func(mu []*experimentalmetricmetadata.MetadataUpdate) error {
+		updates.Add(1)
+		updated.Add(int32(len(mu)))
+		return nil
+	}}
+
+	// The changes of all pods within the interval are sent at once, once per pod.
+	rw.onAdd(gvk.Pod, pods[0])
+	rw.onAdd(gvk.Pod, pods[1])
+	rw.onUpdate(gvk.Pod, pods[0], updatedPod)
+	rw.onUpdate(gvk.Pod, updatedPod, updatedPod)
+	assert.Equal(t, int32(0), updates.Load())
+	assert.Equal(t, 0, logsConsumer.LogRecordCount())
+
+	require.Eventually(t, func() bool {
+		return updates.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(2), updated.Load())
+	assert.Equal(t, 2, logsConsumer.LogRecordCount())
+
+	// The coalesced update of the first pod carries its latest state.
+	lr := logsConsumer.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	var found bool
+	for i := 0; i < lr.Len(); i++ {
+		attrs := lr.At(i).Attributes().AsRaw()
+		if attrs["otel.entity.id"].(map[string]any)["k8s.pod.uid"] == string(pods[0].UID) {
+			found = true
+			assert.Equal(t, "value", attrs["otel.entity.attributes"].(map[string]any)["key"])
+		}
+	}
+	assert.True(t, found)
+
+	// Nothing is left to send.
+	rw.flushMetadataUpdates()
+	assert.Equal(t, int32(1), updates.Load())
+}
+
 func TestObjMetadata(t *testing.T) {
 	tests := []struct {
 		name          string
 		metadataStore *metadata.Store
+		kind          schema.GroupVersionKind
 		resource      any
 		want          map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata
 	}{
 		{
 			name:          "Pod and container metadata simple case",
 			metadataStore: metadata.NewStore(),
+			kind:          gvk.Pod,
 			resource: testutils.NewPodWithContainer(
 				"0",
 				testutils.NewPodSpecWithContainer("container-name"),
@@ -329,7 +1247,9 @@ func TestObjMetadata(t *testing.T) {
 					ResourceIDKey: "container.id",
 					ResourceID:    "container-id",
 					Metadata: map[string]string{
-						"container.status": "running",
+						"container.status":     "running",
+						"container.image.name": "container-image-name",
+						"container.image.tag":  "latest",
 					},
 				},
 			},
@@ -337,6 +1257,7 @@ func TestObjMetadata(t *testing.T) {
 		{
 			name:          "Pod with Owner Reference",
 			metadataStore: metadata.NewStore(),
+			kind:          gvk.Pod,
 			resource: testutils.WithOwnerReferences([]metav1.OwnerReference{
 				{
 					Kind: "StatefulSet",
@@ -380,6 +1301,7 @@ func TestObjMetadata(t *testing.T) {
 				})
 				return ms
 			}(),
+			kind: gvk.Pod,
 			resource: podWithAdditionalLabels(
 				map[string]string{"k8s-app": "my-app"},
 				testutils.NewPodWithContainer("0", &corev1.PodSpec{}, &corev1.PodStatus{}),
@@ -399,6 +1321,7 @@ func TestObjMetadata(t *testing.T) {
 		{
 			name:          "Daemonset simple case",
 			metadataStore: &metadata.Store{},
+			kind:          gvk.DaemonSet,
 			resource:      testutils.NewDaemonset("1"),
 			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
 				experimentalmetricmetadata.ResourceID("test-daemonset-1-uid"): {
@@ -416,6 +1339,7 @@ func TestObjMetadata(t *testing.T) {
 		{
 			name:          "Deployment simple case",
 			metadataStore: &metadata.Store{},
+			kind:          gvk.Deployment,
 			resource:      testutils.NewDeployment("1"),
 			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
 				experimentalmetricmetadata.ResourceID("test-deployment-1-uid"): {
@@ -434,6 +1358,7 @@ func TestObjMetadata(t *testing.T) {
 		{
 			name:          "HPA simple case",
 			metadataStore: &metadata.Store{},
+			kind:          gvk.HorizontalPodAutoscaler,
 			resource:      testutils.NewHPA("1"),
 			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
 				experimentalmetricmetadata.ResourceID("test-hpa-1-uid"): {
@@ -448,9 +1373,30 @@ func TestObjMetadata(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "Ingress simple case",
+			metadataStore: &metadata.Store{},
+			kind:          gvk.Ingress,
+			resource:      testutils.NewIngress("1"),
+			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+				experimentalmetricmetadata.ResourceID("test-ingress-1-uid"): {
+					EntityType:    "k8s.ingress",
+					ResourceIDKey: "k8s.ingress.uid",
+					ResourceID:    "test-ingress-1-uid",
+					Metadata: map[string]string{
+						"k8s.workload.kind":          "Ingress",
+						"k8s.workload.name":          "test-ingress-1",
+						"ingress.creation_timestamp": "0001-01-01T00:00:00Z",
+						"ingress_class_name":         "nginx",
+						"hosts":                      "a.example.com,b.example.com",
+					},
+				},
+			},
+		},
 		{
 			name:          "Job simple case",
 			metadataStore: &metadata.Store{},
+			kind:          gvk.Job,
 			resource:      testutils.NewJob("1"),
 			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
 				experimentalmetricmetadata.ResourceID("test-job-1-uid"): {
@@ -470,6 +1416,7 @@ func TestObjMetadata(t *testing.T) {
 		{
 			name:          "Node simple case",
 			metadataStore: &metadata.Store{},
+			kind:          gvk.Node,
 			resource:      testutils.NewNode("1"),
 			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
 				experimentalmetricmetadata.ResourceID("test-node-1-uid"): {
@@ -477,10 +1424,14 @@ func TestObjMetadata(t *testing.T) {
 					ResourceIDKey: "k8s.node.uid",
 					ResourceID:    "test-node-1-uid",
 					Metadata: map[string]string{
-						"foo":                     "bar",
-						"foo1":                    "",
-						"k8s.node.name":           "test-node-1",
-						"node.creation_timestamp": "0001-01-01T00:00:00Z",
+						"foo":                                "bar",
+						"foo1":                               "",
+						"k8s.node.name":                      "test-node-1",
+						"node.creation_timestamp":            "0001-01-01T00:00:00Z",
+						"k8s.node.kubelet_version":           "v1.25.3",
+						"k8s.node.kernel_version":            "6.4.12-arch1-1",
+						"k8s.node.os_image":                  "Ubuntu 22.04.1 LTS",
+						"k8s.node.container_runtime_version": "containerd://1.6.9",
 					},
 				},
 			},
@@ -488,6 +1439,7 @@ func TestObjMetadata(t *testing.T) {
 		{
 			name:          "ReplicaSet simple case",
 			metadataStore: &metadata.Store{},
+			kind:          gvk.ReplicaSet,
 			resource:      testutils.NewReplicaSet("1"),
 			want: map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
 				experimentalmetricmetadata.ResourceID("test-replicaset-1-uid"): {
@@ -507,6 +1459,7 @@ func TestObjMetadata(t *testing.T) {
 		{
 			name:          "ReplicationController simple case",
 			metadataStore: &metadata.Store{},
+			kind:          gvk.ReplicationController,
 			resource: &corev1.ReplicationController{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "test-replicationcontroller-1",
@@ -520,6 +1473,7 @@ func TestObjMetadata(t *testing.T) {
 					ResourceIDKey: "k8s.replicationcontroller.uid",
 					ResourceID:    "test-replicationcontroller-1-uid",
 					Metadata: map[string]string{
+						"k8s.replicationcontroller.name":           "test-replicationcontroller-1",
 						"k8s.workload.kind":                        "ReplicationController",
 						"k8s.workload.name":                        "test-replicationcontroller-1",
 						"replicationcontroller.creation_timestamp": "0001-01-01T00:00:00Z",
@@ -534,9 +1488,9 @@ func TestObjMetadata(t *testing.T) {
 		set := receivertest.NewNopCreateSettings()
 		set.TelemetrySettings.Logger = zap.New(observedLogger)
 		t.Run(tt.name, func(t *testing.T) {
-			dc := &resourceWatcher{metadataStore: tt.metadataStore}
+			dc := &resourceWatcher{metadataStore: tt.metadataStore, kinds: newTestKinds(), config: &Config{}}
 
-			actual := dc.objMetadata(tt.resource)
+			actual := dc.objMetadata(tt.kind, tt.resource)
 			require.Equal(t, len(tt.want), len(actual))
 
 			for key, item := range tt.want {
@@ -564,3 +1518,89 @@ func podWithAdditionalLabels(labels map[string]string, pod *corev1.Pod) any {
 
 	return pod
 }
+
+// BenchmarkOnUpdateWithoutDestination measures the informer update path when no metadata
+// destination is configured, which should return before computing any object metadata.
+func BenchmarkOnUpdateWithoutDestination(b *testing.B) {
+	rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{}, metadata.NewStore(), newTestKinds())
+	rw.initialSyncDone.Store(true)
+	oldPod := testutils.NewPodWithContainer(
+		"1",
+		testutils.NewPodSpecWithContainer("container-name"),
+		testutils.NewPodStatusWithContainer("container-name", "container-id"),
+	)
+	newPod := getUpdatedPod(oldPod)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rw.onUpdate(gvk.Pod, oldPod, newPod)
+	}
+}
+
+func TestObjMetadataWithLabelsAndAnnotations(t *testing.T) {
+	rw := &resourceWatcher{
+		kinds:         newTestKinds(),
+		metadataStore: metadata.NewStore(),
+		config: &Config{
+			MetadataLabels:      []string{"app", "team"},
+			MetadataAnnotations: []string{"cost.example.com/*"},
+		},
+	}
+	pod := testutils.NewPodWithContainer(
+		"0",
+		testutils.NewPodSpecWithContainer("container-name"),
+		testutils.NewPodStatusWithContainer("container-name", "container-id"),
+	)
+	pod.Labels = map[string]string{"app": "my-app", "version": "v1"}
+	pod.Annotations = map[string]string{"cost.example.com/center": "1234"}
+
+	md := rw.objMetadata(gvk.Pod, pod)
+	podMetadata := md[experimentalmetricmetadata.ResourceID("test-pod-0-uid")].Metadata
+	assert.Equal(t, "my-app", podMetadata["k8s.pod.label.app"])
+	assert.Equal(t, "1234", podMetadata["k8s.pod.annotation.cost.example.com/center"])
+	assert.NotContains(t, podMetadata, "k8s.pod.label.team")
+	assert.NotContains(t, podMetadata, "k8s.pod.label.version")
+	assert.NotContains(t, md[experimentalmetricmetadata.ResourceID("container-id")].Metadata, "k8s.pod.label.app")
+}
+
+func TestObjMetadataExcludedNamespace(t *testing.T) {
+	ms := metadata.NewStore()
+	ms.SetNamespaceFilter(metadata.NewNamespaceFilter(nil, []string{"test-namespace"}))
+	rw := &resourceWatcher{
+		kinds:         newTestKinds(),
+		metadataStore: ms,
+		config:        &Config{},
+	}
+
+	assert.Nil(t, rw.objMetadata(gvk.ReplicaSet, testutils.NewReplicaSet("1")))
+	assert.NotNil(t, rw.objMetadata(gvk.Node, testutils.NewNode("1")))
+}
+
+func TestTransformKeepsSelectedAnnotations(t *testing.T) {
+	rw := &resourceWatcher{config: &Config{MetadataAnnotations: []string{"team"}}}
+	pod := testutils.NewPodWithContainer(
+		"0",
+		testutils.NewPodSpecWithContainer("container-name"),
+		testutils.NewPodStatusWithContainer("container-name", "container-id"),
+	)
+	pod.Annotations = map[string]string{"team": "a-team", "kubectl.kubernetes.io/last-applied-configuration": "{}"}
+
+	got, err := rw.transform(pod)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "a-team"}, got.(*corev1.Pod).Annotations)
+}
+
+func TestTransformKeepsMirrorPodAnnotation(t *testing.T) {
+	rw := &resourceWatcher{config: &Config{MetadataAnnotations: []string{"team"}}}
+	pod := testutils.NewPodWithContainer(
+		"0",
+		testutils.NewPodSpecWithContainer("container-name"),
+		testutils.NewPodStatusWithContainer("container-name", "container-id"),
+	)
+	pod.Annotations = map[string]string{"team": "a-team", corev1.MirrorPodAnnotationKey: "mirror", "other": "value"}
+
+	got, err := rw.transform(pod)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "a-team", corev1.MirrorPodAnnotationKey: "mirror"}, got.(*corev1.Pod).Annotations)
+}